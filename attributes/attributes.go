@@ -38,7 +38,10 @@ func (t *Component) collect(name, val string) error {
 	case "params":
 		var err error
 		t.Params, err = parsers.ParseParameters(val)
-		return err
+		if err != nil {
+			return parsers.WrapError("invalid params", err)
+		}
+		return nil
 	case "gen-new-init":
 		t.GenNewInit = true
 		return nil
@@ -124,7 +127,10 @@ func (e *Embed) collect(name, val string) error {
 		}
 		var err error
 		e.Args, err = parsers.AnalyseArguments(val)
-		return err
+		if err != nil {
+			return parsers.WrapError("invalid args", err)
+		}
+		return nil
 	case "value":
 		if e.Args.Count != -1 {
 			return errors.New(": embed cannot have both args and value attributes")
@@ -144,6 +150,7 @@ func (e *Embed) collect(name, val string) error {
 // General collects attributes that may occur on any element.
 type General struct {
 	Bindings []data.VariableMapping
+	Model    []data.Model
 	Capture  []data.UnboundEventMapping
 	If, For  *data.ControlBlock
 	Assign   []data.Assignment
@@ -155,18 +162,30 @@ func (g *General) collect(name, val string) error {
 		var err error
 		g.Bindings, err = parsers.ParseBindings(val)
 		if err != nil {
-			return errors.New(": invalid bindings: " + err.Error())
+			return parsers.WrapError("invalid bindings", err)
 		}
 		for _, binding := range g.Bindings {
 			if binding.Value.Kind == data.BoundEventValue {
 				return errors.New(": cannot use event() in bindings")
 			}
 		}
+	case "model":
+		var err error
+		g.Model, err = parsers.ParseModels(val)
+		if err != nil {
+			return parsers.WrapError("invalid model", err)
+		}
+		for _, model := range g.Model {
+			switch model.Value.Kind {
+			case data.BoundSelf, data.BoundExpr, data.BoundEventValue, data.BoundFormValue:
+				return errors.New(": model must use prop(), style(), dataset() or class()")
+			}
+		}
 	case "capture":
 		var err error
 		g.Capture, err = parsers.ParseCapture(val)
 		if err != nil {
-			return errors.New(": invalid capture: " + err.Error())
+			return parsers.WrapError("invalid capture", err)
 		}
 	case "if":
 		g.If = &data.ControlBlock{Kind: data.IfBlock, Expression: val}
@@ -174,13 +193,13 @@ func (g *General) collect(name, val string) error {
 		var err error
 		g.For, err = parsers.ParseFor(val)
 		if err != nil {
-			return errors.New(": invalid for: " + err.Error())
+			return parsers.WrapError("invalid for", err)
 		}
 	case "assign":
 		var err error
 		g.Assign, err = parsers.ParseAssignments(val)
 		if err != nil {
-			return errors.New(": invalid assign: " + err.Error())
+			return parsers.WrapError("invalid assign", err)
 		}
 	default:
 		return invalidAttribute{name}
@@ -196,7 +215,7 @@ func ExtractAskewAttribs(n *html.Node, target Collector) error {
 	i := 0
 	for i < len(n.Attr) {
 		attr := n.Attr[i]
-		if len(attr.Key) < 2 || attr.Key[0:2] != "a:" {
+		if len(attr.Key) < 2 || attr.Key[0:2] != "a:" || attr.Key == data.PositionAttr {
 			i++
 			continue
 		}
@@ -208,7 +227,7 @@ func ExtractAskewAttribs(n *html.Node, target Collector) error {
 		key := attr.Key[2:]
 
 		if _, ok := seen[key]; ok {
-			panic("duplicate attribute: " + attr.Key)
+			return errors.New(": duplicate attribute `" + attr.Key + "`")
 		}
 		seen[key] = struct{}{}
 		if err := target.collect(key, attr.Val); err != nil {
@@ -234,8 +253,12 @@ func Collect(n *html.Node, target Collector) error {
 
 	for i := 0; i < len(n.Attr); {
 		attr := &n.Attr[i]
+		if attr.Key == data.PositionAttr {
+			i++
+			continue
+		}
 		if _, ok := seen[attr.Key]; ok {
-			panic("duplicate attribute: " + attr.Key)
+			return errors.New(": duplicate attribute `" + attr.Key + "`")
 		}
 		seen[attr.Key] = struct{}{}
 		if err := target.collect(attr.Key, attr.Val); err != nil {
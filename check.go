@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/flyx/askew/data"
+	"github.com/flyx/askew/packages"
+)
+
+// jsonDiagnostic is the representation of a diagnostic in a JSON report.
+type jsonDiagnostic struct {
+	File     string `json:"file,omitempty"`
+	Line     int    `json:"line,omitempty"`
+	Column   int    `json:"column,omitempty"`
+	Offset   *int   `json:"offset,omitempty"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// jsonReport is the JSON report written by `askew check --format=json`.
+type jsonReport struct {
+	Diagnostics []jsonDiagnostic `json:"diagnostics"`
+	Errors      int              `json:"errors"`
+	Warnings    int              `json:"warnings"`
+}
+
+// check discovers and processes all files like a normal run would, but does
+// not write any files. It reports all diagnostics in the given format and
+// returns the exit code for the process, which is non-zero if any errors have
+// been found.
+func check(excludes []string, dataPath string, format string, ignoreUnused bool) int {
+	report := os.Stdout
+	switch format {
+	case "text":
+		break
+	case "json":
+		// keep stdout clean for the report by redirecting informational output.
+		os.Stdout = os.Stderr
+	default:
+		os.Stdout.WriteString("[error] unknown report format: `" + format + "`\n")
+		return 1
+	}
+
+	var diag data.Diagnostics
+	if loadedData, err := loadData(dataPath); err != nil {
+		diag.Add(err)
+	} else if base, err := packages.Discover(excludes, loadedData, &diag); err != nil {
+		diag.Add(err)
+	} else if order, err := packages.Sort(base.ImportPath, base.Packages); err != nil {
+		diag.Add(err)
+	} else {
+		p := processor{ignoreUnused: ignoreUnused}
+		p.init(base, &diag)
+		p.process(order)
+	}
+
+	if format == "text" {
+		return reportDiagnostics(&diag)
+	}
+	r := jsonReport{Diagnostics: make([]jsonDiagnostic, 0, len(diag.Items)),
+		Errors: diag.Count(data.SeverityError), Warnings: diag.Count(data.SeverityWarning)}
+	for _, item := range diag.Items {
+		d := jsonDiagnostic{File: item.Pos.File, Line: item.Pos.Line,
+			Column: item.Pos.Column, Severity: item.Severity.String(),
+			Message: item.Message}
+		if item.Offset >= 0 {
+			offset := item.Offset
+			d.Offset = &offset
+		}
+		r.Diagnostics = append(r.Diagnostics, d)
+	}
+	enc := json.NewEncoder(report)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(&r); err != nil {
+		os.Stderr.WriteString("[error] " + err.Error() + "\n")
+		return 1
+	}
+	if r.Errors > 0 {
+		return 1
+	}
+	return 0
+}
@@ -30,6 +30,9 @@ type EventMapping struct {
 	FromController bool
 	ParamMappings  []BoundParam
 	Handling       EventHandling
+	// Delegate is true if the event is handled by a listener on the container
+	// of the list the component is part of.
+	Delegate bool
 }
 
 // UnboundEventMapping describes an event mapping for which the parameter names
@@ -39,4 +42,5 @@ type UnboundEventMapping struct {
 	Handler       string
 	ParamMappings map[string]BoundValue
 	Handling      EventHandling
+	Delegate      bool
 }
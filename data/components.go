@@ -1,6 +1,7 @@
 package data
 
 import (
+	"sort"
 	"unicode"
 
 	"github.com/flyx/net/html"
@@ -47,6 +48,11 @@ type ConstructorCall struct {
 	Kind            ConstructorCallKind
 	Index, Variable string // only for NestedFor
 	Expression      string // only for NestedIf and NestedFor
+	// Origin is the element and its control attribute, if any.
+	Origin Origin
+	// Target is the constructed component if it is declared in the current
+	// module.
+	Target *Component
 }
 
 // Embed describes a <a:embed> node.
@@ -59,12 +65,63 @@ type Embed struct {
 	Field, Ns, T     string
 	Control          bool
 	ConstructorCalls []ConstructorCall
+	Origin           Origin
+	// Target is the embedded component if it is declared in the current module.
+	Target *Component
+	// Slot is true if the embed has been declared with <a:slot>. It is an
+	// optional embed without type whose content is given by the unit that
+	// embeds the component.
+	Slot bool
+	// Fallback is a DocumentNode holding the content of an <a:slot>, which is
+	// the slot's initial content. Nil if the <a:slot> is empty.
+	Fallback *html.Node
+	// SlotContents is the content given for the slots of the embedded
+	// component. Only used with DirectEmbed.
+	SlotContents []SlotContent
+	// Routes is the route table given by <a:route> children. Only used with
+	// OptionalEmbed.
+	Routes []Route
+	// Block is true if the embed is inside an a:if block. Its Path is then
+	// relative to the block's element. The embed is created each time the
+	// block renders its content and destroyed when the content is discarded.
+	Block bool
+}
+
+// Route describes a <a:route> node inside an optional <a:embed>, which
+// constructs the embed's content when the location matches Path.
+type Route struct {
+	Path string
+	// Params are the names of the parameters in Path, in order.
+	Params          []string
+	ConstructorName string
+	// Args may reference the parameters, which are strings.
+	Args   Arguments
+	Origin Origin
+	// Target is the constructed component if it is declared in the current
+	// module.
+	Target *Component
+}
+
+// SlotContent is content given for a slot of an embedded component, either
+// as child element of <a:embed> or as <a:construct>, with an `a:slot`
+// attribute.
+type SlotContent struct {
+	// Slot is the name of the slot.
+	Slot string
+	// HTML is a DocumentNode holding the element if the content is static
+	// HTML, else nil.
+	HTML *html.Node
+	// Construct creates the content if it is a component, else nil.
+	Construct *ConstructorCall
+	Origin    Origin
 }
 
 // Handler describes a <a:handler> node.
 type Handler struct {
 	Params  []Param
 	Returns *ParamType
+	// Pos is the position of the handler's declaration.
+	Pos Position
 }
 
 // ControllerMethod is a method of a controller declared with <a:controller>.
@@ -76,6 +133,7 @@ type ControllerMethod struct {
 type Capture struct {
 	Path     []int
 	Mappings []EventMapping
+	Origin   Origin
 }
 
 // ComponentParam is a component parameter whose type is not parsed or checked by
@@ -92,6 +150,11 @@ type Assignment struct {
 	Expression string
 	Path       []int
 	Target     BoundValue
+	Origin     Origin
+	// Signal is true if the expression has been given as `signal(expr)`. The
+	// expression then evaluates to an askew.Source whose values are assigned
+	// whenever they change.
+	Signal bool
 }
 
 // Block is a subtree of a component.
@@ -121,12 +184,17 @@ type ControlBlock struct {
 	Index, Variable string // only for ForBlock
 	Expression      string
 	Path            []int
+	Origin          Origin
+	// Embeds are the embeds inside the block that are not inside a nested
+	// control block. They are also part of the unit's Embeds.
+	Embeds []Embed
 }
 
 // Component describes a <a:component> node.
 type Component struct {
 	Unit
-	// HTML id. internally generated.
+	// ID identifies the component in the document. It is derived from the
+	// import path of the component's package and its name.
 	ID              string
 	Name            string
 	Parameters      []ComponentParam
@@ -135,8 +203,88 @@ type Component struct {
 	Handlers        map[string]Handler
 	Controller      map[string]ControllerMethod
 	Captures        []Capture
+	Models          []Model
 	GenNewInit      bool
 	GenList, GenOpt bool
+	// Style is the content of the component's <style> children with all
+	// selectors restricted to the component's elements. Empty if the
+	// component has no style.
+	Style string
+	// Pos is the position of the <a:component> element.
+	Pos Position
+}
+
+// The names of handlers that are called by the generated code on lifecycle
+// events of the component instead of being captured.
+const (
+	// MountedHook is called after the component has entered the document.
+	MountedHook = "mounted"
+	// UnmountedHook is called after the component has left the document.
+	UnmountedHook = "unmounted"
+	// DestroyedHook is called before the component is destroyed.
+	DestroyedHook = "destroyed"
+)
+
+// LifecycleHooks contains the names of all lifecycle hooks.
+var LifecycleHooks = []string{MountedHook, UnmountedHook, DestroyedHook}
+
+// HasHook returns true iff the component declares a handler with the given
+// lifecycle hook name that is used as lifecycle hook, i.e. that has neither
+// parameters nor a return value and is not captured. Other handlers with that
+// name are ordinary handlers.
+func (c *Component) HasHook(name string) bool {
+	h, ok := c.Handlers[name]
+	return ok && len(h.Params) == 0 && h.Returns == nil && !c.IsCaptured(name)
+}
+
+// IsCaptured returns true iff the given handler of the component is
+// referenced by a capture.
+func (c *Component) IsCaptured(handler string) bool {
+	for _, capture := range c.Captures {
+		for _, m := range capture.Mappings {
+			if m.Handler == handler && !m.FromController {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// DelegatedEvents returns the sorted list of event types for which the
+// component has delegated captures.
+func (c *Component) DelegatedEvents() []string {
+	var ret []string
+	for _, capture := range c.Captures {
+		for _, m := range capture.Mappings {
+			if !m.Delegate {
+				continue
+			}
+			i := sort.SearchStrings(ret, m.Event)
+			if i == len(ret) || ret[i] != m.Event {
+				ret = append(ret, "")
+				copy(ret[i+1:], ret[i:])
+				ret[i] = m.Event
+			}
+		}
+	}
+	return ret
+}
+
+// HasSlot returns true iff the component declares an <a:slot> with the given
+// name.
+func (c *Component) HasSlot(name string) bool {
+	for _, e := range c.Embeds {
+		if e.Slot && e.Field == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ScopeAttr returns the name of the attribute that marks the elements of the
+// component's template if the component has a style.
+func (c *Component) ScopeAttr() string {
+	return "data-" + c.ID
 }
 
 // NewName returns the name of the component's new func.
@@ -0,0 +1,67 @@
+package data
+
+// Severity describes how severe a reported problem is.
+type Severity int
+
+const (
+	// SeverityError marks a problem that prevents code generation.
+	SeverityError Severity = iota
+	// SeverityWarning marks a problem that does not prevent code generation.
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	default:
+		panic("unknown severity")
+	}
+}
+
+// Diagnostics accumulates errors and warnings so that processing can
+// continue after a problem has been found.
+//
+// All methods can be called on a nil *Diagnostics, in which case warnings are
+// discarded.
+type Diagnostics struct {
+	Items []*Error
+	// CurFile is the path of the file that is currently being processed.
+	// It is used for errors whose position is unknown.
+	CurFile string
+}
+
+// Add records the given error with error severity.
+func (d *Diagnostics) Add(err error) {
+	if d == nil {
+		return
+	}
+	e := ErrorAt(Position{File: d.CurFile}, err)
+	e.Severity = SeverityError
+	d.Items = append(d.Items, e)
+}
+
+// Warn records a warning at the given position.
+func (d *Diagnostics) Warn(pos Position, message string) {
+	if d == nil {
+		return
+	}
+	d.Items = append(d.Items, &Error{Pos: pos, Offset: -1, Message: message,
+		Severity: SeverityWarning})
+}
+
+// Count returns the number of recorded items with the given severity.
+func (d *Diagnostics) Count(s Severity) int {
+	if d == nil {
+		return 0
+	}
+	ret := 0
+	for _, item := range d.Items {
+		if item.Severity == s {
+			ret++
+		}
+	}
+	return ret
+}
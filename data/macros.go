@@ -12,4 +12,6 @@ type Slot struct {
 type Macro struct {
 	Slots       []Slot
 	First, Last *html.Node
+	// Pos is the position of the <a:macro> element.
+	Pos Position
 }
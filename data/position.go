@@ -0,0 +1,123 @@
+package data
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/flyx/net/html"
+)
+
+// PositionAttr is the name of the attribute that is added to each element
+// when a file is loaded. Its value is the position of the element's start tag
+// in its source file. The attribute is removed before any HTML is written.
+const PositionAttr = "a:pos"
+
+// Position describes a location in a source file.
+type Position struct {
+	File string
+	// Line and Column are 1-based. They are 0 if the position denotes the file
+	// as a whole. Column counts characters (Unicode code points), not bytes.
+	Line, Column int
+}
+
+// String returns the position in the format `file:line:col`.
+func (p Position) String() string {
+	if p.Line == 0 {
+		return p.File
+	}
+	return p.File + ":" + strconv.Itoa(p.Line) + ":" + strconv.Itoa(p.Column)
+}
+
+// ParsePosition parses a string in the format returned by Position.String.
+func ParsePosition(s string) (Position, error) {
+	colStart := strings.LastIndexByte(s, ':')
+	if colStart == -1 {
+		return Position{File: s}, nil
+	}
+	lineStart := strings.LastIndexByte(s[:colStart], ':')
+	if lineStart == -1 {
+		return Position{}, fmt.Errorf("invalid position: %s", s)
+	}
+	line, err := strconv.Atoi(s[lineStart+1 : colStart])
+	if err != nil {
+		return Position{}, fmt.Errorf("invalid line in position: %s", s)
+	}
+	col, err := strconv.Atoi(s[colStart+1:])
+	if err != nil {
+		return Position{}, fmt.Errorf("invalid column in position: %s", s)
+	}
+	return Position{File: s[:lineStart], Line: line, Column: col}, nil
+}
+
+// PositionOf returns the position of the given node. If the node itself has
+// no position, e.g. because it is a text node or has been created implicitly
+// by the HTML parser, the position of its closest ancestor that has one is
+// returned.
+func PositionOf(n *html.Node) Position {
+	for cur := n; cur != nil; cur = cur.Parent {
+		if cur.Type != html.ElementNode {
+			continue
+		}
+		for _, a := range cur.Attr {
+			if a.Key == PositionAttr {
+				if ret, err := ParsePosition(a.Val); err == nil {
+					return ret
+				}
+			}
+		}
+	}
+	return Position{}
+}
+
+// Error is an error that is associated with a position in a source file.
+// It is also used for reporting warnings.
+type Error struct {
+	Pos Position
+	// Offset is the position inside the value of an attribute or the text
+	// content of an element where the error has been detected, e.g. by the
+	// parser. It is -1 if not applicable.
+	Offset   int
+	Message  string
+	Severity Severity
+}
+
+func (e *Error) Error() string {
+	if e.Pos.File != "" {
+		return e.Pos.String() + ": " + e.Text()
+	}
+	return e.Text()
+}
+
+// Text returns the error's message without position, but including the
+// offset if it is known.
+func (e *Error) Text() string {
+	if e.Offset >= 0 {
+		return e.Message + " (at offset " + strconv.Itoa(e.Offset) + ")"
+	}
+	return e.Message
+}
+
+// ErrorAt returns err as an *Error located at the given position.
+// If err already is an *Error that has a position, it is returned unchanged.
+// A leading ": " in the message of err, which askew's error messages
+// traditionally have, is removed.
+func ErrorAt(pos Position, err error) *Error {
+	if e, ok := err.(*Error); ok {
+		if e.Pos.File == "" {
+			e.Pos = pos
+		}
+		return e
+	}
+	return &Error{Pos: pos, Offset: -1,
+		Message: strings.TrimPrefix(err.Error(), ": ")}
+}
+
+// Origin describes the part of a source file that a piece of generated code
+// originates from.
+type Origin struct {
+	Pos Position
+	// Attr is the name of the attribute that contains the code. It is empty if
+	// the code is the content of the element.
+	Attr string
+}
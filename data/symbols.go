@@ -34,6 +34,11 @@ type Symbols struct {
 	curAskewFile *AskewFile
 	curAsiteFile *ASiteFile
 	CurUnit      *Unit
+	// Diagnostics receives errors and warnings that do not abort processing.
+	// May be nil.
+	Diagnostics *Diagnostics
+	// IgnoreUnused disables warnings about handlers that are never captured.
+	IgnoreUnused bool
 }
 
 // SetAskewFile sets the currently processed file to be the given .askew file.
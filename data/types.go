@@ -33,6 +33,8 @@ type ParamType struct {
 	Kind TypeKind
 	// used when Kind == NamedType
 	Name string
+	// TypeArgs are the type arguments of a generic NamedType.
+	TypeArgs []*ParamType
 	// used when Kind == MapType
 	KeyType *ParamType
 	// used when Kind in [ArrayType, MapType, PointerType, FuncType (return type)]
@@ -52,7 +54,14 @@ func (pt ParamType) String() string {
 	case JSValueType:
 		return "js.Value"
 	case NamedType:
-		return pt.Name
+		if len(pt.TypeArgs) == 0 {
+			return pt.Name
+		}
+		args := make([]string, len(pt.TypeArgs))
+		for i, a := range pt.TypeArgs {
+			args[i] = a.String()
+		}
+		return pt.Name + "[" + strings.Join(args, ", ") + "]"
 	case ArrayType:
 		return "[]" + pt.ValueType.String()
 	case MapType:
@@ -80,6 +89,24 @@ func (pt ParamType) String() string {
 	}
 }
 
+var predeclared = map[string]struct{}{
+	"int8": {}, "int16": {}, "int32": {}, "int64": {}, "uint": {}, "uint8": {},
+	"uint16": {}, "uint32": {}, "uint64": {}, "uintptr": {}, "byte": {},
+	"rune": {}, "float32": {}, "float64": {}, "complex64": {}, "complex128": {},
+	"error": {}, "any": {},
+}
+
+// IsPredeclared returns true iff pt is a NamedType that names one of Go's
+// predeclared types. int, string and bool have their own kinds and are not
+// considered.
+func (pt ParamType) IsPredeclared() bool {
+	if pt.Kind != NamedType || len(pt.TypeArgs) != 0 {
+		return false
+	}
+	_, ok := predeclared[pt.Name]
+	return ok
+}
+
 // Param is a parameter of a handler or controller method.
 type Param struct {
 	Name string
@@ -95,4 +122,5 @@ type Field struct {
 	Name         string
 	Type         *ParamType
 	DefaultValue *string
+	Origin       Origin
 }
@@ -12,4 +12,21 @@ type VariableMapping struct {
 	Variable GoValue
 	Value    BoundValue
 	Path     []int
+	// Layout is the name of the runtime's layout constant used for formatting
+	// a time.Time variable, e.g. `DateLayout`. Empty if the runtime's default
+	// is to be used.
+	Layout string
+}
+
+// Model maps a field of a component to a value in the DOM. The value is
+// assigned from the field at instantiation and the field is updated whenever
+// the DOM node emits Event.
+type Model struct {
+	Field string
+	Value BoundValue
+	// Type is the type of Field. It is set from the field's declaration.
+	Type   *ParamType
+	Event  string
+	Path   []int
+	Origin Origin
 }
@@ -1,14 +1,18 @@
 module github.com/flyx/askew
 
-go 1.12
+go 1.22.0
 
 require (
 	github.com/flyx/net v0.1.1
+	github.com/fsnotify/fsnotify v1.7.0
 	github.com/pborman/getopt/v2 v2.1.0
 	github.com/pointlander/compress v1.1.0 // indirect
 	github.com/pointlander/jetset v1.0.0 // indirect
 	github.com/pointlander/peg v1.0.0 // indirect
-	golang.org/x/mod v0.3.0
+	golang.org/x/mod v0.21.0
+	golang.org/x/sync v0.8.0 // indirect
+	golang.org/x/sys v0.26.0 // indirect
+	golang.org/x/tools v0.26.0
 	golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543 // indirect
 	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b
 )
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/flyx/askew/data"
+	"github.com/flyx/askew/lsp"
+	"github.com/flyx/askew/packages"
+)
+
+// serveLSP runs a language server on stdin and stdout. The first analysis
+// processes all files like `check` does, later analyses only process changed
+// packages and the packages depending on them. Returns the exit code for the
+// process.
+func serveLSP(excludes []string, dataPath string, ignoreUnused bool) int {
+	out := os.Stdout
+	// stdout is reserved for messages to the client, informational output of
+	// processing goes to stderr.
+	os.Stdout = os.Stderr
+
+	a := &analyzer{excludes: excludes, dataPath: dataPath, ignoreUnused: ignoreUnused}
+	server := lsp.NewServer(os.Stdin, out, a.analyze)
+	if err := server.Serve(); err != nil {
+		os.Stderr.WriteString("[error] " + err.Error() + "\n")
+		return 1
+	}
+	return 0
+}
+
+// analyzer keeps the processed symbols of the language server in memory, like
+// the watcher does, so that changes only require processing the affected
+// packages.
+type analyzer struct {
+	excludes     []string
+	dataPath     string
+	ignoreUnused bool
+
+	tmplData interface{}
+	// p is nil if the last complete analysis failed.
+	p *processor
+	// items contains the diagnostics of the last analysis of each package.
+	// Diagnostics without file are stored for the empty string.
+	items map[string][]*data.Error
+}
+
+// analyze implements lsp.Analyzer.
+func (a *analyzer) analyze(changed []string) (*data.Symbols, *data.Diagnostics) {
+	if changed == nil || a.p == nil {
+		return a.analyzeAll()
+	}
+	base := &a.p.syms.BaseDir
+	diag := &data.Diagnostics{}
+	a.p.setDiagnostics(diag)
+	// packages that failed previously are retried since their processing may
+	// have been stopped before all symbols were registered.
+	seen := make(map[string]struct{})
+	for _, relPath := range changed {
+		seen[relPath] = struct{}{}
+	}
+	for relPath, items := range a.items {
+		if _, ok := seen[relPath]; ok || relPath == "" {
+			continue
+		}
+		for _, item := range items {
+			if item.Severity == data.SeverityError {
+				changed = append(changed, relPath)
+				break
+			}
+		}
+	}
+	for _, relPath := range changed {
+		if err := packages.Rediscover(base, relPath, a.tmplData, diag); err != nil {
+			diag.Add(err)
+		}
+	}
+	affected := packages.Dependents(base.ImportPath, base.Packages, changed)
+	// dependent packages have been modified by previous processing and must be
+	// loaded again.
+	for _, relPath := range affected[len(changed):] {
+		if err := packages.Rediscover(base, relPath, a.tmplData, diag); err != nil {
+			diag.Add(err)
+		}
+	}
+	if order, err := packages.Sort(base.ImportPath, base.Packages); err != nil {
+		diag.Add(err)
+	} else {
+		wanted := make(map[string]struct{})
+		for _, relPath := range affected {
+			wanted[relPath] = struct{}{}
+		}
+		filtered := make([]string, 0, len(affected))
+		for _, relPath := range order {
+			if _, ok := wanted[relPath]; ok {
+				filtered = append(filtered, relPath)
+			}
+		}
+		a.p.process(filtered)
+	}
+	for _, relPath := range affected {
+		delete(a.items, relPath)
+	}
+	delete(a.items, "")
+	a.store(diag)
+	return &a.p.syms, a.all()
+}
+
+// analyzeAll discovers and processes all packages.
+func (a *analyzer) analyzeAll() (*data.Symbols, *data.Diagnostics) {
+	a.p, a.items = nil, make(map[string][]*data.Error)
+	diag := &data.Diagnostics{}
+	var err error
+	if a.tmplData, err = loadData(a.dataPath); err != nil {
+		diag.Add(err)
+		return nil, diag
+	}
+	base, err := packages.Discover(a.excludes, a.tmplData, diag)
+	if err != nil {
+		diag.Add(err)
+		return nil, diag
+	}
+	order, err := packages.Sort(base.ImportPath, base.Packages)
+	if err != nil {
+		diag.Add(err)
+		return nil, diag
+	}
+	a.p = &processor{ignoreUnused: a.ignoreUnused}
+	a.p.init(base, diag)
+	a.p.process(order)
+	a.store(diag)
+	return &a.p.syms, diag
+}
+
+// store records the given diagnostics for the packages of their files.
+func (a *analyzer) store(diag *data.Diagnostics) {
+	for _, item := range diag.Items {
+		relPath := ""
+		if item.Pos.File != "" {
+			relPath = filepath.Dir(item.Pos.File)
+		}
+		a.items[relPath] = append(a.items[relPath], item)
+	}
+}
+
+// all returns the stored diagnostics of all packages.
+func (a *analyzer) all() *data.Diagnostics {
+	diag := &data.Diagnostics{}
+	for _, items := range a.items {
+		diag.Items = append(diag.Items, items...)
+	}
+	return diag
+}
@@ -0,0 +1,223 @@
+package lsp
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/flyx/askew/data"
+	"github.com/flyx/askew/walker"
+)
+
+// generalAttributes are the askew attributes allowed on standard HTML
+// elements.
+var generalAttributes = []string{
+	"a:assign", "a:bindings", "a:capture", "a:for", "a:if", "a:model", "a:slot"}
+
+// elementAttributes lists the attributes of askew's elements.
+var elementAttributes = map[string][]string{
+	"a:component": {"name", "params", "gen-new-init", "usage"},
+	"a:embed": {"name", "type", "list", "optional", "args", "value",
+		"control"},
+	"a:construct": {"type", "args", "a:if", "a:for"},
+	"a:route":     {"path", "type", "args"},
+	"a:include":   {"name"},
+	"a:macro":     {"name"},
+	"a:slot":      {"name"},
+	"a:text":      {"expr"},
+	"a:site":      {"a:htmlfile", "a:jspath", "a:wasmpath", "a:wasmexecpath"},
+}
+
+// complete returns the completion items at the given position.
+func (s *Server) complete(params textDocumentPositionParams) []completionItem {
+	ret := []completionItem{}
+	path, ok := s.relPath(params.TextDocument.URI)
+	if !ok {
+		return ret
+	}
+	text := s.text(path)
+	offset := offsetOf(text, params.Position)
+	ctx := analyzeContext(text, offset)
+
+	var candidates []completionItem
+	start := ctx.start
+	switch ctx.kind {
+	case inElementName:
+		for _, e := range walker.AskewElements {
+			candidates = append(candidates, completionItem{Label: e.Name, Kind: kindKeyword})
+		}
+	case inAttributeName:
+		names, ok := elementAttributes[ctx.element]
+		if !ok {
+			if strings.HasPrefix(ctx.element, "a:") {
+				break
+			}
+			names = generalAttributes
+		}
+		for _, name := range names {
+			candidates = append(candidates, completionItem{Label: name, Kind: kindProperty})
+		}
+	case inAttributeValue:
+		candidates, start = s.valueCompletions(path, text, offset, ctx)
+	}
+
+	prefix := text[start:offset]
+	editRange := textRange{Start: positionOf(text, start), End: positionOf(text, offset)}
+	for _, c := range candidates {
+		if strings.HasPrefix(c.Label, prefix) {
+			c.TextEdit = &textEdit{Range: editRange, NewText: c.Label}
+			ret = append(ret, c)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Label < ret[j].Label })
+	return ret
+}
+
+// valueCompletions returns the candidates for the value of an attribute,
+// along with the start offset of the text they replace.
+func (s *Server) valueCompletions(path, text string, offset int,
+	ctx cursorContext) (candidates []completionItem, start int) {
+	start = ctx.start
+	f, ok := s.file(path)
+	if !ok {
+		return
+	}
+	switch {
+	case ctx.attribute == "type" &&
+		(ctx.element == "a:embed" || ctx.element == "a:construct" ||
+			ctx.element == "a:route"):
+		for alias, pkg := range s.visiblePackages(f) {
+			for _, file := range pkg.Files {
+				for name := range file.Components {
+					candidates = append(candidates, completionItem{
+						Label: qualify(alias, name), Kind: kindClass, Detail: pkg.ImportPath})
+				}
+			}
+		}
+	case ctx.attribute == "name" && ctx.element == "a:include":
+		for alias, pkg := range s.visiblePackages(f) {
+			for _, file := range pkg.Files {
+				for name := range file.Macros {
+					candidates = append(candidates, completionItem{
+						Label: qualify(alias, name), Kind: kindModule, Detail: pkg.ImportPath})
+				}
+			}
+		}
+	case ctx.attribute == "a:capture":
+		identStart, _ := identAt(text, offset)
+		if identStart < ctx.start || !isHandlerName(text[ctx.start:], identStart-ctx.start) {
+			return
+		}
+		start = identStart
+		cmp := s.component(ctx.component)
+		if cmp == nil {
+			return
+		}
+		for name := range cmp.Handlers {
+			candidates = append(candidates, completionItem{
+				Label: name, Kind: kindMethod, Detail: "handler"})
+		}
+		for name := range cmp.Controller {
+			candidates = append(candidates, completionItem{
+				Label: name, Kind: kindMethod, Detail: "controller"})
+		}
+	}
+	return
+}
+
+// definition returns the location of the declaration of the symbol at the
+// given position, or nil if there is none.
+func (s *Server) definition(params textDocumentPositionParams) *location {
+	path, ok := s.relPath(params.TextDocument.URI)
+	if !ok {
+		return nil
+	}
+	text := s.text(path)
+	offset := offsetOf(text, params.Position)
+	ctx := analyzeContext(text, offset)
+	if ctx.kind != inAttributeValue {
+		return nil
+	}
+	if _, ok := s.file(path); !ok {
+		return nil
+	}
+
+	var pos data.Position
+	switch {
+	case ctx.attribute == "type" &&
+		(ctx.element == "a:embed" || ctx.element == "a:construct" ||
+			ctx.element == "a:route"):
+		if cmp, _, _, err := s.syms.ResolveComponent(ctx.value); err == nil {
+			pos = cmp.Pos
+		}
+	case ctx.attribute == "name" && ctx.element == "a:include":
+		if m, err := s.syms.ResolveMacro(ctx.value); err == nil {
+			pos = m.Pos
+		}
+	case ctx.attribute == "a:capture":
+		identStart, identEnd := identAt(text, offset)
+		if identStart < ctx.start || identStart == identEnd ||
+			!isHandlerName(text[ctx.start:], identStart-ctx.start) {
+			return nil
+		}
+		cmp := s.component(ctx.component)
+		if cmp == nil {
+			return nil
+		}
+		name := text[identStart:identEnd]
+		if h, ok := cmp.Handlers[name]; ok {
+			pos = h.Pos
+		} else if m, ok := cmp.Controller[name]; ok {
+			pos = m.Pos
+		}
+	}
+	if pos.File == "" {
+		return nil
+	}
+	return &location{URI: pathToURI(filepath.Join(s.root, pos.File)),
+		Range: rangeOf(s.text(pos.File), pos)}
+}
+
+// visiblePackages returns the packages whose symbols can be referenced in the
+// given file, mapped by the alias they are referenced with. The file's own
+// package has an empty alias.
+func (s *Server) visiblePackages(f *data.File) map[string]*data.Package {
+	ret := map[string]*data.Package{"": s.syms.Packages[s.syms.CurPkg]}
+	for alias, importPath := range f.Imports {
+		relPath, err := filepath.Rel(s.syms.ImportPath, importPath)
+		if err != nil || strings.HasPrefix(relPath, "..") {
+			continue
+		}
+		if pkg, ok := s.syms.Packages[relPath]; ok {
+			ret[alias] = pkg
+		}
+	}
+	return ret
+}
+
+// component returns the component with the given name in the current file.
+func (s *Server) component(name string) *data.Component {
+	f := s.syms.CurAskewFile()
+	if f == nil {
+		return nil
+	}
+	return f.Components[name]
+}
+
+// isHandlerName checks whether the identifier starting at the given offset
+// inside the value of an a:capture attribute names a handler, i.e. whether it
+// follows the `:` after an event name.
+func isHandlerName(value string, offset int) bool {
+	i := offset - 1
+	for i >= 0 && isSpace(value[i]) {
+		i--
+	}
+	return i >= 0 && value[i] == ':'
+}
+
+func qualify(alias, name string) string {
+	if alias == "" {
+		return name
+	}
+	return alias + "." + name
+}
@@ -0,0 +1,186 @@
+package lsp
+
+import "strings"
+
+// contextKind describes what kind of token the cursor is located in.
+type contextKind int
+
+const (
+	// inText is any location outside of a tag.
+	inText contextKind = iota
+	// inElementName is the name of a start tag.
+	inElementName
+	// inAttributeName is the name of an attribute, or whitespace between
+	// attributes.
+	inAttributeName
+	// inAttributeValue is the value of an attribute.
+	inAttributeValue
+)
+
+// cursorContext describes the location of the cursor in an askew file.
+type cursorContext struct {
+	kind contextKind
+	// element is the name of the tag the cursor is located in.
+	element string
+	// attribute is the name of the attribute whose value the cursor is
+	// located in.
+	attribute string
+	// start is the offset of the current token: the element name, attribute
+	// name or attribute value.
+	start int
+	// value is the complete value of the attribute.
+	value string
+	// component is the name of the <a:component> enclosing the cursor.
+	component string
+}
+
+type scanState int
+
+const (
+	scanText scanState = iota
+	scanComment
+	scanElementName
+	scanTag
+	scanAttributeName
+	scanAfterAttributeName
+	scanBeforeValue
+	scanValue
+)
+
+// analyzeContext determines the context of the given offset in text.
+// The text is scanned from the start to correctly handle `>` characters
+// inside attribute values.
+func analyzeContext(text string, offset int) cursorContext {
+	var (
+		ret       cursorContext
+		state     = scanText
+		start     int
+		quote     byte
+		element   string
+		attribute string
+	)
+	// finishValue is called after an attribute value has been read completely.
+	finishValue := func(value string) {
+		if element == "a:component" && attribute == "name" {
+			ret.component = value
+		}
+	}
+	for i := 0; i < offset; i++ {
+		c := text[i]
+		switch state {
+		case scanText:
+			if c == '<' {
+				if strings.HasPrefix(text[i:], "<!--") {
+					state = scanComment
+					i += 3
+				} else {
+					state, start = scanElementName, i+1
+				}
+			}
+		case scanComment:
+			if strings.HasPrefix(text[i:], "-->") {
+				state = scanText
+				i += 2
+			}
+		case scanElementName:
+			if isSpace(c) || c == '>' || c == '/' && i > start {
+				element = strings.ToLower(text[start:i])
+				if element == "/a:component" {
+					ret.component = ""
+				}
+				if c == '>' {
+					state = scanText
+				} else {
+					state = scanTag
+				}
+			}
+		case scanTag:
+			if c == '>' {
+				state = scanText
+			} else if !isSpace(c) && c != '/' {
+				state, start = scanAttributeName, i
+			}
+		case scanAttributeName, scanAfterAttributeName:
+			if state == scanAttributeName {
+				if !isSpace(c) && c != '=' && c != '>' {
+					continue
+				}
+				attribute = strings.ToLower(text[start:i])
+			}
+			switch {
+			case c == '=':
+				state = scanBeforeValue
+			case c == '>':
+				state = scanText
+			case isSpace(c):
+				state = scanAfterAttributeName
+			default:
+				state, start = scanAttributeName, i
+			}
+		case scanBeforeValue:
+			if c == '"' || c == '\'' {
+				state, quote, start = scanValue, c, i+1
+			} else if c == '>' {
+				state = scanText
+			} else if !isSpace(c) {
+				state, quote, start = scanValue, 0, i
+			}
+		case scanValue:
+			if quote == 0 && (isSpace(c) || c == '>') || quote != 0 && c == quote {
+				finishValue(text[start:i])
+				if c == '>' {
+					state = scanText
+				} else {
+					state = scanTag
+				}
+			}
+		}
+	}
+
+	switch state {
+	case scanElementName:
+		ret.kind, ret.start = inElementName, start
+	case scanTag, scanAfterAttributeName:
+		ret.kind, ret.element, ret.start = inAttributeName, element, offset
+	case scanAttributeName:
+		ret.kind, ret.element, ret.start = inAttributeName, element, start
+	case scanBeforeValue:
+		ret.kind, ret.element, ret.attribute, ret.start =
+			inAttributeValue, element, attribute, offset
+	case scanValue:
+		ret.kind, ret.element, ret.attribute, ret.start =
+			inAttributeValue, element, attribute, start
+		end := offset
+		for end < len(text) {
+			c := text[end]
+			if quote == 0 && (isSpace(c) || c == '>') || quote != 0 && c == quote {
+				break
+			}
+			end++
+		}
+		ret.value = text[start:end]
+	}
+	return ret
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' ||
+		c >= '0' && c <= '9'
+}
+
+// identAt returns the start and end offset of the Go identifier in s that
+// contains or ends at the given offset.
+func identAt(s string, offset int) (start, end int) {
+	start, end = offset, offset
+	for start > 0 && isIdentChar(s[start-1]) {
+		start--
+	}
+	for end < len(s) && isIdentChar(s[end]) {
+		end++
+	}
+	return
+}
@@ -0,0 +1,361 @@
+// Package lsp implements a language server for askew files that communicates
+// via the Language Server Protocol.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flyx/askew/data"
+	"github.com/flyx/askew/packages"
+)
+
+// Analyzer processes the source files in the current directory. changed
+// contains the relative paths of the packages whose files have changed since
+// the previous call; if it is nil, all packages are discovered and processed.
+// An Analyzer may process the changed packages and the packages depending on
+// them only. It returns the resulting symbols, which are nil if processing
+// could not be started, and the diagnostics of all packages.
+type Analyzer func(changed []string) (*data.Symbols, *data.Diagnostics)
+
+// DefaultDelay is the time the server waits after a change of a document
+// for further changes before analyzing the sources.
+const DefaultDelay = 300 * time.Millisecond
+
+// Server is a language server that communicates with a single client.
+type Server struct {
+	in      *bufio.Reader
+	out     io.Writer
+	analyze Analyzer
+	// root is the absolute path of the directory askew runs on.
+	root string
+	// docs maps paths of open documents, relative to root, to their current
+	// content.
+	docs map[string]string
+	// syms are the symbols of the last analysis that got to process files.
+	syms *data.Symbols
+	// published contains the paths of all files diagnostics have been
+	// published for.
+	published map[string]struct{}
+	// Delay is the time the server waits after a change of a document for
+	// further changes before analyzing the sources. Requests that depend on
+	// the analysis are answered after analyzing pending changes immediately.
+	Delay time.Duration
+	// full is true if all packages must be analyzed by the next analysis.
+	full bool
+	// changed contains the packages that must be analyzed by the next
+	// analysis.
+	changed map[string]struct{}
+	pending <-chan time.Time
+}
+
+// NewServer creates a server that reads messages from in and writes messages
+// to out. The server runs on the current directory unless the client sends a
+// different root directory.
+func NewServer(in io.Reader, out io.Writer, analyze Analyzer) *Server {
+	root, _ := os.Getwd()
+	return &Server{in: bufio.NewReader(in), out: out, analyze: analyze,
+		root: root, docs: make(map[string]string),
+		published: make(map[string]struct{}), Delay: DefaultDelay,
+		changed: make(map[string]struct{})}
+}
+
+// Serve processes messages until the client sends the exit notification or
+// closes the input.
+func (s *Server) Serve() error {
+	reqs := make(chan *request)
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			req, err := readMessage(s.in)
+			if err != nil {
+				errs <- err
+				return
+			}
+			reqs <- req
+		}
+	}()
+	for {
+		select {
+		case req := <-reqs:
+			if req.Method == "exit" {
+				return nil
+			}
+			if err := s.dispatch(req); err != nil {
+				return err
+			}
+		case err := <-errs:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		case <-s.pending:
+			if err := s.flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Server) dispatch(req *request) error {
+	var (
+		result interface{}
+		err    *responseError
+	)
+	switch req.Method {
+	case "initialize":
+		result, err = s.initialize(req.Params)
+	case "initialized":
+		s.full = true
+		return s.flush()
+	case "shutdown":
+		break
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if json.Unmarshal(req.Params, &params) == nil {
+			if path, ok := s.relPath(params.TextDocument.URI); ok {
+				s.docs[path] = params.TextDocument.Text
+				s.update(path)
+			}
+		}
+		return nil
+	case "textDocument/didChange":
+		var params didChangeParams
+		if json.Unmarshal(req.Params, &params) == nil &&
+			len(params.ContentChanges) > 0 {
+			if path, ok := s.relPath(params.TextDocument.URI); ok {
+				// with full sync, the last change contains the whole document.
+				s.docs[path] = params.ContentChanges[len(params.ContentChanges)-1].Text
+				s.update(path)
+			}
+		}
+		return nil
+	case "textDocument/didSave":
+		var params didCloseParams
+		if json.Unmarshal(req.Params, &params) == nil {
+			if path, ok := s.relPath(params.TextDocument.URI); ok {
+				s.update(path)
+			}
+		}
+		return nil
+	case "textDocument/didClose":
+		var params didCloseParams
+		if json.Unmarshal(req.Params, &params) == nil {
+			if path, ok := s.relPath(params.TextDocument.URI); ok {
+				delete(s.docs, path)
+				s.update(path)
+			}
+		}
+		return nil
+	case "textDocument/completion":
+		if ferr := s.flush(); ferr != nil {
+			return ferr
+		}
+		var params textDocumentPositionParams
+		if json.Unmarshal(req.Params, &params) != nil {
+			err = &responseError{Code: codeInvalidParams, Message: "invalid params"}
+		} else {
+			result = s.complete(params)
+		}
+	case "textDocument/definition":
+		if ferr := s.flush(); ferr != nil {
+			return ferr
+		}
+		var params textDocumentPositionParams
+		if json.Unmarshal(req.Params, &params) != nil {
+			err = &responseError{Code: codeInvalidParams, Message: "invalid params"}
+		} else if loc := s.definition(params); loc != nil {
+			result = loc
+		}
+	default:
+		if req.ID == nil {
+			// unknown notifications may be ignored.
+			return nil
+		}
+		err = &responseError{Code: codeMethodNotFound,
+			Message: "method not found: " + req.Method}
+	}
+	if req.ID == nil {
+		return nil
+	}
+	if err != nil {
+		return writeMessage(s.out, errorResponse{JSONRPC: "2.0", ID: req.ID, Error: *err})
+	}
+	return writeMessage(s.out, response{JSONRPC: "2.0", ID: req.ID, Result: result})
+}
+
+func (s *Server) initialize(raw json.RawMessage) (interface{}, *responseError) {
+	var params initializeParams
+	if err := json.Unmarshal(raw, &params); err != nil {
+		return nil, &responseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	root := params.RootPath
+	if params.RootURI != "" {
+		if path, ok := uriToPath(params.RootURI); ok {
+			root = path
+		}
+	}
+	if root != "" {
+		if err := os.Chdir(root); err != nil {
+			return nil, &responseError{Code: codeRequestFailed,
+				Message: "cannot use root directory: " + err.Error()}
+		}
+		s.root = root
+	}
+	return map[string]interface{}{
+		"capabilities": map[string]interface{}{
+			"textDocumentSync": map[string]interface{}{
+				"openClose": true,
+				"change":    syncFull,
+				"save":      map[string]interface{}{},
+			},
+			"completionProvider": map[string]interface{}{
+				"triggerCharacters": []string{"<", " ", "\"", ":"},
+			},
+			"definitionProvider": true,
+		},
+		"serverInfo": map[string]string{"name": "askew"},
+	}, nil
+}
+
+// relPath returns the path of the file with the given URI relative to the
+// root directory. Fails for files outside of the root directory.
+func (s *Server) relPath(uri string) (string, bool) {
+	path, ok := uriToPath(uri)
+	if !ok {
+		return "", false
+	}
+	rel, err := filepath.Rel(s.root, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return rel, true
+}
+
+// text returns the content of the file with the given path relative to the
+// root directory, preferring the content of open documents.
+func (s *Server) text(path string) string {
+	if text, ok := s.docs[path]; ok {
+		return text
+	}
+	content, err := ioutil.ReadFile(filepath.Join(s.root, path))
+	if err != nil {
+		return ""
+	}
+	return string(content)
+}
+
+// update schedules an analysis of the package containing the file at the
+// given path, which is relative to the root directory.
+func (s *Server) update(path string) {
+	s.changed[filepath.Dir(path)] = struct{}{}
+	s.pending = time.After(s.Delay)
+}
+
+// flush analyzes the pending changes, using the content of open documents,
+// and publishes the resulting diagnostics. Does nothing if there are no
+// pending changes.
+func (s *Server) flush() error {
+	if !s.full && len(s.changed) == 0 {
+		return nil
+	}
+	var changed []string
+	if !s.full {
+		changed = make([]string, 0, len(s.changed))
+		for relPath := range s.changed {
+			changed = append(changed, relPath)
+		}
+		sort.Strings(changed)
+	}
+	s.full, s.changed, s.pending = false, make(map[string]struct{}), nil
+
+	packages.Overlay = make(map[string][]byte, len(s.docs))
+	for path, text := range s.docs {
+		packages.Overlay[path] = []byte(text)
+	}
+	syms, diag := s.analyze(changed)
+	packages.Overlay = nil
+	if syms != nil {
+		s.syms = syms
+	}
+	return s.publish(diag)
+}
+
+// publish sends the given diagnostics to the client. Diagnostics without a
+// file are sent as log messages. Files that had diagnostics previously but
+// have none now get an empty list so that the client clears them.
+func (s *Server) publish(diag *data.Diagnostics) error {
+	byFile := make(map[string][]diagnostic)
+	for _, item := range diag.Items {
+		severity, messageType := severityError, messageError
+		if item.Severity == data.SeverityWarning {
+			severity, messageType = severityWarning, messageWarning
+		}
+		if item.Pos.File == "" {
+			if err := writeMessage(s.out, notification{JSONRPC: "2.0",
+				Method: "window/logMessage", Params: logMessageParams{
+					Type: messageType, Message: item.Text()}}); err != nil {
+				return err
+			}
+			continue
+		}
+		byFile[item.Pos.File] = append(byFile[item.Pos.File], diagnostic{
+			Range: rangeOf(s.text(item.Pos.File), item.Pos), Severity: severity,
+			Source: "askew", Message: item.Text()})
+	}
+	for path := range s.published {
+		if _, ok := byFile[path]; !ok {
+			byFile[path] = []diagnostic{}
+		}
+	}
+	paths := make([]string, 0, len(byFile))
+	for path := range byFile {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	s.published = make(map[string]struct{})
+	for _, path := range paths {
+		if len(byFile[path]) > 0 {
+			s.published[path] = struct{}{}
+		}
+		if err := writeMessage(s.out, notification{JSONRPC: "2.0",
+			Method: "textDocument/publishDiagnostics", Params: publishDiagnosticsParams{
+				URI: pathToURI(filepath.Join(s.root, path)), Diagnostics: byFile[path]}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// file returns the processed file with the given path relative to the root
+// directory. Sets the current package and file of the symbols so that
+// identifiers can be resolved relative to the file.
+func (s *Server) file(path string) (*data.File, bool) {
+	if s.syms == nil {
+		return nil, false
+	}
+	pkgPath := filepath.Dir(path)
+	pkg, ok := s.syms.Packages[pkgPath]
+	if !ok {
+		return nil, false
+	}
+	s.syms.CurPkg = pkgPath
+	for _, f := range pkg.Files {
+		if f.Path == path {
+			s.syms.SetAskewFile(f)
+			return &f.File, true
+		}
+	}
+	if pkg.Site != nil && pkg.Site.Path == path {
+		s.syms.SetASiteFile(pkg.Site)
+		return &pkg.Site.File, true
+	}
+	return nil, false
+}
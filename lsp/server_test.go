@@ -0,0 +1,300 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/textproto"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/flyx/askew/data"
+	"github.com/flyx/askew/packages"
+)
+
+// message is any message sent by the server.
+type message struct {
+	ID     *json.RawMessage `json:"id"`
+	Method string           `json:"method"`
+	Params json.RawMessage  `json:"params"`
+	Result json.RawMessage  `json:"result"`
+}
+
+// analysis records a call of the fake analyzer.
+type analysis struct {
+	changed []string
+	overlay map[string]string
+}
+
+// client talks to a server running in the background. The server's analyzer
+// reports each call on analyses and returns the diagnostics sent to results.
+type client struct {
+	t        *testing.T
+	in       *io.PipeWriter
+	out      *bufio.Reader
+	done     chan error
+	analyses chan analysis
+	results  chan *data.Diagnostics
+	root     string
+}
+
+func start(t *testing.T, delay time.Duration) *client {
+	inR, inW := io.Pipe()
+	outR, outW := io.Pipe()
+	c := &client{t: t, in: inW, out: bufio.NewReader(outR), done: make(chan error, 1),
+		analyses: make(chan analysis, 16), results: make(chan *data.Diagnostics, 16)}
+	s := NewServer(inR, outW, func(changed []string) (*data.Symbols, *data.Diagnostics) {
+		overlay := make(map[string]string)
+		for path, content := range packages.Overlay {
+			overlay[path] = string(content)
+		}
+		c.analyses <- analysis{changed: changed, overlay: overlay}
+		select {
+		case diag := <-c.results:
+			return nil, diag
+		default:
+			return nil, &data.Diagnostics{}
+		}
+	})
+	s.Delay = delay
+	c.root = s.root
+	go func() {
+		c.done <- s.Serve()
+		outW.Close()
+	}()
+	return c
+}
+
+func (c *client) send(method string, id int, params interface{}) {
+	c.t.Helper()
+	msg := map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}
+	if id != 0 {
+		msg["id"] = id
+	}
+	if err := writeMessage(c.in, msg); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func (c *client) uri(path string) string {
+	return pathToURI(filepath.Join(c.root, path))
+}
+
+func (c *client) open(path, text string) {
+	c.t.Helper()
+	c.send("textDocument/didOpen", 0, map[string]interface{}{
+		"textDocument": map[string]string{"uri": c.uri(path), "text": text}})
+}
+
+func (c *client) change(path, text string) {
+	c.t.Helper()
+	c.send("textDocument/didChange", 0, map[string]interface{}{
+		"textDocument":   map[string]string{"uri": c.uri(path)},
+		"contentChanges": []map[string]string{{"text": text}}})
+}
+
+func (c *client) receive() message {
+	c.t.Helper()
+	read := make(chan error, 1)
+	var msg message
+	go func() {
+		body, err := readRaw(c.out)
+		if err == nil {
+			err = json.Unmarshal(body, &msg)
+		}
+		read <- err
+	}()
+	select {
+	case err := <-read:
+		if err != nil {
+			c.t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		c.t.Fatal("timeout while waiting for a message")
+	}
+	return msg
+}
+
+func (c *client) analysis() analysis {
+	c.t.Helper()
+	select {
+	case a := <-c.analyses:
+		return a
+	case <-time.After(5 * time.Second):
+		c.t.Fatal("timeout while waiting for an analysis")
+		return analysis{}
+	}
+}
+
+func (c *client) noAnalysis(d time.Duration) {
+	c.t.Helper()
+	select {
+	case a := <-c.analyses:
+		c.t.Fatalf("unexpected analysis of %v", a.changed)
+	case <-time.After(d):
+	}
+}
+
+func (c *client) exit() {
+	c.t.Helper()
+	c.send("exit", 0, nil)
+	if err := <-c.done; err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+// readRaw reads the body of a single message from r.
+func readRaw(r *bufio.Reader) ([]byte, error) {
+	header, err := textproto.NewReader(r).ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+	length, err := strconv.Atoi(header.Get("Content-Length"))
+	if err != nil {
+		return nil, err
+	}
+	body := make([]byte, length)
+	_, err = io.ReadFull(r, body)
+	return body, err
+}
+
+func TestInitialize(t *testing.T) {
+	c := start(t, time.Hour)
+	c.send("initialize", 1, map[string]interface{}{})
+	msg := c.receive()
+	if msg.ID == nil || string(*msg.ID) != "1" {
+		t.Fatalf("expected response to request 1, got %+v", msg)
+	}
+	var result struct {
+		Capabilities struct {
+			TextDocumentSync struct {
+				OpenClose bool `json:"openClose"`
+				Change    int  `json:"change"`
+			} `json:"textDocumentSync"`
+			DefinitionProvider bool `json:"definitionProvider"`
+		} `json:"capabilities"`
+	}
+	if err := json.Unmarshal(msg.Result, &result); err != nil {
+		t.Fatal(err)
+	}
+	caps := result.Capabilities
+	if !caps.TextDocumentSync.OpenClose || caps.TextDocumentSync.Change != syncFull ||
+		!caps.DefinitionProvider {
+		t.Errorf("unexpected capabilities: %s", msg.Result)
+	}
+
+	c.send("initialized", 0, map[string]interface{}{})
+	if a := c.analysis(); a.changed != nil {
+		t.Errorf("expected analysis of all packages, got %v", a.changed)
+	}
+	c.exit()
+}
+
+func TestChangesAreDebounced(t *testing.T) {
+	c := start(t, 100*time.Millisecond)
+	c.send("initialized", 0, map[string]interface{}{})
+	c.analysis()
+
+	c.open("a/x.askew", "<a:component name=\"x\"></a:component>")
+	c.change("a/x.askew", "1")
+	c.change("a/x.askew", "2")
+	c.change("a/x.askew", "3")
+	c.open("b/y.askew", "4")
+	a := c.analysis()
+	if !reflect.DeepEqual(a.changed, []string{"a", "b"}) {
+		t.Errorf("expected analysis of [a b], got %v", a.changed)
+	}
+	if a.overlay[filepath.Join("a", "x.askew")] != "3" ||
+		a.overlay[filepath.Join("b", "y.askew")] != "4" {
+		t.Errorf("analysis did not see current document content: %v", a.overlay)
+	}
+	c.noAnalysis(300 * time.Millisecond)
+
+	c.change("b/y.askew", "5")
+	if a := c.analysis(); !reflect.DeepEqual(a.changed, []string{"b"}) {
+		t.Errorf("expected analysis of [b], got %v", a.changed)
+	}
+	c.exit()
+}
+
+func TestRequestAnalyzesPendingChanges(t *testing.T) {
+	c := start(t, time.Hour)
+	c.open("a/x.askew", "<a:component name=\"x\"></a:component>")
+	c.send("textDocument/definition", 2, map[string]interface{}{
+		"textDocument": map[string]string{"uri": c.uri("a/x.askew")},
+		"position":     map[string]int{"line": 0, "character": 1}})
+	if a := c.analysis(); !reflect.DeepEqual(a.changed, []string{"a"}) {
+		t.Errorf("expected analysis of [a], got %v", a.changed)
+	}
+	if msg := c.receive(); msg.ID == nil || string(*msg.ID) != "2" {
+		t.Errorf("expected response to request 2, got %+v", msg)
+	}
+	c.exit()
+}
+
+func TestPublishDiagnostics(t *testing.T) {
+	c := start(t, time.Millisecond)
+	path := filepath.Join("a", "x.askew")
+	diag := &data.Diagnostics{}
+	diag.Add(&data.Error{Pos: data.Position{File: path, Line: 2, Column: 3},
+		Offset: -1, Message: "broken"})
+	diag.Warn(data.Position{File: path, Line: 1, Column: 1}, "suspicious")
+	diag.Add(&data.Error{Offset: -1, Message: "no file"})
+	c.results <- diag
+	c.open("a/x.askew", "first\nsecond\n")
+	c.analysis()
+
+	msg := c.receive()
+	var logParams logMessageParams
+	if err := json.Unmarshal(msg.Params, &logParams); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Method != "window/logMessage" || logParams.Message != "no file" ||
+		logParams.Type != messageError {
+		t.Errorf("expected log message for error without file, got %s %s", msg.Method, msg.Params)
+	}
+
+	msg = c.receive()
+	var params publishDiagnosticsParams
+	if err := json.Unmarshal(msg.Params, &params); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Method != "textDocument/publishDiagnostics" || params.URI != c.uri("a/x.askew") {
+		t.Fatalf("expected diagnostics for a/x.askew, got %s %s", msg.Method, msg.Params)
+	}
+	expected := []diagnostic{
+		{Range: textRange{Start: position{Line: 1, Character: 2}, End: position{Line: 1, Character: 6}},
+			Severity: severityError, Source: "askew", Message: "broken"},
+		{Range: textRange{Start: position{Line: 0, Character: 0}, End: position{Line: 0, Character: 5}},
+			Severity: severityWarning, Source: "askew", Message: "suspicious"},
+	}
+	if !reflect.DeepEqual(params.Diagnostics, expected) {
+		t.Errorf("unexpected diagnostics:\n  got:  %+v\n  want: %+v", params.Diagnostics, expected)
+	}
+
+	// the next analysis has no diagnostics, the file's list must be cleared.
+	c.change("a/x.askew", "fixed\n")
+	c.analysis()
+	msg = c.receive()
+	params = publishDiagnosticsParams{}
+	if err := json.Unmarshal(msg.Params, &params); err != nil {
+		t.Fatal(err)
+	}
+	if msg.Method != "textDocument/publishDiagnostics" || params.URI != c.uri("a/x.askew") ||
+		params.Diagnostics == nil || len(params.Diagnostics) != 0 {
+		t.Errorf("expected empty diagnostics for a/x.askew, got %s %s", msg.Method, msg.Params)
+	}
+
+	// nothing has been published for the file anymore, so it is not cleared
+	// again and the next message is the response to the following request.
+	c.change("a/x.askew", "still fixed\n")
+	c.analysis()
+	c.send("shutdown", 3, nil)
+	if msg := c.receive(); msg.ID == nil || string(*msg.ID) != "3" {
+		t.Errorf("expected response to request 3, got %s %s", msg.Method, msg.Params)
+	}
+	c.exit()
+}
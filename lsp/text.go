@@ -0,0 +1,89 @@
+package lsp
+
+import (
+	"net/url"
+	"path/filepath"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/flyx/askew/data"
+)
+
+// pathToURI converts an absolute file path into a file URI.
+func pathToURI(path string) string {
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+}
+
+// uriToPath converts a file URI into an absolute file path.
+func uriToPath(uri string) (string, bool) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return "", false
+	}
+	return filepath.FromSlash(u.Path), true
+}
+
+// lineStart returns the offset of the start of the given 0-based line.
+// Returns the length of text if text has less lines.
+func lineStart(text string, line int) int {
+	offset := 0
+	for ; line > 0; line-- {
+		next := strings.IndexByte(text[offset:], '\n')
+		if next == -1 {
+			return len(text)
+		}
+		offset += next + 1
+	}
+	return offset
+}
+
+// offsetOf returns the byte offset of the given LSP position in text.
+// LSP measures characters in UTF-16 code units.
+func offsetOf(text string, p position) int {
+	offset := lineStart(text, p.Line)
+	for units := 0; units < p.Character && offset < len(text); {
+		r, size := utf8.DecodeRuneInString(text[offset:])
+		if r == '\n' {
+			break
+		}
+		units += len(utf16.Encode([]rune{r}))
+		offset += size
+	}
+	return offset
+}
+
+// positionOf returns the LSP position of the given byte offset in text.
+func positionOf(text string, offset int) position {
+	var ret position
+	start := strings.LastIndexByte(text[:offset], '\n') + 1
+	ret.Line = strings.Count(text[:start], "\n")
+	for _, r := range text[start:offset] {
+		ret.Character += len(utf16.Encode([]rune{r}))
+	}
+	return ret
+}
+
+// rangeOf returns the range of the token starting at the given position in
+// text. The token ends at the next whitespace, `>` or `(`. The range is empty if
+// the position does not have a column.
+func rangeOf(text string, pos data.Position) textRange {
+	if pos.Line == 0 {
+		return textRange{}
+	}
+	start := lineStart(text, pos.Line-1)
+	if pos.Column == 0 {
+		p := positionOf(text, start)
+		return textRange{Start: p, End: p}
+	}
+	// the column counts characters while LSP counts UTF-16 code units.
+	for i := 1; i < pos.Column && start < len(text) && text[start] != '\n'; i++ {
+		_, size := utf8.DecodeRuneInString(text[start:])
+		start += size
+	}
+	end := start
+	for end < len(text) && !strings.ContainsRune(" \t\r\n>(", rune(text[end])) {
+		end++
+	}
+	return textRange{Start: positionOf(text, start), End: positionOf(text, end)}
+}
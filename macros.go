@@ -28,7 +28,7 @@ func (md *macroDiscovery) Process(n *html.Node) (descend bool, replacement *html
 	sd := slotDiscovery{slots: make([]data.Slot, 0, 16), syms: md.syms}
 	w := walker.Walker{TextNode: walker.Allow{}, StdElements: walker.Allow{},
 		Text: walker.Allow{}, Embed: walker.Allow{}, Construct: walker.Allow{},
-		Include: &includeProcessor{md.syms}, Slot: &sd}
+		Route: walker.Allow{}, Include: &includeProcessor{md.syms}, Slot: &sd}
 
 	first, last, err := w.WalkChildren(n, &walker.Siblings{Cur: n.FirstChild})
 	if err != nil {
@@ -38,7 +38,8 @@ func (md *macroDiscovery) Process(n *html.Node) (descend bool, replacement *html
 	if curFile.Macros == nil {
 		curFile.Macros = make(map[string]data.Macro)
 	}
-	curFile.Macros[name] = data.Macro{Slots: sd.slots, First: first, Last: last}
+	curFile.Macros[name] = data.Macro{Slots: sd.slots, First: first, Last: last,
+		Pos: data.PositionOf(n)}
 	// removes the macro and stops parent walker from descending
 	return false, &html.Node{Type: html.TextNode, Data: ""}, nil
 }
@@ -57,7 +58,7 @@ func (sd *slotDiscovery) Process(n *html.Node) (descend bool, replacement *html.
 
 	w := walker.Walker{TextNode: walker.Allow{}, StdElements: walker.Allow{},
 		Text: walker.Allow{}, Embed: walker.Allow{}, Construct: walker.Allow{},
-		Include: &includeProcessor{sd.syms}}
+		Route: walker.Allow{}, Include: &includeProcessor{sd.syms}}
 	n.FirstChild, n.LastChild, err = w.WalkChildren(n, &walker.Siblings{Cur: n.FirstChild})
 	return false, nil, err
 }
@@ -89,7 +90,8 @@ func (ip *includeProcessor) Process(n *html.Node) (descend bool, replacement *ht
 	ec := elmCopier{&instantiator}
 	instantiator.w =
 		walker.Walker{TextNode: textCopier{}, StdElements: &ec, Text: &ec,
-			Slot: &slotReplacer{&instantiator}, Embed: &ec, Construct: &ec}
+			Slot: &slotReplacer{&instantiator}, Embed: &ec, Construct: &ec,
+			Route: &ec}
 	replacement, _, err = instantiator.w.WalkChildren(nil, &walker.Siblings{Cur: m.First})
 	return
 }
@@ -132,7 +134,7 @@ func (vm *valueMapper) Process(n *html.Node) (descend bool, replacement *html.No
 	}
 	w := walker.Walker{TextNode: walker.Allow{}, StdElements: walker.Allow{},
 		Text: walker.Allow{}, Embed: walker.Allow{}, Construct: walker.Allow{},
-		Include: &includeProcessor{vm.syms}}
+		Route: walker.Allow{}, Include: &includeProcessor{vm.syms}}
 	n.FirstChild, n.LastChild, err = w.WalkChildren(n, &walker.Siblings{Cur: n.FirstChild})
 	return false, &html.Node{Type: html.CommentNode}, nil
 }
@@ -192,7 +194,8 @@ type unitDescender struct {
 func (cd *unitDescender) Process(n *html.Node) (descend bool, replacement *html.Node, err error) {
 	w := walker.Walker{TextNode: walker.Allow{}, StdElements: walker.Allow{}, Include: &includeProcessor{cd.syms},
 		Handlers: walker.Allow{}, Controller: walker.Allow{}, Data: walker.Allow{},
-		Embed: walker.Allow{}, Construct: walker.Allow{}, Text: walker.Allow{}}
+		Embed: walker.Allow{}, Construct: walker.Allow{}, Route: walker.Allow{},
+		Text: walker.Allow{}, Slot: walker.Allow{}}
 	n.FirstChild, n.LastChild, err = w.WalkChildren(n, &walker.Siblings{Cur: n.FirstChild})
 	return false, nil, err
 }
@@ -209,10 +212,11 @@ func processMacros(nodes []*html.Node, syms *data.Symbols) (dummyParent *html.No
 		dummyParent.FirstChild = nodes[0]
 		dummyParent.LastChild = nodes[len(nodes)-1]
 		w := walker.Walker{TextNode: walker.WhitespaceOnly{},
-			Component: &unitDescender{syms: syms},
-			Site:      &unitDescender{syms: syms},
-			Macro:     &macroDiscovery{syms: syms},
-			Import:    importRemover{}}
+			Component:   &unitDescender{syms: syms},
+			Site:        &unitDescender{syms: syms},
+			Macro:       &macroDiscovery{syms: syms},
+			Import:      importRemover{},
+			Diagnostics: syms.Diagnostics}
 		_, _, err = w.WalkChildren(dummyParent, &walker.NodeSlice{Items: nodes})
 	}
 	return
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/flyx/askew/data"
 	"github.com/flyx/askew/output"
 	"github.com/flyx/askew/packages"
 
@@ -15,6 +16,13 @@ import (
 )
 
 func main() {
+	args := os.Args
+	command := ""
+	if len(args) > 1 && (args[1] == "check" || args[1] == "lsp") {
+		// the command takes the place of the program name for option parsing.
+		command, args = args[1], args[1:]
+	}
+
 	outputOpt := getopt.StringLong(
 		"outputDir", 'o', ".", "output directory for index.html")
 	excludes := getopt.ListLong("exclude", 'e',
@@ -23,15 +31,21 @@ func main() {
 			"relative to the directory given at command line, or to cwd if no directory is given.")
 	backendOpt := getopt.StringLong(
 		"backend", 'b', "gopherjs", "backend to use; either `gopherjs` (default) or `wasm`")
-	data := getopt.StringLong("data", 'd', "", "path to a data file to use for *.askew.tmpl / *.asite.tmpl files")
-	getopt.Parse()
+	dataOpt := getopt.StringLong("data", 'd', "", "path to a data file to use for *.askew.tmpl / *.asite.tmpl files")
+	watchOpt := getopt.BoolLong("watch", 'w', "keep running and regenerate code whenever source files change")
+	goimportsOpt := getopt.BoolLong("goimports", 'g', "format generated code with goimports, which adds imports for packages used in Go code without <a:import>")
+	prerenderOpt := getopt.BoolLong("prerender", 'p', "render the direct embeds of sites into the HTML files, evaluating constant expressions; components adopt the rendered content at initialization")
+	nativeOpt := getopt.BoolLong("native", 'n', "with --prerender, evaluate the arguments of the direct embeds of sites by running the site's package natively")
+	ignoreUnusedOpt := getopt.BoolLong("no-unused-warnings", 0, "do not warn about handlers that are never captured")
+	formatOpt := getopt.StringLong("format", 'f', "text", "report format of the `check` command; either `text` (default) or `json`")
+	getopt.CommandLine.Parse(args)
 	var err error
 	outputDirPath, err := filepath.Abs(*outputOpt)
 	if err != nil {
 		panic(err)
 	}
 
-	args := getopt.Args()
+	args = getopt.Args()
 	if len(args) == 1 {
 		if err := os.Chdir(args[0]); err != nil {
 			os.Stdout.WriteString("[error] cannot process directory: " + err.Error() + "\n")
@@ -45,6 +59,13 @@ func main() {
 		os.Exit(1)
 	}
 
+	switch command {
+	case "check":
+		os.Exit(check(*excludes, *dataOpt, *formatOpt, *ignoreUnusedOpt))
+	case "lsp":
+		os.Exit(serveLSP(*excludes, *dataOpt, *ignoreUnusedOpt))
+	}
+
 	info, err := os.Stat(*outputOpt)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -69,48 +90,95 @@ func main() {
 		panic("unknown backend: `" + *backendOpt + "`")
 	}
 
-	var loadedData interface{}
-	if *data != "" {
-		raw, err := ioutil.ReadFile(*data)
-		if err != nil {
-			fmt.Printf("[error] %v\n", err.Error())
-			os.Exit(1)
-		}
-		if err = yaml.Unmarshal(raw, &loadedData); err != nil {
-			fmt.Printf("[error] %v\n", err.Error())
-			os.Exit(1)
-		}
+	if *watchOpt {
+		w := watcher{excludes: *excludes, dataPath: *dataOpt,
+			outputPath: outputDirPath, backend: backend, goimports: *goimportsOpt,
+			prerender: *prerenderOpt, native: *nativeOpt,
+			ignoreUnused: *ignoreUnusedOpt}
+		w.run()
+		return
 	}
 
-	base, err := packages.Discover(*excludes, loadedData)
+	loadedData, err := loadData(*dataOpt)
 	if err != nil {
-		os.Stdout.WriteString("[error] " + err.Error() + "\n")
+		fmt.Printf("[error] %v\n", err.Error())
 		os.Exit(1)
 	}
-	order, err := packages.Sort(base.ImportPath, base.Packages)
+
+	var diag data.Diagnostics
+	base, err := packages.Discover(*excludes, loadedData, &diag)
 	if err != nil {
-		os.Stdout.WriteString("[error] " + err.Error() + "\n")
+		reportError(err)
 		os.Exit(1)
 	}
+	order, err := packages.Sort(base.ImportPath, base.Packages)
+	if err != nil {
+		diag.Add(err)
+		os.Exit(reportDiagnostics(&diag))
+	}
 
-	var p processor
-	p.init(base)
-	for _, path := range order {
-		if err := p.processMacros(path); err != nil {
-			os.Stdout.WriteString("[error] " + err.Error() + "\n")
-			os.Exit(1)
+	p := processor{goimports: *goimportsOpt, prerender: *prerenderOpt,
+		native: *nativeOpt, ignoreUnused: *ignoreUnusedOpt}
+	p.init(base, &diag)
+	if p.process(order) {
+		os.Stdout.WriteString("[info] generating code\n")
+		if err := p.dump(order, outputDirPath, backend); err != nil {
+			diag.Add(err)
+		} else {
+			p.typecheck(p.packages())
 		}
 	}
-	for _, path := range order {
-		if err := p.processComponents(path); err != nil {
-			os.Stdout.WriteString("[error] " + err.Error() + "\n")
-			os.Exit(1)
+	os.Exit(reportDiagnostics(&diag))
+}
+
+// reportError writes the given error to stdout. Errors that are associated
+// with a position are written in the format `file:line:col: message` which is
+// understood by editors.
+func reportError(err error) {
+	if e, ok := err.(*data.Error); ok {
+		os.Stdout.WriteString(e.Error() + "\n")
+	} else {
+		os.Stdout.WriteString("[error] " + err.Error() + "\n")
+	}
+}
+
+// reportDiagnostics writes all recorded diagnostics to stdout, followed by a
+// summary. Diagnostics with a position are written in the format
+// `file:line:col: severity: message`. Returns the exit code for the process,
+// which is non-zero if any errors have been recorded.
+func reportDiagnostics(diag *data.Diagnostics) int {
+	for _, item := range diag.Items {
+		if item.Pos.File == "" {
+			os.Stdout.WriteString("[" + item.Severity.String() + "] " +
+				item.Text() + "\n")
+		} else {
+			os.Stdout.WriteString(item.Pos.String() + ": " +
+				item.Severity.String() + ": " + item.Text() + "\n")
 		}
 	}
+	errors := diag.Count(data.SeverityError)
+	warnings := diag.Count(data.SeverityWarning)
+	if errors+warnings > 0 {
+		fmt.Printf("[info] %d error(s), %d warning(s)\n", errors, warnings)
+	}
+	if errors > 0 {
+		return 1
+	}
+	return 0
+}
 
-	os.Stdout.WriteString("[info] generating code\n")
-	if err := p.dump(outputDirPath, backend); err != nil {
-		os.Stdout.WriteString("[error] " + err.Error() + "\n")
-		os.Exit(1)
+// loadData loads the YAML data file at the given path. It returns nil if path
+// is empty.
+func loadData(path string) (interface{}, error) {
+	var loadedData interface{}
+	if path != "" {
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err = yaml.Unmarshal(raw, &loadedData); err != nil {
+			return nil, err
+		}
 	}
+	return loadedData, nil
 }
@@ -2,59 +2,98 @@ package output
 
 import (
 	"bytes"
+	"errors"
 	"go/build"
-	"io"
+	"go/format"
+	"go/scanner"
 	"io/ioutil"
-	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
-)
+	"strings"
 
-var goimportsPath = filepath.Join(build.Default.GOPATH, "bin", "goimports")
+	"github.com/flyx/askew/data"
+)
 
-func init() {
-	info, err := os.Stat(goimportsPath)
-	if os.IsNotExist(err) {
-		panic("`goimports` missing, please install via `go get golang.org/x/tools/cmd/goimports`")
+// goimportsPath returns the path to the goimports executable. It is searched
+// in PATH and in $GOPATH/bin.
+func goimportsPath() (string, error) {
+	if path, err := exec.LookPath("goimports"); err == nil {
+		return path, nil
 	}
+	path := filepath.Join(build.Default.GOPATH, "bin", "goimports")
+	info, err := os.Stat(path)
 	if err != nil {
-		panic("failed to access `goimports`: " + err.Error())
+		if os.IsNotExist(err) {
+			return "", errors.New("`goimports` missing, please install via `go install golang.org/x/tools/cmd/goimports@latest`")
+		}
+		return "", errors.New("failed to access `goimports`: " + err.Error())
 	}
 	if !info.Mode().IsRegular() {
-		panic("`goimports` is not a regular file")
+		return "", errors.New("`goimports` is not a regular file")
 	}
+	return path, nil
 }
 
-func writeFormatted(goCode string, file string) {
-	fmtcmd := exec.Command(goimportsPath)
+// runGoimports formats the given code with goimports, which also adds imports
+// for packages referenced in the code that have not been imported.
+func runGoimports(goCode []byte) ([]byte, error) {
+	path, err := goimportsPath()
+	if err != nil {
+		return nil, err
+	}
+	fmtcmd := exec.Command(path)
 
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
+	fmtcmd.Stdin = bytes.NewReader(goCode)
 	fmtcmd.Stdout = &stdout
 	fmtcmd.Stderr = &stderr
 
-	stdin, err := fmtcmd.StdinPipe()
-	if err != nil {
-		panic("unable to create stdin pipe: " + err.Error())
+	if err := fmtcmd.Run(); err != nil {
+		return nil, errors.New("goimports failed: " + err.Error() + "\n" +
+			strings.TrimSpace(stderr.String()))
 	}
-	err = fmtcmd.Start()
-	if err != nil {
-		panic("unable to start goimports process for formatting the code: " + err.Error())
+	return stdout.Bytes(), nil
+}
+
+// formatError returns an error describing the given error that occurred while
+// formatting the given code. If the error is a syntax error in code that
+// originates from a source file, the error is located there.
+func formatError(goCode []byte, file string, err error) error {
+	list, ok := err.(scanner.ErrorList)
+	if !ok || len(list) == 0 {
+		return errors.New("failed to format code for " + file + ": " + err.Error())
+	}
+	first := list[0]
+	if origin, ok := ReadSourceMap(goCode)[first.Pos.Line]; ok && origin.Pos.File != "" {
+		msg := "syntax error: " + first.Msg
+		if origin.Attr != "" {
+			msg = "in `" + origin.Attr + "`: " + msg
+		}
+		return &data.Error{Pos: origin.Pos, Offset: -1, Message: msg}
 	}
-	io.WriteString(stdin, goCode)
-	stdin.Close()
+	return errors.New("failed to format code for " + file + ": " + first.Error())
+}
 
-	if err := fmtcmd.Wait(); err != nil {
-		log.Println("error while formatting: " + err.Error())
-		log.Println("stderr output:")
-		log.Println(stderr.String())
-		log.Println("input:")
-		log.Println(goCode)
-		panic("failed to format Go code")
+// writeFormatted formats the given Go code and writes it to the given file.
+// Code is formatted with go/format, or with goimports if useGoimports is set.
+func writeFormatted(goCode string, file string, useGoimports bool) error {
+	src := []byte(goCode)
+	var formatted []byte
+	var err error
+	if useGoimports {
+		formatted, err = runGoimports(src)
+	} else if formatted, err = format.Source(src); err != nil {
+		err = formatError(src, file, err)
+	}
+	if err != nil {
+		return err
 	}
 
-	if err := ioutil.WriteFile(file, stdout.Bytes(), os.ModePerm); err != nil {
-		panic("failed to write file '" + file + "': " + err.Error())
+	if err := ioutil.WriteFile(file, addLineDirectives(formatted, file),
+		os.ModePerm); err != nil {
+		return errors.New("failed to write file '" + file + "': " + err.Error())
 	}
+	return nil
 }
@@ -1,6 +1,8 @@
 package output
 
 import (
+	"go/scanner"
+	"go/token"
 	"strconv"
 	"strings"
 
@@ -54,6 +56,21 @@ func wrapperForType(t data.ParamType) string {
 		return "askew.BoolValue"
 	case data.JSValueType:
 		return "askew.RawValue"
+	case data.ArrayType:
+		if t.ValueType.Kind == data.StringType {
+			return "askew.StringSliceValue"
+		}
+	case data.NamedType:
+		switch t.Name {
+		case "float64":
+			return "askew.FloatValue"
+		case "time.Time":
+			return "askew.TimeValue"
+		}
+		if t.IsPredeclared() {
+			break
+		}
+		return "askew.CustomValue[" + t.String() + ", *" + t.String() + "]"
 	}
 	panic("no wrapper for type: " + t.String())
 }
@@ -84,7 +101,34 @@ func fieldType(e data.Embed) string {
 	return b.String()
 }
 
+// blockEmbeds returns the embeds of the given block and of all control blocks
+// nested in it.
+func blockEmbeds(b *data.ControlBlock) []data.Embed {
+	ret := append([]data.Embed(nil), b.Embeds...)
+	for _, c := range b.Controlled {
+		ret = append(ret, blockEmbeds(c)...)
+	}
+	return ret
+}
+
+// stripPositions removes the position attributes that have been added while
+// loading the source files from the given node and all its descendants.
+func stripPositions(n *html.Node) {
+	if n.Type == html.ElementNode {
+		for i := range n.Attr {
+			if n.Attr[i].Key == data.PositionAttr {
+				n.Attr = append(n.Attr[:i], n.Attr[i+1:]...)
+				break
+			}
+		}
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		stripPositions(c)
+	}
+}
+
 func renderTemplateHTML(n *html.Node) string {
+	stripPositions(n)
 	var w strings.Builder
 	html.Render(&w, n)
 	var ret strings.Builder
@@ -97,3 +141,52 @@ func renderTemplateHTML(n *html.Node) string {
 	}
 	return ret.String()
 }
+
+// markup returns a Go string literal containing the HTML rendering of the
+// children of the given node.
+func markup(n *html.Node) string {
+	stripPositions(n)
+	var w strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		html.Render(&w, c)
+	}
+	return strconv.Quote(w.String())
+}
+
+// slotValue returns an expression that creates the given content of a slot.
+func slotValue(c data.SlotContent) string {
+	if c.HTML != nil {
+		return "askew.NewMarkup(" + markup(c.HTML) + ")"
+	}
+	return c.Construct.ConstructorName + "(" + c.Construct.Args.Raw + ")"
+}
+
+// routeVars returns the parameters of the given route that are referenced in
+// its arguments. Like importSet.addExpr, this considers every identifier that
+// is not preceded by a `.`.
+func routeVars(r data.Route) []string {
+	src := []byte(r.Args.Raw)
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+	var sc scanner.Scanner
+	sc.Init(file, src, nil, 0)
+	used := make(map[string]bool)
+	prev := token.ILLEGAL
+	for {
+		_, tok, lit := sc.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok == token.IDENT && prev != token.PERIOD {
+			used[lit] = true
+		}
+		prev = tok
+	}
+	var ret []string
+	for _, p := range r.Params {
+		if used[p] {
+			ret = append(ret, p)
+		}
+	}
+	return ret
+}
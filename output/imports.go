@@ -0,0 +1,209 @@
+package output
+
+import (
+	"go/scanner"
+	"go/token"
+	"strings"
+
+	"github.com/flyx/askew/data"
+)
+
+// runtimePath is the import path of the package that generated code uses via
+// the `askew` identifier.
+const runtimePath = "github.com/flyx/askew/runtime"
+
+// jsPath is the import path of the package that generated code uses via the
+// `js` identifier. It forwards to syscall/js when compiling for the browser.
+const jsPath = runtimePath + "/js"
+
+// importSet collects the names of all packages that are referenced by
+// generated code.
+type importSet map[string]struct{}
+
+// addExpr adds all package names referenced by the given Go expression.
+// Since the expression is not type-checked, every identifier that is followed
+// by a `.` and not preceded by one is considered to be a package name.
+// This is fine since the names are only used to filter declared imports.
+func (s importSet) addExpr(expr string) {
+	src := []byte(expr)
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+	var sc scanner.Scanner
+	sc.Init(file, src, nil, 0)
+	prev, cur := token.ILLEGAL, token.ILLEGAL
+	var curLit string
+	for {
+		_, tok, lit := sc.Scan()
+		if tok == token.PERIOD && cur == token.IDENT && prev != token.PERIOD {
+			s[curLit] = struct{}{}
+		}
+		if tok == token.EOF {
+			break
+		}
+		prev, cur, curLit = cur, tok, lit
+	}
+}
+
+func (s importSet) addType(t *data.ParamType) {
+	if t == nil {
+		return
+	}
+	switch t.Kind {
+	case data.NamedType:
+		s.addExpr(t.Name)
+		for _, a := range t.TypeArgs {
+			s.addType(a)
+		}
+	case data.JSValueType:
+		s["js"] = struct{}{}
+	case data.FuncType:
+		for i := range t.Params {
+			s.addType(t.Params[i].Type)
+		}
+	}
+	s.addType(t.KeyType)
+	s.addType(t.ValueType)
+}
+
+func (s importSet) addHandler(h data.Handler) {
+	for i := range h.Params {
+		s.addType(h.Params[i].Type)
+	}
+	s.addType(h.Returns)
+}
+
+func (s importSet) addBlock(b *data.Block) {
+	for _, a := range b.Assignments {
+		s.addExpr(a.Expression)
+	}
+	for _, c := range b.Controlled {
+		s.addExpr(c.Expression)
+		s.addBlock(&c.Block)
+	}
+}
+
+func (s importSet) addUnit(u *data.Unit) {
+	s.addBlock(&u.Block)
+	for _, v := range u.Variables {
+		// the type of a variable is only referenced by its wrapper.
+		s.addExpr(wrapperForType(*v.Variable.Type))
+	}
+	for _, e := range u.Embeds {
+		if e.Ns != "" {
+			s[e.Ns] = struct{}{}
+		}
+		s.addExpr(e.Args.Raw)
+		s.addExpr(e.Value)
+		for _, c := range e.ConstructorCalls {
+			s.addExpr(c.ConstructorName)
+			s.addExpr(c.Args.Raw)
+			s.addExpr(c.Expression)
+		}
+		for _, c := range e.SlotContents {
+			if c.Construct != nil {
+				s.addExpr(c.Construct.ConstructorName)
+				s.addExpr(c.Construct.Args.Raw)
+			}
+		}
+		for _, r := range e.Routes {
+			s.addExpr(r.ConstructorName)
+			s.addExpr(r.Args.Raw)
+		}
+	}
+}
+
+func (s importSet) addComponent(c *data.Component) {
+	// every component has a template and component data.
+	s["js"] = struct{}{}
+	s["askew"] = struct{}{}
+	s.addUnit(&c.Unit)
+	for i := range c.Parameters {
+		s.addType(&c.Parameters[i].Type)
+	}
+	for _, f := range c.Fields {
+		s.addType(f.Type)
+		if f.DefaultValue != nil {
+			s.addExpr(*f.DefaultValue)
+		}
+	}
+	for _, h := range c.Handlers {
+		s.addHandler(h)
+	}
+	for _, m := range c.Controller {
+		s.addHandler(m.Handler)
+	}
+	for _, capture := range c.Captures {
+		for _, m := range capture.Mappings {
+			for _, p := range m.ParamMappings {
+				if p.Value.Kind == data.BoundExpr {
+					s.addExpr(p.Value.IDs[0])
+				}
+			}
+		}
+	}
+}
+
+// filter returns the subset of the given declared imports that is used, plus
+// the imports of the runtime packages if they are used.
+func (s importSet) filter(declared map[string]string) map[string]string {
+	ret := make(map[string]string)
+	for alias, path := range declared {
+		if _, ok := s[alias]; ok {
+			ret[alias] = path
+		}
+	}
+	if _, ok := s["js"]; ok {
+		ret["js"] = jsPath
+	}
+	if _, ok := s["askew"]; ok {
+		ret["askew"] = runtimePath
+	}
+	return ret
+}
+
+// askewFileImports returns the imports required by the code generated for
+// the given file.
+func askewFileImports(f *data.AskewFile) map[string]string {
+	s := make(importSet)
+	for _, c := range f.Components {
+		s.addComponent(c)
+	}
+	return s.filter(f.Imports)
+}
+
+// siteFileImports returns the imports required by the code generated for the
+// given site.
+func siteFileImports(f *data.ASiteFile) map[string]string {
+	s := make(importSet)
+	// the init func queries the document.
+	s["js"] = struct{}{}
+	if len(f.Embeds) > 0 {
+		s["askew"] = struct{}{}
+	}
+	s.addUnit(&f.Unit)
+	return s.filter(f.Imports)
+}
+
+// importBlock formats the given imports for use inside an import
+// declaration. Imports of the standard library are grouped before all other
+// imports.
+func importBlock(imports map[string]string) string {
+	var std, other []string
+	for alias, path := range imports {
+		line := "\t\"" + path + "\""
+		if path[strings.LastIndexByte(path, '/')+1:] != alias {
+			line = "\t" + alias + " \"" + path + "\""
+		}
+		// paths of the standard library have no dot in their first element.
+		if first := strings.SplitN(path, "/", 2)[0]; strings.ContainsRune(first, '.') {
+			other = append(other, line)
+		} else {
+			std = append(std, line)
+		}
+	}
+	if len(std) > 0 && len(other) > 0 {
+		std = append(std, "")
+	}
+	// go/format sorts the imports of each group.
+	return strings.Join(append(std, other...), "\n")
+}
@@ -0,0 +1,113 @@
+package output
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/flyx/askew/data"
+)
+
+// nativeTag is the build tag that enables the file generated by nativeArgs.
+const nativeTag = "askew_prerender"
+
+// nativeArgPrefix starts each line of the native program's output that
+// contains the value of an argument. Other output, e.g. of the site's own
+// package initialization, is ignored.
+const nativeArgPrefix = "askew:arg "
+
+// prerenderable returns true iff the given embed of a site is rendered by the
+// prerenderer.
+func prerenderable(e data.Embed) bool {
+	return e.Kind == data.DirectEmbed && e.Value == "" && e.Target != nil &&
+		!e.Block
+}
+
+// nativeArgs evaluates the arguments of the site's direct embeds by running
+// the site's package natively, i.e. for the platform askew runs on, where the
+// runtime uses the in-memory DOM. The package must be a main package that
+// builds natively. It is run with the build tag askew_prerender and exits
+// before any init func runs, so that neither the site's init code nor its
+// main func are executed.
+//
+// The result maps the index of each embed in f.Embeds to the values of its
+// arguments, given as Go literals. Arguments whose value does not have a basic
+// type are given as empty string.
+func nativeArgs(f *data.ASiteFile, pw *PackageWriter) (map[int][]string, error) {
+	if pw.PackageName != "main" {
+		return nil, errors.New("package `" + pw.PackageName +
+			"` is not a main package")
+	}
+	args := make([]string, len(f.Embeds))
+	s := make(importSet)
+	s["fmt"], s["math"], s["os"], s["reflect"], s["strconv"] =
+		struct{}{}, struct{}{}, struct{}{}, struct{}{}, struct{}{}
+	declared := map[string]string{"fmt": "fmt", "math": "math", "os": "os",
+		"reflect": "reflect", "strconv": "strconv"}
+	for alias, path := range f.Imports {
+		declared[alias] = path
+	}
+	for i, e := range f.Embeds {
+		if prerenderable(e) && e.Args.Count > 0 {
+			args[i] = e.Args.Raw
+			s.addExpr(e.Args.Raw)
+		}
+	}
+
+	b := strings.Builder{}
+	b.WriteString("//go:build " + nativeTag + "\n")
+	if err := fileHeader.Execute(&b, struct {
+		PackageName string
+		Imports     map[string]string
+	}{pw.PackageName, s.filter(declared)}); err != nil {
+		return nil, err
+	}
+	if err := prerenderArgs.Execute(&b, args); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(pw.RelPath, f.BaseName+".asite.prerender.go")
+	if err := writeFormatted(b.String(), path, pw.Goimports); err != nil {
+		return nil, err
+	}
+	defer os.Remove(path)
+
+	cmd := exec.Command("go", "run", "-tags", nativeTag, ".")
+	cmd.Dir = pw.RelPath
+	cmd.Env = append(os.Environ(), "GOOS="+runtime.GOOS, "GOARCH="+runtime.GOARCH)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, errors.New("`go run` failed: " + err.Error() + "\n" +
+			strings.TrimSpace(stderr.String()))
+	}
+
+	ret := make(map[int][]string)
+	sc := bufio.NewScanner(&stdout)
+	for sc.Scan() {
+		line := sc.Text()
+		if !strings.HasPrefix(line, nativeArgPrefix) {
+			continue
+		}
+		items := strings.SplitN(line[len(nativeArgPrefix):], " ", 3)
+		if len(items) != 3 {
+			continue
+		}
+		embed, err1 := strconv.Atoi(items[0])
+		arg, err2 := strconv.Atoi(items[1])
+		if err1 != nil || err2 != nil || embed < 0 || embed >= len(f.Embeds) ||
+			arg < 0 || arg >= f.Embeds[embed].Args.Count {
+			continue
+		}
+		if ret[embed] == nil {
+			ret[embed] = make([]string, f.Embeds[embed].Args.Count)
+		}
+		ret[embed][arg] = items[2]
+	}
+	return ret, sc.Err()
+}
@@ -26,6 +26,17 @@ type PackageWriter struct {
 	Syms        *data.Symbols
 	PackageName string
 	RelPath     string
+	// Goimports specifies whether generated code is formatted with goimports
+	// instead of go/format. This adds imports for packages that are referenced
+	// in Go code without being declared in <a:import>.
+	Goimports bool
+	// Prerender specifies whether the direct embeds of a site are rendered
+	// into its HTML file, to be adopted by the components at initialization.
+	Prerender bool
+	// Native specifies whether the arguments of the embeds rendered by
+	// Prerender are evaluated by running the site's package natively. This
+	// requires that the packages the site depends on have been written.
+	Native bool
 }
 
 // WriteFile writes a file of the package.
@@ -34,7 +45,7 @@ func (pw *PackageWriter) WriteFile(f *data.AskewFile) error {
 	if err := fileHeader.Execute(&b, struct {
 		PackageName string
 		Imports     map[string]string
-	}{pw.PackageName, f.Imports}); err != nil {
+	}{pw.PackageName, askewFileImports(f)}); err != nil {
 		return err
 	}
 
@@ -48,8 +59,8 @@ func (pw *PackageWriter) WriteFile(f *data.AskewFile) error {
 		return err
 	}
 
-	writeFormatted(b.String(), filepath.Join(pw.RelPath, f.BaseName+".askew.go"))
-	return nil
+	return writeFormatted(b.String(),
+		filepath.Join(pw.RelPath, f.BaseName+".askew.go"), pw.Goimports)
 }
 
 // WriteSite writes a file init.go in the site's package, and the HTML file
@@ -61,15 +72,21 @@ func (pw *PackageWriter) WriteSite(f *data.ASiteFile, outputPath string,
 	if err := fileHeader.Execute(&b, struct {
 		PackageName string
 		Imports     map[string]string
-	}{pw.PackageName, f.Imports}); err != nil {
+	}{pw.PackageName, siteFileImports(f)}); err != nil {
 		return err
 	}
 
-	if err := site.Execute(&b, f); err != nil {
+	if err := site.Execute(&b, struct {
+		*data.ASiteFile
+		Prerender bool
+	}{f, pw.Prerender}); err != nil {
 		return err
 	}
 
-	writeFormatted(b.String(), filepath.Join(pw.RelPath, f.BaseName+".asite.go"))
+	if err := writeFormatted(b.String(),
+		filepath.Join(pw.RelPath, f.BaseName+".asite.go"), pw.Goimports); err != nil {
+		return err
+	}
 
 	// HTML file
 	node := f.RootNode()
@@ -132,10 +149,25 @@ func (pw *PackageWriter) WriteSite(f *data.ASiteFile, outputPath string,
 		node.LastChild = node.LastChild.NextSibling
 	}
 
+	var values map[int][]string
+	if pw.Prerender && pw.Native {
+		var err error
+		if values, err = nativeArgs(f, pw); err != nil {
+			pw.Syms.Diagnostics.Warn(data.Position{File: f.Path},
+				"unable to evaluate arguments natively, only constant arguments are prerendered: "+
+					err.Error())
+		}
+	}
+
 	htmlFile, err := os.Create(filepath.Join(outputPath, f.HTMLFile))
 	if err != nil {
 		return err
 	}
+	insertStyles(f)
+	if pw.Prerender {
+		prerenderSite(f, values)
+	}
+	stripPositions(f.Document)
 	html.Render(htmlFile, f.Document)
 	htmlFile.Close()
 
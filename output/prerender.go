@@ -0,0 +1,277 @@
+package output
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/flyx/askew/data"
+	"github.com/flyx/net/html"
+)
+
+// Comments that delimit prerendered content in the HTML of a site. They are
+// consumed by the hydration code of the runtime.
+const (
+	// componentStart is followed by the name of the component.
+	componentStart = "askew:component "
+	componentEnd   = "/askew:component"
+	// dynamicStart and dynamicEnd enclose content that replaces a single node
+	// of the component's template, e.g. the text of an <a:text>.
+	dynamicStart = "askew:dynamic"
+	dynamicEnd   = "/askew:dynamic"
+)
+
+// reflectedProperties maps DOM properties that may be given in prop() to the
+// HTML attributes they reflect.
+var reflectedProperties = map[string]string{
+	"id": "id", "title": "title", "value": "value", "href": "href",
+	"src": "src", "alt": "alt", "placeholder": "placeholder", "name": "name",
+	"type": "type", "checked": "checked", "disabled": "disabled",
+	"selected": "selected", "hidden": "hidden", "className": "class",
+	"htmlFor": "for",
+}
+
+// prerenderer renders components into the HTML document of a site.
+type prerenderer struct {
+	fset *token.FileSet
+}
+
+// prerenderSite inserts the content of all direct embeds of the site into
+// its document. values contains the arguments of the embeds that have been
+// evaluated by nativeArgs and may be nil. All other arguments, and all
+// expressions inside the components, are only evaluated if they are constant.
+func prerenderSite(f *data.ASiteFile, values map[int][]string) {
+	p := prerenderer{fset: token.NewFileSet()}
+	p.embeds(f.RootNode(), f.Embeds, types.NewPackage("site", "site"), values)
+}
+
+// embeds renders the direct embeds of a unit whose content is root. scope
+// holds the constants that are available for evaluating the embeds' args,
+// values holds evaluated args by embed index and may be nil.
+func (p *prerenderer) embeds(root *html.Node, embeds []data.Embed,
+	scope *types.Package, values map[int][]string) {
+	// resolve all placeholders first since rendering changes the paths.
+	placeholders := make([]*html.Node, len(embeds))
+	for i, e := range embeds {
+		if prerenderable(e) {
+			placeholders[i] = walkNode(root, e.Path)
+		}
+	}
+	for i, e := range embeds {
+		if placeholders[i] == nil {
+			continue
+		}
+		parent := placeholders[i].Parent
+		parent.InsertBefore(&html.Node{Type: html.CommentNode,
+			Data: componentStart + e.Target.Name}, placeholders[i])
+		content := p.component(e.Target,
+			p.params(e.Target, e.Args, scope, values[i]))
+		for content.FirstChild != nil {
+			n := content.FirstChild
+			content.RemoveChild(n)
+			parent.InsertBefore(n, placeholders[i])
+		}
+		parent.InsertBefore(&html.Node{Type: html.CommentNode,
+			Data: componentEnd}, placeholders[i])
+	}
+}
+
+// params returns a package whose scope contains a constant for each
+// parameter of cmp whose argument is a constant expression or has been
+// evaluated. values contains the evaluated arguments as Go literals, or an
+// empty string for an argument that has not been evaluated. It may be nil.
+func (p *prerenderer) params(cmp *data.Component, args data.Arguments,
+	scope *types.Package, values []string) *types.Package {
+	ret := types.NewPackage(cmp.Name, cmp.Name)
+	if args.Count == 0 {
+		return ret
+	}
+	src := "f(" + args.Raw + ")"
+	expr, err := parser.ParseExpr(src)
+	if err != nil {
+		return ret
+	}
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != len(cmp.Parameters) {
+		return ret
+	}
+	for i, arg := range call.Args {
+		expr := src[arg.Pos()-1 : arg.End()-1]
+		if i < len(values) && values[i] != "" {
+			expr = values[i]
+		}
+		tv, err := types.Eval(p.fset, scope, token.NoPos, expr)
+		if err != nil || tv.Value == nil {
+			continue
+		}
+		ret.Scope().Insert(types.NewConst(token.NoPos, ret,
+			cmp.Parameters[i].Name, tv.Type, tv.Value))
+	}
+	return ret
+}
+
+// component returns a copy of the component's template with all assignments
+// applied whose values are constant. a:if and a:for blocks are left empty.
+func (p *prerenderer) component(cmp *data.Component,
+	scope *types.Package) *html.Node {
+	root := cloneNode(cmp.Template)
+	// resolve all targets first since rendering changes the paths.
+	assignments := make([]*html.Node, len(cmp.Assignments))
+	for i, a := range cmp.Assignments {
+		assignments[i] = walkNode(root, a.Path)
+	}
+	blocks := make([]*html.Node, len(cmp.Controlled))
+	for i, b := range cmp.Controlled {
+		blocks[i] = walkNode(root, b.Path)
+	}
+
+	p.embeds(root, cmp.Embeds, scope, nil)
+	for i, a := range cmp.Assignments {
+		tv, err := types.Eval(p.fset, scope, token.NoPos, a.Expression)
+		if err != nil || tv.Value == nil || a.Signal {
+			if a.Target.Kind == data.BoundSelf {
+				replaceDynamic(assignments[i])
+			}
+			continue
+		}
+		assign(assignments[i], a.Target, tv.Value)
+	}
+	for _, n := range blocks {
+		replaceDynamic(n)
+	}
+	return root
+}
+
+// assign applies the given value to the target at node n.
+func assign(n *html.Node, target data.BoundValue, value constant.Value) {
+	switch target.Kind {
+	case data.BoundSelf:
+		replaceDynamic(n, &html.Node{Type: html.TextNode,
+			Data: constantString(value)})
+	case data.BoundProperty:
+		switch target.ID() {
+		case "textContent", "innerText":
+			for n.FirstChild != nil {
+				n.RemoveChild(n.FirstChild)
+			}
+			n.AppendChild(&html.Node{Type: html.TextNode,
+				Data: constantString(value)})
+		default:
+			if name, ok := reflectedProperties[target.ID()]; ok {
+				if value.Kind() == constant.Bool {
+					if constant.BoolVal(value) {
+						setAttr(n, name, "")
+					}
+				} else {
+					setAttr(n, name, constantString(value))
+				}
+			}
+		}
+	case data.BoundStyle:
+		style := attrVal(n, "style")
+		if style != "" && !strings.HasSuffix(style, ";") {
+			style += ";"
+		}
+		setAttr(n, "style", style+kebabCase(target.ID())+": "+
+			constantString(value)+";")
+	case data.BoundDataset:
+		setAttr(n, "data-"+kebabCase(target.ID()), constantString(value))
+	case data.BoundClass:
+		index := -1
+		if value.Kind() == constant.Bool {
+			if constant.BoolVal(value) {
+				index = 0
+			}
+		} else if i, ok := constant.Int64Val(value); ok && i > 0 &&
+			int(i) <= len(target.IDs) {
+			index = int(i) - 1
+		}
+		if index != -1 {
+			class := attrVal(n, "class")
+			if class != "" {
+				class += " "
+			}
+			setAttr(n, "class", class+target.IDs[index])
+		}
+	}
+}
+
+// replaceDynamic replaces n with the given nodes enclosed in the comments that
+// mark dynamic content.
+func replaceDynamic(n *html.Node, content ...*html.Node) {
+	parent := n.Parent
+	parent.InsertBefore(&html.Node{Type: html.CommentNode, Data: dynamicStart}, n)
+	for _, c := range content {
+		parent.InsertBefore(c, n)
+	}
+	parent.InsertBefore(&html.Node{Type: html.CommentNode, Data: dynamicEnd}, n)
+	parent.RemoveChild(n)
+}
+
+func constantString(value constant.Value) string {
+	switch value.Kind() {
+	case constant.String:
+		return constant.StringVal(value)
+	case constant.Bool:
+		return strconv.FormatBool(constant.BoolVal(value))
+	default:
+		return value.ExactString()
+	}
+}
+
+func kebabCase(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsUpper(r) {
+			b.WriteByte('-')
+			b.WriteRune(unicode.ToLower(r))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func attrVal(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func setAttr(n *html.Node, key, value string) {
+	for i := range n.Attr {
+		if n.Attr[i].Key == key {
+			n.Attr[i].Val = value
+			return
+		}
+	}
+	n.Attr = append(n.Attr, html.Attribute{Key: key, Val: value})
+}
+
+func walkNode(root *html.Node, path []int) *html.Node {
+	cur := root
+	for _, index := range path {
+		cur = cur.FirstChild
+		for ; index > 0; index-- {
+			cur = cur.NextSibling
+		}
+	}
+	return cur
+}
+
+func cloneNode(n *html.Node) *html.Node {
+	ret := &html.Node{Type: n.Type, DataAtom: n.DataAtom, Data: n.Data,
+		Namespace: n.Namespace, Attr: append([]html.Attribute(nil), n.Attr...)}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		ret.AppendChild(cloneNode(c))
+	}
+	return ret
+}
@@ -0,0 +1,145 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"github.com/flyx/askew/data"
+)
+
+// The generated code contains marker comments around code that originates
+// from a source file. They survive formatting and are used to map positions
+// in the generated code back to the source. The format of a starting marker
+// is `// askew:begin <attr> <pos>` where attr is `-` if the code is not
+// contained in an attribute.
+const (
+	originBegin = "// askew:begin "
+	originEnd   = "// askew:end"
+)
+
+// beginOrigin returns a comment that marks the start of code originating
+// from the given origin. attr, if given, overrides the origin's attribute.
+func beginOrigin(o data.Origin, attr ...string) string {
+	if len(attr) > 0 {
+		o.Attr = attr[0]
+	}
+	if o.Attr == "" {
+		return originBegin + "- " + o.Pos.String()
+	}
+	return originBegin + o.Attr + " " + o.Pos.String()
+}
+
+// endOrigin returns a comment that closes the innermost open origin.
+func endOrigin() string {
+	return originEnd
+}
+
+// SourceMap maps 1-based line numbers of a generated file to the origin of
+// the code in that line. Lines that do not originate from a source file have
+// no entry.
+type SourceMap map[int]data.Origin
+
+// ReadSourceMap reads the origin markers contained in the given generated
+// Go code. Lines inside raw string literals are never markers.
+func ReadSourceMap(goCode []byte) SourceMap {
+	ret := make(SourceMap)
+	inString := rawStringLines(goCode)
+	var stack []data.Origin
+	for i, raw := range strings.Split(string(goCode), "\n") {
+		text := strings.TrimSpace(raw)
+		_, isString := inString[i+1]
+		switch {
+		case isString:
+			if len(stack) > 0 {
+				ret[i+1] = stack[len(stack)-1]
+			}
+		case strings.HasPrefix(text, originBegin):
+			var o data.Origin
+			desc := text[len(originBegin):]
+			if sep := strings.IndexByte(desc, ' '); sep != -1 {
+				if attr := desc[:sep]; attr != "-" {
+					o.Attr = attr
+				}
+				o.Pos, _ = data.ParsePosition(desc[sep+1:])
+			}
+			stack = append(stack, o)
+		case text == originEnd:
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		default:
+			if len(stack) > 0 {
+				ret[i+1] = stack[len(stack)-1]
+			}
+		}
+	}
+	return ret
+}
+
+// addLineDirectives adds //line directives to the given formatted code of
+// the file at path. Each line that originates from a source file is preceded
+// by a directive referencing the line of its origin, so that compiler errors
+// and stack traces refer to the source file instead of the generated code.
+// Blank lines and lines inside multi-line raw strings are left untouched.
+func addLineDirectives(goCode []byte, path string) []byte {
+	sm := ReadSourceMap(goCode)
+	if len(sm) == 0 {
+		return goCode
+	}
+	inString := rawStringLines(goCode)
+	dir, base := filepath.Dir(path), filepath.Base(path)
+
+	var b bytes.Buffer
+	b.Grow(len(goCode) * 2)
+	outLine, mapped := 1, false
+	for i, line := range bytes.SplitAfter(goCode, []byte{'\n'}) {
+		if _, ok := inString[i+1]; !ok && len(bytes.TrimSpace(line)) > 0 {
+			if origin, ok := sm[i+1]; ok && origin.Pos.File != "" {
+				srcPath, err := filepath.Rel(dir, origin.Pos.File)
+				if err != nil {
+					srcPath = origin.Pos.File
+				}
+				fmt.Fprintf(&b, "//line %s:%d\n", filepath.ToSlash(srcPath),
+					origin.Pos.Line)
+				outLine++
+				mapped = true
+			} else if mapped {
+				// the directive applies to the line following it, hence +1.
+				fmt.Fprintf(&b, "//line %s:%d\n", base, outLine+1)
+				outLine++
+				mapped = false
+			}
+		}
+		b.Write(line)
+		outLine++
+	}
+	return b.Bytes()
+}
+
+// rawStringLines returns the 1-based numbers of all lines that start inside
+// a raw string literal.
+func rawStringLines(goCode []byte) map[int]struct{} {
+	ret := make(map[int]struct{})
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(goCode))
+	var s scanner.Scanner
+	s.Init(file, goCode, nil, 0)
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok == token.STRING && lit[0] == '`' {
+			start := file.Line(pos)
+			end := file.Line(pos + token.Pos(len(lit)) - 1)
+			for l := start + 1; l <= end; l++ {
+				ret[l] = struct{}{}
+			}
+		}
+	}
+	return ret
+}
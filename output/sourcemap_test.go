@@ -0,0 +1,144 @@
+package output
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/flyx/askew/data"
+)
+
+var (
+	assignOrigin = data.Origin{Attr: "a:assign",
+		Pos: data.Position{File: "ui/ui.askew", Line: 12, Column: 3}}
+	textOrigin = data.Origin{
+		Pos: data.Position{File: "ui/ui.askew", Line: 20, Column: 5}}
+)
+
+func TestReadSourceMap(t *testing.T) {
+	code := strings.Join([]string{
+		"package ui",                     // 1
+		"",                               // 2
+		"func f() {",                     // 3
+		"\t" + beginOrigin(assignOrigin), // 4
+		"\ta := 1",                       // 5
+		"\t" + beginOrigin(textOrigin),   // 6
+		"\tb := a",                       // 7
+		"\t" + endOrigin(),               // 8
+		"\tc := b",                       // 9
+		"\t" + endOrigin(),               // 10
+		"\t_ = c",                        // 11
+		"\t" + endOrigin(),               // 12: unbalanced, ignored
+		"}",                              // 13
+	}, "\n")
+	expected := SourceMap{5: assignOrigin, 7: textOrigin, 9: assignOrigin}
+	if sm := ReadSourceMap([]byte(code)); !reflect.DeepEqual(sm, expected) {
+		t.Errorf("unexpected source map:\n  got:  %v\n  want: %v", sm, expected)
+	}
+}
+
+func TestReadSourceMapIgnoresRawStrings(t *testing.T) {
+	code := "package ui\n\nvar s = `\n" + beginOrigin(textOrigin) + "\n`\n\nvar x = 1\n"
+	if sm := ReadSourceMap([]byte(code)); len(sm) != 0 {
+		t.Errorf("marker in raw string has been read: %v", sm)
+	}
+}
+
+func TestBeginOriginOverridesAttr(t *testing.T) {
+	code := beginOrigin(textOrigin, "args") + "\nx\n" + endOrigin()
+	expected := SourceMap{2: data.Origin{Pos: textOrigin.Pos, Attr: "args"}}
+	if sm := ReadSourceMap([]byte(code)); !reflect.DeepEqual(sm, expected) {
+		t.Errorf("unexpected source map:\n  got:  %v\n  want: %v", sm, expected)
+	}
+}
+
+const mappedCode = `package ui
+
+func f() string {
+	` + originBegin + `a:assign ui/ui.askew:12:3
+	a := "one"
+	` + originEnd + `
+	b := ` + "`raw\n" + `	` + originBegin + `- ui/ui.askew:99:1
+still raw` + "`" + `
+	` + originBegin + `- ui/ui.askew:20:5
+	c := a + b
+	` + originEnd + `
+	return c
+}
+`
+
+func TestAddLineDirectives(t *testing.T) {
+	out := string(addLineDirectives([]byte(mappedCode), "ui/ui.askew.go"))
+	expected := `package ui
+
+func f() string {
+	` + originBegin + `a:assign ui/ui.askew:12:3
+//line ui.askew:12
+	a := "one"
+//line ui.askew.go:8
+	` + originEnd + `
+	b := ` + "`raw\n" + `	` + originBegin + `- ui/ui.askew:99:1
+still raw` + "`" + `
+	` + originBegin + `- ui/ui.askew:20:5
+//line ui.askew:20
+	c := a + b
+//line ui.askew.go:16
+	` + originEnd + `
+	return c
+}
+`
+	if out != expected {
+		t.Fatalf("unexpected output:\n%s\nexpected:\n%s", out, expected)
+	}
+}
+
+// TestLineDirectivePositions checks the positions the Go parser reports for
+// the code produced by addLineDirectives.
+func TestLineDirectivePositions(t *testing.T) {
+	out := addLineDirectives([]byte(mappedCode), "ui/ui.askew.go")
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "ui/ui.askew.go", out, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outLines := strings.Split(string(out), "\n")
+	expected := map[string]string{
+		"a": "ui/ui.askew:12", "c": "ui/ui.askew:20", "b": "ui/ui.askew.go:",
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok {
+			return true
+		}
+		name := assign.Lhs[0].(*ast.Ident).Name
+		pos := fset.Position(assign.Pos())
+		want := expected[name]
+		if want == "ui/ui.askew.go:" {
+			// unmapped code must have its actual position in the output.
+			raw := fset.PositionFor(assign.Pos(), false)
+			if pos.Filename != "ui/ui.askew.go" || pos.Line != raw.Line {
+				t.Errorf("%s: expected %s:%d, got %s:%d", name, raw.Filename, raw.Line,
+					pos.Filename, pos.Line)
+			}
+			if !strings.Contains(outLines[raw.Line-1], name+" :=") {
+				t.Errorf("%s: line %d is %q", name, raw.Line, outLines[raw.Line-1])
+			}
+			return true
+		}
+		if got := pos.Filename + ":" + strconv.Itoa(pos.Line); got != want {
+			t.Errorf("%s: expected %s, got %s", name, want, got)
+		}
+		return true
+	})
+}
+
+func TestAddLineDirectivesWithoutOrigins(t *testing.T) {
+	code := "package ui\n\nvar x = 1\n"
+	if out := string(addLineDirectives([]byte(code), "ui/ui.askew.go")); out != code {
+		t.Errorf("code without origins has been changed:\n%s", out)
+	}
+}
@@ -0,0 +1,68 @@
+package output
+
+import (
+	"github.com/flyx/askew/data"
+	"github.com/flyx/net/html"
+	"github.com/flyx/net/html/atom"
+)
+
+// collectStyles appends each component with a style that is reachable via
+// the given embeds to list, unless seen already contains it. This includes the
+// components created by <a:construct> and <a:route> children of the embeds.
+func collectStyles(embeds []data.Embed, seen map[*data.Component]struct{},
+	list []*data.Component) []*data.Component {
+	for _, e := range embeds {
+		list = collectStyle(e.Target, seen, list)
+		for _, c := range e.ConstructorCalls {
+			list = collectStyle(c.Target, seen, list)
+		}
+		for _, c := range e.SlotContents {
+			if c.Construct != nil {
+				list = collectStyle(c.Construct.Target, seen, list)
+			}
+		}
+		for _, r := range e.Routes {
+			list = collectStyle(r.Target, seen, list)
+		}
+	}
+	return list
+}
+
+// collectStyle appends cmp to list if it has a style and then collects the
+// styles of its embeds, unless cmp is nil or seen already contains it.
+func collectStyle(cmp *data.Component, seen map[*data.Component]struct{},
+	list []*data.Component) []*data.Component {
+	if cmp == nil {
+		return list
+	}
+	if _, ok := seen[cmp]; ok {
+		return list
+	}
+	seen[cmp] = struct{}{}
+	if cmp.Style != "" {
+		list = append(list, cmp)
+	}
+	return collectStyles(cmp.Embeds, seen, list)
+}
+
+// insertStyles appends a <style> element to the site's head for each
+// component with a style that is embedded in the site, directly or
+// indirectly. The styles of other components are injected at runtime.
+func insertStyles(f *data.ASiteFile) {
+	var head *html.Node
+	for n := f.RootNode().FirstChild; n != nil; n = n.NextSibling {
+		if n.Type == html.ElementNode && n.DataAtom == atom.Head {
+			head = n
+			break
+		}
+	}
+	if head == nil {
+		return
+	}
+	for _, cmp := range collectStyles(f.Embeds, make(map[*data.Component]struct{}), nil) {
+		style := &html.Node{Type: html.ElementNode, Data: "style",
+			DataAtom: atom.Style, Attr: []html.Attribute{{Key: "id", Val: cmp.ID}}}
+		style.AppendChild(&html.Node{Type: html.TextNode, Data: cmp.Style})
+		head.AppendChild(style)
+	}
+}
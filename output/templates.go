@@ -2,7 +2,6 @@ package output
 
 import (
 	"fmt"
-	"path/filepath"
 	"strconv"
 	"strings"
 	"text/template"
@@ -11,21 +10,14 @@ import (
 )
 
 var fileHeader = template.Must(template.New("fileHeader").Funcs(template.FuncMap{
-	"FormatImport": func(alias, path string) string {
-		if filepath.Base(path) == alias {
-			return "\"" + path + "\""
-		}
-		return alias + " \"" + path + "\""
-	},
+	"ImportBlock": importBlock,
 }).Parse(`
 package {{.PackageName}}
 
 // Code generated by askew. DO NOT EDIT.
 
 import (
-	"syscall/js"
-	{{- range $alias, $path := .Imports }}
-	{{FormatImport $alias $path}}{{ end }}
+{{ImportBlock .Imports}}
 )
 `))
 
@@ -156,14 +148,21 @@ var component = template.Must(template.New("component").Funcs(template.FuncMap{
 		return strings.Join(items, ", ")
 	},
 	"FieldType": fieldType,
-	"BlockNotEmpty": func(b data.Block) bool {
-		return len(b.Assignments) > 0 || len(b.Controlled) > 0
+	"BlockNotEmpty": func(b *data.ControlBlock) bool {
+		return len(b.Assignments) > 0 || len(b.Controlled) > 0 || len(b.Embeds) > 0
 	},
+	"BlockEmbeds":  blockEmbeds,
 	"TemplateHTML": renderTemplateHTML,
+	"Markup":       markup,
+	"SlotValue":    slotValue,
+	"RouteVars":    routeVars,
+	"Begin":        beginOrigin,
+	"End":          endOrigin,
 }).Option("missingkey=error").Parse(`
-{{- define "Block"}}
+{{- define "Assignments"}}
   {{- range .Assignments}}
 	{
+		{{Begin .Origin}}
 		{{- if IsFormValue .Target.Kind}}
 		tmp := askew.BoundFormValueAt(
 			askew.WalkPath(block, {{PathItems .Path .Target.FormDepth}}), "{{.Target.ID}}", {{.Target.IsRadio}})
@@ -177,16 +176,108 @@ var component = template.Must(template.New("component").Funcs(template.FuncMap{
 		tmp := askew.{{TypeForKind .Target.Kind}}At(
 			askew.WalkPath(block, {{PathItems .Path 0}}), "{{.Target.ID}}")
 		{{- end}}
+		{{- if .Signal}}
+		o.αcd.BindSignal(tmp, {{.Expression}})
+		{{- else}}
 		askew.Assign(tmp, {{.Expression}})
+		{{- end}}
+		{{End}}
 	}
 	{{- end}}
+{{- end}}
+
+{{- define "EmbedIndex"}}{{if .Block}}αi{{else}}{{Last .Path}}{{end}}{{end}}
+
+{{- define "Embed"}}
+		{{- $e := .}}
+		{{- if eq .Kind 0}}
+		{{Begin .Origin}}
+		{{- if .Value}}
+		o.{{.Field}} = {{.Value}}
+		{{- else}}
+		o.{{.Field}}.Init({{.Args.Raw}})
+		{{- end}}
+		{{End}}
+		{{- range .SlotContents}}
+		{{Begin .Origin}}
+		o.{{$e.Field}}.{{.Slot}}.Set({{SlotValue .}})
+		{{End}}
+		{{- end}}
+		o.{{.Field}}.InsertInto(container, container.Get("childNodes").Index({{template "EmbedIndex" .}}))
+		{{- if .Control}}
+		o.{{.Field}}.Controller = o
+		{{- end}}
+		{{- else}}
+		o.{{.Field}}.Init(container, {{template "EmbedIndex" .}})
+		{{- if .Fallback}}
+		o.{{.Field}}.Set(askew.NewMarkup({{Markup .Fallback}}))
+		{{- end}}
+		{{- if .Control}}
+		o.{{.Field}}.DefaultController = o
+		{{- end}}
+		{{- range .ConstructorCalls}}
+		{{$cname := .ConstructorName}}
+		{{- if ne .Kind 0}}
+		{{Begin .Origin}}
+		{{- if eq .Kind 1}}
+		if {{.Expression}} {
+		{{- else}}
+		for {{.Index}}, {{.Variable}} := range {{.Expression}} {
+		{{- end}}
+		{{End}}
+		{{- end}}
+		{{Begin .Origin "args"}}
+		{{- if eq $e.Kind 2}}
+		o.{{$e.Field}}.Set(
+		{{- else}}
+		o.{{$e.Field}}.Append(
+		{{- end}}{{with $e.Ns}}{{.}}.{{end}}{{$cname}}({{.Args.Raw}}))
+		{{End}}
+		{{- if ne .Kind 0}}
+		}
+		{{- end}}
+		{{- end}}
+		{{- if .Routes}}
+		o.αcd.AddRoutes(func() { o.{{.Field}}.Set(nil) },
+		{{- range .Routes}}
+			askew.Route{Pattern: {{printf "%q" .Path}}, Handler: func(αparams askew.RouteParams) {
+				{{- range RouteVars .}}
+				{{.}} := αparams["{{.}}"]
+				{{- end}}
+				{{Begin .Origin}}
+				o.{{$e.Field}}.Set({{.ConstructorName}}({{.Args.Raw}}))
+				{{End}}
+			}},
+		{{- end}}
+		)
+		{{- end}}
+		{{- end}}
+{{- end}}
+
+{{- define "DestroyEmbed"}}
+	{{- if eq .Kind 0}}
+	o.{{.Field}}.Destroy()
+	{{- else if eq .Kind 1}}
+	o.{{.Field}}.DestroyAll()
+	{{- else}}
+	o.{{.Field}}.Set(nil)
+	{{- end}}
+{{- end}}
+
+{{- define "Block"}}
+	{{- range $i, $e := .Embeds}}
+	αembed{{$i}} := askew.WalkPath(block, {{PathItems .Path 0}})
+	{{- end}}
+	{{- template "Assignments" .}}
 
 	{{- range .Controlled}}
 	{{- if eq .Kind 0}}
+	{{Begin .Origin}}
 	if {{.Expression}} {
-		{{if BlockNotEmpty .Block}}
+		{{End}}
+		{{if BlockNotEmpty .}}
 		block := askew.WalkPath(block, {{PathItems .Path 0}})
-		{{template "Block" .Block}}
+		{{template "Block" .}}
 		{{- end}}
 	} else {
 		_item := askew.WalkPath(block, {{PathItems .Path 0}})
@@ -199,30 +290,75 @@ var component = template.Must(template.New("component").Funcs(template.FuncMap{
 		_parent := _orig.Get("parentNode")
 		_next := _orig.Get("nextSibling")
 		_parent.Call("removeChild", _orig)
+		{{Begin .Origin}}
 		for {{.Index}}{{with .Variable}}, {{.}}{{end}} := range {{.Expression}} {
+			{{End}}
 			block := _orig.Call("cloneNode", true)
-			{{template "Block" .Block}}
+			{{template "Block" .}}
 			_parent.Call("insertBefore", block, _next)
 		}
 	}
 	{{- end}}
 	{{- end}}
+	{{- range $i, $e := .Embeds}}
+	{
+		container, αi := αembed{{$i}}.Get("parentNode"), askew.ChildIndex(αembed{{$i}})
+		{{- template "Embed" .}}
+		o.αlive{{.Field}} = true
+	}
+	{{- end}}
+{{- end}}
+
+{{- define "ManagedBlock"}}
+	func(αbm *askew.BlockManager) {
+		{{- range BlockEmbeds .}}
+		if o.αlive{{.Field}} {
+			{{- template "DestroyEmbed" .}}
+			o.αlive{{.Field}} = false
+		}
+		{{- end}}
+		αn := 0
+		{{Begin .Origin}}
+		{{- if eq .Kind 0}}
+		if {{.Expression}} {
+		{{- else}}
+		for {{.Index}}{{with .Variable}}, {{.}}{{end}} := range {{.Expression}} {
+		{{- end}}
+			{{End}}
+			{{- if BlockNotEmpty .}}
+			block := αbm.Item(αn)
+			{{- template "Block" .}}
+			{{- else}}
+			αbm.Item(αn)
+			{{- end}}
+			αn++
+		}
+		αbm.Truncate(αn)
+	}
 {{- end}}
 
 {{define "doCall" -}}
 	o.{{if .FromController}}Controller.{{end}}{{.Handler}}({{GenArgs .ParamMappings}})
 {{- end}}
 
+{{define "goCall" -}}
+	αdone := askew.BeginAsync()
+		go func({{range $i, $p := .ParamMappings}}{{if $i}}, {{end}}α{{$i}} {{$p.Type}}{{end}}) {
+			defer αdone()
+			o.{{if .FromController}}Controller.{{end}}{{.Handler}}({{range $i, $p := .ParamMappings}}{{if $i}}, {{end}}α{{$i}}{{end}})
+		}({{GenArgs .ParamMappings}})
+{{- end}}
+
 {{define "callHandler"}}
 	{{- if eq .Handling 0}}
-		go {{template "doCall" .}}
+		{{template "goCall" .}}
 		arguments[0].Call("preventDefault")
 	{{- else if eq .Handling 2}}
 		if {{template "doCall" .}} {
 			arguments[0].Call("preventDefault")
 		}
 	{{- else }}
-		go {{template "doCall" .}}
+		{{template "goCall" .}}
 	{{- end}}
 {{- end}}
 
@@ -241,6 +377,9 @@ var α{{.Name}}Template = js.Global().Get("document").Call("createElement", "tem
 
 func init() {
 	α{{.Name}}Template.Set("innerHTML", ` + "`" + "{{TemplateHTML .Template}}" + "`" + `)
+	{{- if .Style}}
+	askew.AddStyle("{{.ID}}", {{printf "%q" .Style}})
+	{{- end}}
 }
 
 // {{.Name}} is a DOM component autogenerated by Askew
@@ -255,11 +394,21 @@ type {{.Name}} struct {
 	{{.Variable.Name}} {{Wrapper .Variable.Type}}
 	{{- end}}
 	{{- range .Fields}}
+	{{Begin .Origin}}
 	{{.Name}} {{.Type}}
+	{{End}}
 	{{- end}}
 	{{- range .Embeds }}
 	{{.Field}} {{FieldType .}}
 	{{- end}}
+	{{- range .Embeds }}
+	{{- if .Block}}
+	αlive{{.Field}} bool
+	{{- end}}
+	{{- end}}
+	{{- if .Controlled}}
+	αblocks [{{len .Controlled}}]askew.BlockManager
+	{{- end}}
 }
 
 
@@ -282,14 +431,25 @@ func (o *{{.Name}}) Init({{GenComponentParams .Parameters}}) {
 func (o *{{.Name}}) FirstNode() js.Value {
 	return o.αcd.First()
 }
+{{- if .Controller}}
+
+// DoSetController sets the Controller, which must implement {{.Name}}Controller.
+// This is an implementation detail and should not be called from user code.
+func (o *{{.Name}}) DoSetController(controller interface{}) {
+	o.Controller = controller.({{.Name}}Controller)
+}
+{{- end}}
 
 // askewInit initializes the component, discarding all previous information.
 // The component is initially a DocumentFragment until it gets inserted into
 // the main document. It can be manipulated both before and after insertion.
 func (o *{{.Name}}) askewInit({{GenComponentParams .Parameters}}) {
-	o.αcd.Init(α{{.Name}}Template.Get("content").Call("cloneNode", true))
+	o.αcd.Init(askew.Instantiate(α{{.Name}}Template, "{{.Name}}"))
 	{{ range .Fields }}
-	{{- if .DefaultValue }}o.{{.Name}} = {{.DefaultValue}}
+	{{- if .DefaultValue }}
+	{{Begin .Origin}}
+	o.{{.Name}} = {{.DefaultValue}}
+	{{End}}
 	{{end}}
 	{{- end}}
 	{{- range .Variables }}
@@ -302,70 +462,88 @@ func (o *{{.Name}}) askewInit({{GenComponentParams .Parameters}}) {
 	{{- else}}
 	o.{{.Variable.Name}}.BoundValue = askew.New{{TypeForKind .Value.Kind}}(&o.αcd, "{{.Value.ID}}", {{PathItems .Path 0}})
 	{{- end}}
+	{{- if .Layout}}
+	o.{{.Variable.Name}}.Layout = askew.{{.Layout}}
 	{{- end}}
-	{{- if BlockNotEmpty .Block}}
+	{{- end}}
+	{{- if .Assignments}}
 	{
 		block := o.αcd.Walk()
-		{{- template "Block" .Block}}
+		{{- template "Assignments" .Block}}
+	}
+	{{- end}}
+	{{- range $i, $b := .Controlled}}
+	o.αblocks[{{$i}}].Init(o.αcd.Walk({{PathItems .Path 0}}), {{not .Controlled}},
+		{{- template "ManagedBlock" .}})
+	{{- end}}
+	{{- range .Models}}
+	{
+		src := o.αcd.Walk({{PathItems .Path 0}})
+		{{Begin .Origin}}
+		{{- if IsClassValue .Value.Kind}}
+		bv := askew.BoundClassesAt(src, []string{ {{ClassNames .Value.IDs}} })
+		{{- else}}
+		bv := askew.{{TypeForKind .Value.Kind}}At(src, "{{.Value.ID}}")
+		{{- end}}
+		askew.Assign(bv, o.{{.Field}})
+		o.αcd.AddEventListener(src, "{{.Event}}", func(this js.Value, arguments []js.Value) interface{} {
+			if v := (&{{Wrapper .Type}}{BoundValue: bv}); askew.Valid(v) {
+				o.{{.Field}} = v.Get()
+			}
+			return nil
+		})
+		{{End}}
 	}
 	{{- end}}
 	{{- range .Captures}}
+	{{- $capture := .}}
 	{
 		src := o.αcd.Walk({{PathItems .Path 0}})
 		{{- range .Mappings}}
 		{
-			wrapper := js.FuncOf(func(this js.Value, arguments []js.Value) interface{} {
+			{{Begin $capture.Origin}}
+			o.αcd.{{if .Delegate}}Delegate{{else}}AddEventListener{{end}}(src, "{{.Event}}", func(this js.Value, arguments []js.Value) interface{} {
 				{{- if NeedsSelf .ParamMappings}}
-				self := arguments[0].Get("currentTarget")
+				self := this
 				{{- end}}
 				{{template "callHandler" .}}
 				return nil
 			})
-			src.Call("addEventListener", "{{.Event}}", wrapper)
+			{{End}}
 		}
 		{{- end}}
 	}
 	{{- end}}
 	{{- range .Embeds }}
+	{{- if not .Block}}
 	{
 		container := o.αcd.Walk({{PathItems .Path 1}})
-		{{- if eq .Kind 0}}
-		{{- if .Value}}
-		o.{{.Field}} = {{.Value}}
-		{{- else}}
-		o.{{.Field}}.Init({{.Args.Raw}})
-		{{- end}}
-		o.{{.Field}}.InsertInto(container, container.Get("childNodes").Index({{Last .Path}}))
-		{{- if .Control}}
-		o.{{.Field}}.Controller = o
-		{{- end}}
-		{{- else}}
-		o.{{.Field}}.Init(container, {{Last .Path}})
-		{{- if .Control}}
-		o.{{.Field}}.DefaultController = o
-		{{- end}}
-		{{$e := .}}
-		{{- range .ConstructorCalls}}
-		{{$cname := .ConstructorName}}
-		{{- if eq .Kind 1}}
-		if {{.Expression}} {
-		{{- else if eq .Kind 2}}
-		for {{.Index}}, {{.Variable}} := range {{.Expression}} {
-		{{- end}}
-		{{- if eq $e.Kind 2}}
-		o.{{$e.Field}}.Set(
-		{{- else}}
-		o.{{$e.Field}}.Append(
-		{{- end}}{{with $e.Ns}}{{.}}.{{end}}{{$cname}}({{.Args.Raw}}))
-		{{- if ne .Kind 0}}
-		}
-		{{- end}}
-		{{- end}}
+		{{- if and (eq .Kind 0) .Target (not .Value)}}
+		askew.Adopt(container.Get("childNodes").Index({{Last .Path}}))
 		{{- end}}
+		{{- template "Embed" .}}
 	}
 	{{- end}}
+	{{- end}}
+	{{- if .Controlled}}
+	o.Refresh()
+	{{- end}}
 }
 
+{{- if .Controlled}}
+
+// Refresh re-evaluates the conditions and ranges of the component's a:if and
+// a:for blocks and updates the DOM accordingly. Parameters of the component
+// referenced in those expressions keep the values given at initialization.
+// Elements of blocks that contain nested blocks are replaced by new elements,
+// losing their DOM state.
+func (o *{{.Name}}) Refresh() {
+	for i := range o.αblocks {
+		o.αblocks[i].Update()
+	}
+}
+{{- end}}
+
 // InsertInto inserts this component into the given object.
 // The component will be in inserted state afterwards.
 //
@@ -373,14 +551,13 @@ func (o *{{.Name}}) askewInit({{GenComponentParams .Parameters}}) {
 func (o *{{.Name}}) InsertInto(parent js.Value, before js.Value) {
 	o.αcd.DoInsert(parent, before)
 	{{- range .Embeds}}
-	{{- if ne .Kind 0}}
-	{{- if .T}}
-	o.{{.Field}}.αmgr.UpdateParent(o.αcd.DocumentFragment(), parent, before)
-	{{- else}}
+	{{- if and (ne .Kind 0) (not .Block)}}
 	o.{{.Field}}.DoUpdateParent(o.αcd.DocumentFragment(), parent, before)
 	{{- end}}
 	{{- end}}
-	{{- end}}
+	if askew.IsConnected(parent) {
+		o.DoMount()
+	}
 }
 
 // Extract removes this component from its current parent.
@@ -388,120 +565,105 @@ func (o *{{.Name}}) InsertInto(parent js.Value, before js.Value) {
 func (o *{{.Name}}) Extract() {
 	o.αcd.DoExtract()
 	{{- range .Embeds}}
-	{{- if ne .Kind 0}}
-	{{- if .T}}
-	o.{{.Field}}.αmgr.UpdateParent(o.αcd.First().Get("parentNode"), o.αcd.DocumentFragment(), js.Undefined())
-	{{- else}}
+	{{- if and (ne .Kind 0) (not .Block)}}
 	o.{{.Field}}.DoUpdateParent(o.αcd.First().Get("parentNode"), o.αcd.DocumentFragment(), js.Undefined())
 	{{- end}}
 	{{- end}}
-	{{- end}}
+	o.DoUnmount()
 }
 
-// Destroy destroys this element (and all contained components). If it is
-// currently inserted anywhere, it gets removed before.
-func (o *{{.Name}}) Destroy() {
+// DoUpdateParent updates the list and optional embeds of this component
+// after its nodes have been moved from oldParent to newParent.
+// This is an implementation detail and should not be called from user code.
+func (o *{{.Name}}) DoUpdateParent(oldParent, newParent, newEnd js.Value) {
 	{{- range .Embeds}}
-	{{- if eq .Kind 0}}
-	o.{{.Field}}.Destroy()
-	{{- else if eq .Kind 1}}
-	o.{{.Field}}.DestroyAll()
-	{{- else}}
-	o.{{.Field}}.Set(nil)
+	{{- if and (ne .Kind 0) (not .Block)}}
+	o.{{.Field}}.DoUpdateParent(oldParent, newParent, newEnd)
 	{{- end}}
 	{{- end}}
-	o.αcd.DoDestroy()
 }
 
-{{- end}}`))
-
-var list = template.Must(template.New("list").Parse(`
-{{- range .Components}}{{ if .GenList }}
+{{- if .DelegatedEvents}}
 
-// {{.Name}}List is a list of {{.Name}} whose manipulation methods auto-update
-// the corresponding nodes in the document.
-type {{.Name}}List struct {
-	αmgr askew.ListManager
-	αitems []*{{.Name}}
-	{{- if .Controller}}
-	DefaultController {{.Name}}Controller
-	{{- end}}
-}
-
-// Init initializes the list, discarding previous data.
-// The list's items will be placed in the given container, starting at the
-// given index.
-func (l *{{.Name}}List) Init(container js.Value, index int) {
-	l.αmgr = askew.CreateListManager(container, index)
-	l.αitems = nil
-}
-
-// Len returns the number of items in the list.
-func (l *{{.Name}}List) Len() int {
-	return len(l.αitems)
-}
-
-// Item returns the item at the current index.
-func (l *{{.Name}}List) Item(index int) *{{.Name}} {
-	return l.αitems[index]
+// DelegatedEvents returns the types of the events this component delegates
+// to the list it is part of.
+// This is an implementation detail and should not be called from user code.
+func (o *{{.Name}}) DelegatedEvents() []string {
+	return []string{ {{- range $i, $e := .DelegatedEvents}}{{if $i}}, {{end}}"{{$e}}"{{end -}} }
 }
+{{- end}}
 
-// Append appends the given item to the list.
-func (l *{{.Name}}List) Append(item *{{.Name}}) {
-	if item == nil {
-		panic("cannot append nil to list")
+// DoMount is called after the component has been inserted into the
+// document. It calls the mounted hooks of the embedded components and then
+// the component's own.
+// This is an implementation detail and should not be called from user code.
+func (o *{{.Name}}) DoMount() {
+	if !o.αcd.DoMount() {
+		return
 	}
-	l.αmgr.Append(item)
-	l.αitems = append(l.αitems, item)
-	{{- if .Controller}}
-	item.Controller = l.DefaultController
+	{{- range .Embeds}}
+	{{- if .Block}}
+	if o.αlive{{.Field}} {
+		o.{{.Field}}.DoMount()
+	}
+	{{- else}}
+	o.{{.Field}}.DoMount()
+	{{- end}}
+	{{- end}}
+	{{- if .HasHook "mounted"}}
+	o.mounted()
 	{{- end}}
-	return
 }
 
-// Insert inserts the given item at the given index into the list.
-func (l *{{.Name}}List) Insert(index int, item *{{.Name}}) {
-	var prev js.Value
-	if index < len(l.αitems) {
-		prev = l.αitems[index].αcd.First()
+// DoUnmount is called after the component has been removed from the
+// document. It calls the component's unmounted hook and then those of the
+// embedded components.
+// This is an implementation detail and should not be called from user code.
+func (o *{{.Name}}) DoUnmount() {
+	if !o.αcd.DoUnmount() {
+		return
 	}
-	if item == nil {
-		panic("cannot insert nil into list")
+	{{- if .HasHook "unmounted"}}
+	o.unmounted()
+	{{- end}}
+	{{- range .Embeds}}
+	{{- if .Block}}
+	if o.αlive{{.Field}} {
+		o.{{.Field}}.DoUnmount()
 	}
-	l.αmgr.Insert(item, prev)
-	l.αitems = append(l.αitems, nil)
-	copy(l.αitems[index+1:], l.αitems[index:])
-	l.αitems[index] = item
-	{{- if .Controller}}
-	item.Controller = l.DefaultController
-	{{- end}}
-	return
+	{{- else}}
+	o.{{.Field}}.DoUnmount()
+	{{- end}}
+	{{- end}}
 }
 
-// Remove removes the item at the given index from the list and returns it.
-func (l *{{.Name}}List) Remove(index int) *{{.Name}} {
-	item := l.αitems[index]
-	item.Extract()
-	copy(l.αitems[index:], l.αitems[index+1:])
-	l.αitems = l.αitems[:len(l.αitems)-1]
-	return item
+// Destroy destroys this element (and all contained components). If it is
+// currently inserted anywhere, it gets removed before.
+func (o *{{.Name}}) Destroy() {
+	o.DoUnmount()
+	{{- if .HasHook "destroyed"}}
+	o.destroyed()
+	{{- end}}
+	{{- range .Embeds}}
+	{{- if .Block}}
+	if o.αlive{{.Field}} {
+		{{- template "DestroyEmbed" .}}
+	}
+	{{- else}}
+	{{- template "DestroyEmbed" .}}
+	{{- end}}
+	{{- end}}
+	o.αcd.DoDestroy()
 }
 
-// Destroy destroys the item at the given index and removes it from the list.
-func (l *{{.Name}}List) Destroy(index int) {
-	item := l.αitems[index]
-	item.Destroy()
-	copy(l.αitems[index:], l.αitems[index+1:])
-	l.αitems = l.αitems[:len(l.αitems)-1]
-}
+{{- end}}`))
 
-// DestroyAll destroys all items in the list and empties it.
-func (l *{{.Name}}List) DestroyAll() {
-	for _, item := range l.αitems {
-		item.Destroy()
-	}
-	l.αitems = l.αitems[:0]
-}
+var list = template.Must(template.New("list").Parse(`
+{{- range .Components}}{{ if .GenList }}
+
+// {{.Name}}List is a list of {{.Name}} whose manipulation methods auto-update
+// the corresponding nodes in the document.
+type {{.Name}}List = askew.List[*{{.Name}}, {{if .Controller}}{{.Name}}Controller{{else}}askew.NoController{{end}}]
 
 {{- end}}{{ end }}
 `))
@@ -510,61 +672,19 @@ var optional = template.Must(template.New("optional").Parse(`
 {{- range .Components}}{{ if .GenOpt }}
 
 // Optional{{.Name}} is a nillable embeddable container for {{.Name}}.
-type Optional{{.Name}} struct {
-	αcur *{{.Name}}
-	αmgr askew.ListManager
-	{{- if .Controller}}
-	DefaultController {{.Name}}Controller
-	{{- end}}
-}
-
-// Init initializes the container to be empty.
-// The contained item, if any, will be placed in the given container at the
-// given index.
-func (o *Optional{{.Name}}) Init(container js.Value, index int) {
-	o.αmgr = askew.CreateListManager(container, index)
-	o.αcur = nil
-}
-
-// Item returns the current item, or nil if no item is assigned
-func (o *Optional{{.Name}}) Item() *{{.Name}} {
-	return o.αcur
-}
-
-// Set sets the contained item destroying the current one.
-// Give nil as value to simply destroy the current item.
-func (o *Optional{{.Name}}) Set(value *{{.Name}}) {
-	if o.αcur != nil {
-		o.αcur.Destroy()
-	}
-	o.αcur = value
-	if value != nil {
-		o.αmgr.Append(value)
-		{{- if .Controller}}
-		value.Controller = o.DefaultController
-		{{- end}}
-	}
-}
-
-// Remove removes the current item and returns it.
-// Returns nil if there is no current item.
-func (o *Optional{{.Name}}) Remove() askew.Component {
-	if o.αcur != nil {
-		ret := o.αcur
-		ret.Extract()
-		o.αcur = nil
-		return ret
-	}
-	return nil
-}
+type Optional{{.Name}} = askew.Optional[*{{.Name}}, {{if .Controller}}{{.Name}}Controller{{else}}askew.NoController{{end}}]
 
 {{- end}}{{ end }}
 `))
 
 var site = template.Must(template.New("site").Funcs(template.FuncMap{
+	"SlotValue": slotValue,
+	"RouteVars": routeVars,
 	"PathItems": pathItems,
 	"Last":      last,
 	"FieldType": fieldType,
+	"Begin":     beginOrigin,
+	"End":       endOrigin,
 }).Parse(`
 {{if .VarName}}
 // {{.VarName}} holds the embedded components of the document's skeleton
@@ -582,19 +702,92 @@ var {{.VarName}} = struct {
 {{- end}}
 
 {{$varName := .VarName}}
+{{$prerender := .Prerender}}
 func init() {
 	html := js.Global().Get("document").Get("childNodes").Index(1)
+	{{- if $prerender}}
+	askew.Hydrate()
+	{{- end}}
 	{{- range .Embeds}}
 	{{- if eq .Kind 0}}
-	{{with $varName}}{{.}}.{{end}}{{.Field}}.Init({{.Args.Raw}})
 	{
 		container := askew.WalkPath(html, {{PathItems .Path 1}})
+		{{- if and $prerender .Target (not .Value)}}
+		askew.Adopt(container.Get("childNodes").Index({{Last .Path}}))
+		{{- end}}
+		{{Begin .Origin}}
+		{{with $varName}}{{.}}.{{end}}{{.Field}}.Init({{.Args.Raw}})
+		{{End}}
+		{{- $e := .}}
+		{{- range .SlotContents}}
+		{{Begin .Origin}}
+		{{with $varName}}{{.}}.{{end}}{{$e.Field}}.{{.Slot}}.Set({{SlotValue .}})
+		{{End}}
+		{{- end}}
 		{{with $varName}}{{.}}.{{end}}{{.Field}}.InsertInto(container, container.Get("childNodes").Index({{Last .Path}}))
 	}
 	{{- else}}
 	{{with $varName}}{{.}}.{{end}}{{.Field}}.Init(askew.WalkPath(html, {{PathItems .Path 1}}), {{Last .Path}})
+	{{- $e := .}}
+	{{- if .Routes}}
+	askew.AddRoutes(func() { {{with $varName}}{{.}}.{{end}}{{.Field}}.Set(nil) },
+	{{- range .Routes}}
+		askew.Route{Pattern: {{printf "%q" .Path}}, Handler: func(αparams askew.RouteParams) {
+			{{- range RouteVars .}}
+			{{.}} := αparams["{{.}}"]
+			{{- end}}
+			{{Begin .Origin}}
+			{{with $varName}}{{.}}.{{end}}{{$e.Field}}.Set({{.ConstructorName}}({{.Args.Raw}}))
+			{{End}}
+		}},
+	{{- end}}
+	)
 	{{- end}}
 	{{- end}}
+	{{- end}}
+}
+`))
+
+var prerenderArgs = template.Must(template.New("prerenderArgs").Parse(`
+// αprerender is called during initialization of the package variables, before
+// any init func runs. It writes the values of the arguments of the site's
+// direct embeds to stdout and exits.
+var _ = αprerender()
+
+func αprerender() bool {
+	{{- range $i, $e := .}}
+	{{- if $e}}
+	αprerenderArgs({{$i}}, {{$e}})
+	{{- end}}
+	{{- end}}
+	os.Exit(0)
+	return true
+}
+
+// αprerenderArgs writes each argument that has a basic type as Go literal.
+func αprerenderArgs(embed int, args ...interface{}) {
+	for i, arg := range args {
+		var lit string
+		v := reflect.ValueOf(arg)
+		switch v.Kind() {
+		case reflect.String:
+			lit = strconv.Quote(v.String())
+		case reflect.Bool:
+			lit = strconv.FormatBool(v.Bool())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			lit = strconv.FormatInt(v.Int(), 10)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			lit = strconv.FormatUint(v.Uint(), 10)
+		case reflect.Float32, reflect.Float64:
+			if math.IsNaN(v.Float()) || math.IsInf(v.Float(), 0) {
+				continue
+			}
+			lit = strconv.FormatFloat(v.Float(), 'g', -1, 64)
+		default:
+			continue
+		}
+		fmt.Printf("` + nativeArgPrefix + `%d %d %s\n", embed, i, lit)
+	}
 }
 `))
 
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/flyx/askew/data"
 	"github.com/flyx/askew/parsers"
@@ -18,6 +19,11 @@ import (
 	"golang.org/x/mod/modfile"
 )
 
+// Overlay maps paths of source files, relative to the current directory, to
+// contents that are used instead of the files' contents on disk. This is used
+// by the language server for files that have unsaved modifications.
+var Overlay map[string][]byte
+
 func findBasePath() (string, error) {
 	path, err := os.Getwd()
 	if err != nil {
@@ -98,10 +104,63 @@ func descend(start *html.Node, path []atom.Atom) (*html.Node, error) {
 	return cur, nil
 }
 
+// walkSources calls fn for each file that is relevant for askew inside the
+// current directory, skipping excluded directories.
+func walkSources(excludes []string, fn func(path string, info os.FileInfo) error) error {
+	return walkDirs(".", excludes, func(path string, info os.FileInfo) error {
+		if info.IsDir() || fileKind(info.Name()) == dotOther {
+			return nil
+		}
+		return fn(path, info)
+	})
+}
+
+// walkDirs calls fn for root and each directory and file inside it, skipping
+// excluded directories.
+func walkDirs(root string, excludes []string, fn func(path string, info os.FileInfo) error) error {
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// the file may have been removed since its directory was read.
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			for _, exclude := range excludes {
+				if matched, _ := filepath.Match(exclude, path); matched {
+					return filepath.SkipDir
+				}
+			}
+		}
+		return fn(path, info)
+	})
+}
+
+// Dirs returns the directory at the given path and all directories below it
+// that are not excluded. The path must be relative to the current directory.
+func Dirs(root string, excludes []string) ([]string, error) {
+	var ret []string
+	err := walkDirs(root, excludes, func(path string, info os.FileInfo) error {
+		if info.IsDir() {
+			ret = append(ret, path)
+		}
+		return nil
+	})
+	return ret, err
+}
+
+// IsSource returns true iff the file at the given path is relevant for askew.
+func IsSource(path string) bool {
+	return fileKind(filepath.Base(path)) != dotOther
+}
+
 // Discover searches for a go.mod in the cwd, then walks through the file system
 // to discover .askew files.
-// For each file, the imports are parsed.
-func Discover(excludes []string, tmplData interface{}) (*data.BaseDir, error) {
+// For each file, the imports are parsed. Errors in single files are recorded
+// in diag, other files will still be loaded.
+func Discover(excludes []string, tmplData interface{},
+	diag *data.Diagnostics) (*data.BaseDir, error) {
 	var err error
 	ret := &data.BaseDir{}
 	ret.ImportPath, err = findBasePath()
@@ -110,147 +169,191 @@ func Discover(excludes []string, tmplData interface{}) (*data.BaseDir, error) {
 	}
 
 	ret.Packages = make(map[string]*data.Package)
-	err = filepath.Walk(".", func(path string, info os.FileInfo, err error) error {
-		if info.IsDir() {
-			for _, exclude := range excludes {
-				if matched, _ := filepath.Match(exclude, path); matched {
-					return filepath.SkipDir
-				}
-			}
+	err = walkSources(excludes, func(path string, info os.FileInfo) error {
+		os.Stdout.WriteString("[info] discovered: " + path + "\n")
+		if err := loadFile(ret, path, info, tmplData); err != nil {
+			diag.Add(data.ErrorAt(data.Position{File: path}, err))
 		}
-		kind := fileKind(info.Name())
-		if info.IsDir() || kind == dotOther {
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// Rediscover discards the package at the given relative path and loads it
+// again from the file system. Only files directly inside the package's
+// directory are loaded. If the directory does not contain any askew files
+// anymore, the package is removed from base. Errors in single files are
+// recorded in diag.
+func Rediscover(base *data.BaseDir, relPath string, tmplData interface{},
+	diag *data.Diagnostics) error {
+	delete(base.Packages, relPath)
+	infos, err := ioutil.ReadDir(relPath)
+	if err != nil {
+		if os.IsNotExist(err) {
 			return nil
 		}
-		os.Stdout.WriteString("[info] discovered: " + path + "\n")
-		relPath := filepath.Dir(path)
-		assumedPkgName := filepath.Base(relPath)
-		if assumedPkgName == "." {
-			assumedPkgName = filepath.Base(ret.ImportPath)
+		return err
+	}
+	for _, info := range infos {
+		if info.IsDir() || fileKind(info.Name()) == dotOther {
+			continue
 		}
-		pkg, ok := ret.Packages[relPath]
-		if !ok {
-			// the .Name of the package is only set when the first file inside that
-			// package is processed.
-			pkg = &data.Package{Files: make([]*data.AskewFile, 0, 32),
-				ImportPath: filepath.ToSlash(filepath.Join(ret.ImportPath, relPath))}
-			ret.Packages[relPath] = pkg
+		path := filepath.Join(relPath, info.Name())
+		os.Stdout.WriteString("[info] rediscovered: " + path + "\n")
+		if err := loadFile(base, path, info, tmplData); err != nil {
+			diag.Add(data.ErrorAt(data.Position{File: path}, err))
 		}
+	}
+	return nil
+}
 
-		var contents []byte
+// Scan returns the modification times of all files that would be discovered
+// by Discover with the given excludes.
+func Scan(excludes []string) (map[string]time.Time, error) {
+	ret := make(map[string]time.Time)
+	err := walkSources(excludes, func(path string, info os.FileInfo) error {
+		ret[path] = info.ModTime()
+		return nil
+	})
+	return ret, err
+}
 
-		var baseName string
-		if kind == dotAskewTmpl || kind == dotAsiteTmpl {
-			var tmpl *template.Template
-			tmpl, err = template.New(filepath.Base(path)).ParseFiles(path)
-			if err != nil {
-				return err
-			}
-			var writer bytes.Buffer
-			if err = tmpl.Execute(&writer, tmplData); err != nil {
-				return err
-			}
-			contents = writer.Bytes()
-			kind--
-			baseName = info.Name()[:len(info.Name())-11]
+// loadFile loads the file at the given path into its package inside base,
+// creating the package if necessary.
+func loadFile(base *data.BaseDir, path string, info os.FileInfo,
+	tmplData interface{}) error {
+	var err error
+	kind := fileKind(info.Name())
+	relPath := filepath.Dir(path)
+	assumedPkgName := filepath.Base(relPath)
+	if assumedPkgName == "." {
+		assumedPkgName = filepath.Base(base.ImportPath)
+	}
+	pkg, ok := base.Packages[relPath]
+	if !ok {
+		// the .Name of the package is only set when the first file inside that
+		// package is processed.
+		pkg = &data.Package{Files: make([]*data.AskewFile, 0, 32),
+			ImportPath: filepath.ToSlash(filepath.Join(base.ImportPath, relPath))}
+		base.Packages[relPath] = pkg
+	}
+
+	var contents []byte
+
+	var baseName string
+	if kind == dotAskewTmpl || kind == dotAsiteTmpl {
+		var tmpl *template.Template
+		if overlay, ok := Overlay[path]; ok {
+			tmpl, err = template.New(filepath.Base(path)).Parse(string(overlay))
 		} else {
-			contents, err = ioutil.ReadFile(path)
-			if err != nil {
-				return err
-			}
-			baseName = info.Name()[:len(info.Name())-6]
+			tmpl, err = template.New(filepath.Base(path)).ParseFiles(path)
 		}
+		if err != nil {
+			return err
+		}
+		var writer bytes.Buffer
+		if err = tmpl.Execute(&writer, tmplData); err != nil {
+			return err
+		}
+		contents = writer.Bytes()
+		kind--
+		baseName = info.Name()[:len(info.Name())-11]
+	} else {
+		if overlay, ok := Overlay[path]; ok {
+			contents = overlay
+		} else if contents, err = ioutil.ReadFile(path); err != nil {
+			return err
+		}
+		baseName = info.Name()[:len(info.Name())-6]
+	}
+	contents = annotatePositions(path, contents)
 
-		if kind == dotAskew {
-			askewFile := &data.AskewFile{File: data.File{BaseName: baseName, Path: path}}
-			askewFile.Content, err = html.ParseFragmentWithOptions(
-				bytes.NewReader(contents), &data.BodyEnv,
-				html.ParseOptionCustomElements(walker.AskewElements))
-			if err != nil {
-				return fmt.Errorf("%s: %s", path, err.Error())
-			}
-			pHandler := &packageHandler{pkg: pkg, seen: false}
-			w := walker.Walker{
-				Package:   pHandler,
-				Import:    &importHandler{file: &askewFile.File},
-				Component: walker.DontDescend{},
-				Macro:     walker.DontDescend{},
-				TextNode:  walker.WhitespaceOnly{}}
-			_, _, err = w.WalkChildren(nil, &walker.NodeSlice{Items: askewFile.Content})
-			if err != nil {
-				return fmt.Errorf("%s: %s", path, err.Error())
-			}
-			if !pHandler.seen {
-				if pkg.Name == "" {
-					pkg.Name = assumedPkgName
-				} else if pkg.Name != assumedPkgName {
-					return fmt.Errorf(
-						"%s: <a:package> missing, another file has already set the package name to '%s'",
-						path, pkg.Name)
-				}
-			}
-			if askewFile.File.Imports == nil {
-				askewFile.File.Imports = make(map[string]string)
+	if kind == dotAskew {
+		askewFile := &data.AskewFile{File: data.File{BaseName: baseName, Path: path}}
+		askewFile.Content, err = html.ParseFragmentWithOptions(
+			bytes.NewReader(contents), &data.BodyEnv,
+			html.ParseOptionCustomElements(walker.AskewElements))
+		if err != nil {
+			return err
+		}
+		pHandler := &packageHandler{pkg: pkg, seen: false}
+		w := walker.Walker{
+			Package:   pHandler,
+			Import:    &importHandler{file: &askewFile.File},
+			Component: walker.DontDescend{},
+			Macro:     walker.DontDescend{},
+			TextNode:  walker.WhitespaceOnly{}}
+		_, _, err = w.WalkChildren(nil, &walker.NodeSlice{Items: askewFile.Content})
+		if err != nil {
+			return data.ErrorAt(data.Position{File: path}, err)
+		}
+		if !pHandler.seen {
+			if pkg.Name == "" {
+				pkg.Name = assumedPkgName
+			} else if pkg.Name != assumedPkgName {
+				return errors.New(
+					": <a:package> missing, another file has already set the package name to '" +
+						pkg.Name + "'")
 			}
-			if url, ok := askewFile.File.Imports["askew"]; ok {
-				if url != "github.com/flyx/askew/runtime" {
-					return fmt.Errorf(
-						"%s: if the alias `askew` is given in imports, it must link to \"github.com/flyx/askew/runtime\"",
-						path)
-				}
-			} else {
-				askewFile.File.Imports["askew"] = "github.com/flyx/askew/runtime"
+		}
+		if askewFile.File.Imports == nil {
+			askewFile.File.Imports = make(map[string]string)
+		}
+		if url, ok := askewFile.File.Imports["askew"]; ok {
+			if url != "github.com/flyx/askew/runtime" {
+				return errors.New(
+					": if the alias `askew` is given in imports, it must link to \"github.com/flyx/askew/runtime\"")
 			}
-			pkg.Files = append(pkg.Files, askewFile)
 		} else {
-			if pkg.Site != nil {
-				return fmt.Errorf("%s: a package cannot contain multiple sites", path)
-			}
+			askewFile.File.Imports["askew"] = "github.com/flyx/askew/runtime"
+		}
+		pkg.Files = append(pkg.Files, askewFile)
+	} else {
+		if pkg.Site != nil {
+			return errors.New(": a package cannot contain multiple sites")
+		}
 
-			asiteFile := &data.ASiteFile{File: data.File{BaseName: baseName, Path: path}}
-			asiteFile.Document, err = html.ParseWithOptions(bytes.NewReader(contents),
-				html.ParseOptionCustomElements(walker.AskewElements))
-			if err != nil {
-				return fmt.Errorf("%s: %s", path, err.Error())
-			}
-			if asiteFile.Document.Type != html.DocumentNode ||
-				asiteFile.Document.FirstChild.Type != html.DoctypeNode {
-				return fmt.Errorf("%s: does not contain a complete HTML 5 document (doctype missing?)", path)
-			}
-			rootNode := asiteFile.Document.FirstChild.NextSibling
-			if rootNode.Type != html.ElementNode || rootNode.Data != "a:site" {
-				return fmt.Errorf("%s: root is not a <a:site> node", path)
-			}
-			head, err := descend(rootNode, []atom.Atom{atom.Head})
-			if err != nil {
-				return fmt.Errorf("%s: %s", path, err.Error())
-			}
-			pHandler := &packageHandler{pkg: pkg, seen: false, remove: true}
-			w := walker.Walker{
-				Package:     pHandler,
-				Import:      &importHandler{file: &asiteFile.File, remove: true},
-				TextNode:    walker.WhitespaceOnly{},
-				StdElements: walker.DontDescend{}}
-			_, _, err = w.WalkChildren(head, &walker.Siblings{Cur: head.FirstChild})
-			if err != nil {
-				return fmt.Errorf("%s: %s", path, err.Error())
-			}
-			if !pHandler.seen {
-				if pkg.Name == "" {
-					pkg.Name = assumedPkgName
-				} else if pkg.Name != assumedPkgName {
-					return fmt.Errorf("%s: <a:package> missing, has been set to %s in another file", path, pkg.Name)
-				}
+		asiteFile := &data.ASiteFile{File: data.File{BaseName: baseName, Path: path}}
+		asiteFile.Document, err = html.ParseWithOptions(bytes.NewReader(contents),
+			html.ParseOptionCustomElements(walker.AskewElements))
+		if err != nil {
+			return err
+		}
+		if asiteFile.Document.Type != html.DocumentNode ||
+			asiteFile.Document.FirstChild.Type != html.DoctypeNode {
+			return errors.New(": does not contain a complete HTML 5 document (doctype missing?)")
+		}
+		rootNode := asiteFile.Document.FirstChild.NextSibling
+		if rootNode.Type != html.ElementNode || rootNode.Data != "a:site" {
+			return errors.New(": root is not a <a:site> node")
+		}
+		head, err := descend(rootNode, []atom.Atom{atom.Head})
+		if err != nil {
+			return err
+		}
+		pHandler := &packageHandler{pkg: pkg, seen: false, remove: true}
+		w := walker.Walker{
+			Package:     pHandler,
+			Import:      &importHandler{file: &asiteFile.File, remove: true},
+			TextNode:    walker.WhitespaceOnly{},
+			StdElements: walker.DontDescend{}}
+		_, _, err = w.WalkChildren(head, &walker.Siblings{Cur: head.FirstChild})
+		if err != nil {
+			return data.ErrorAt(data.Position{File: path}, err)
+		}
+		if !pHandler.seen {
+			if pkg.Name == "" {
+				pkg.Name = assumedPkgName
+			} else if pkg.Name != assumedPkgName {
+				return errors.New(": <a:package> missing, has been set to " + pkg.Name + " in another file")
 			}
-			pkg.Site = asiteFile
 		}
-
-		return nil
-	})
-	if err != nil {
-		return nil, err
+		pkg.Site = asiteFile
 	}
-	return ret, nil
+	return nil
 }
 
 type importHandler struct {
@@ -268,7 +371,7 @@ func (ih *importHandler) Process(n *html.Node) (descend bool, replacement *html.
 	}
 	imports, err := parsers.ParseImports(raw)
 	if err != nil {
-		return false, nil, errors.New(": " + err.Error())
+		return false, nil, parsers.WrapError("invalid imports", err)
 	}
 	if ih.file.Imports != nil {
 		return false, nil, errors.New(": cannot have more than one <a:import> per file")
@@ -0,0 +1,61 @@
+package packages
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/flyx/askew/data"
+	"github.com/flyx/net/html"
+)
+
+// annotatePositions adds an attribute to each start tag in the given HTML
+// source which contains the position of the tag. The HTML parser does not
+// track positions, so this is how the position of each element is made
+// available after parsing. Columns count characters, not bytes.
+//
+// Since the attribute is part of the element, it survives copying and
+// re-parsing of nodes during macro processing.
+func annotatePositions(path string, contents []byte) []byte {
+	z := html.NewTokenizer(bytes.NewReader(contents))
+	var ret bytes.Buffer
+	ret.Grow(len(contents) * 2)
+	line, col := 1, 1
+	for {
+		tt := z.Next()
+		raw := z.Raw()
+		if tt == html.StartTagToken || tt == html.SelfClosingTagToken {
+			nameEnd := 1
+			for nameEnd < len(raw) {
+				switch raw[nameEnd] {
+				case ' ', '\n', '\r', '\t', '\f', '/', '>':
+					break
+				default:
+					nameEnd++
+					continue
+				}
+				break
+			}
+			pos := data.Position{File: path, Line: line, Column: col}
+			ret.Write(raw[:nameEnd])
+			ret.WriteString(" " + data.PositionAttr + "=\"")
+			ret.WriteString(html.EscapeString(pos.String()))
+			ret.WriteByte('"')
+			ret.Write(raw[nameEnd:])
+		} else {
+			ret.Write(raw)
+		}
+		for _, c := range raw {
+			switch {
+			case c == '\n':
+				line++
+				col = 1
+			case utf8.RuneStart(c):
+				col++
+			}
+		}
+		if tt == html.ErrorToken {
+			break
+		}
+	}
+	return ret.Bytes()
+}
@@ -0,0 +1,29 @@
+package packages
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/flyx/askew/data"
+)
+
+func TestAnnotatePositions(t *testing.T) {
+	input := "<a:component name=\"X\">\n\t<p>äöü <b>x</b></p><br/>\n</a:component>"
+	expected := []string{"a.askew:1:1", "a.askew:2:2", "a.askew:2:9", "a.askew:2:21"}
+	actual := string(annotatePositions("a.askew", []byte(input)))
+	var found []string
+	for rest := actual; ; {
+		i := strings.Index(rest, data.PositionAttr+"=\"")
+		if i == -1 {
+			break
+		}
+		rest = rest[i+len(data.PositionAttr)+2:]
+		found = append(found, rest[:strings.IndexByte(rest, '"')])
+	}
+	if strings.Join(found, " ") != strings.Join(expected, " ") {
+		t.Fatalf("expected positions %v, got %v in\n%s", expected, found, actual)
+	}
+	if !strings.Contains(actual, "<br "+data.PositionAttr+"=\"a.askew:2:21\"/>") {
+		t.Fatalf("self-closing tag has not been annotated correctly:\n%s", actual)
+	}
+}
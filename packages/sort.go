@@ -88,3 +88,46 @@ func Sort(importPath string, packages map[string]*data.Package) ([]string, error
 	}
 	return s.result, nil
 }
+
+// Dependents returns the given packages together with all packages that
+// directly or indirectly depend on them. Packages are given and returned as
+// paths relative to the module's base directory.
+func Dependents(importPath string, packages map[string]*data.Package,
+	changed []string) []string {
+	imported := make(map[string][]string)
+	addImports := func(name string, imports map[string]string) {
+		for _, item := range imports {
+			if !strings.HasPrefix(item, importPath) {
+				continue
+			}
+			relPath, err := filepath.Rel(importPath, item)
+			if err != nil {
+				continue
+			}
+			imported[relPath] = append(imported[relPath], name)
+		}
+	}
+	for name, pkg := range packages {
+		for _, file := range pkg.Files {
+			addImports(name, file.Imports)
+		}
+		if pkg.Site != nil {
+			addImports(name, pkg.Site.Imports)
+		}
+	}
+
+	seen := make(map[string]struct{})
+	ret := make([]string, 0, len(changed))
+	queue := append([]string(nil), changed...)
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if _, ok := seen[cur]; ok {
+			continue
+		}
+		seen[cur] = struct{}{}
+		ret = append(ret, cur)
+		queue = append(queue, imported[cur]...)
+	}
+	return ret
+}
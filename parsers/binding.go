@@ -9,7 +9,7 @@ func ParseAssignments(s string) ([]data.Assignment, error) {
 	p := GeneralParser{Buffer: s}
 	p.Init()
 	if err := p.Parse(int(ruleassignments)); err != nil {
-		return nil, err
+		return nil, syntaxError(err)
 	}
 	p.Execute()
 	return p.assignments, nil
@@ -20,8 +20,19 @@ func ParseBindings(s string) ([]data.VariableMapping, error) {
 	p := GeneralParser{Buffer: s}
 	p.Init()
 	if err := p.Parse(int(rulebindings)); err != nil {
-		return nil, err
+		return nil, syntaxError(err)
 	}
 	p.Execute()
 	return p.varMappings, nil
 }
+
+// ParseModels parses a list of models in an a:model attribute
+func ParseModels(s string) ([]data.Model, error) {
+	p := GeneralParser{Buffer: s}
+	p.Init()
+	if err := p.Parse(int(rulemodels)); err != nil {
+		return nil, syntaxError(err)
+	}
+	p.Execute()
+	return p.models, nil
+}
@@ -0,0 +1,117 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/flyx/askew/data"
+)
+
+func TestParseModels(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected []data.Model
+	}{
+		{"prop(value):name", []data.Model{
+			{Field: "name", Value: data.BoundValue{Kind: data.BoundProperty, IDs: []string{"value"}}}}},
+		{" prop(checked) : subscribed ", []data.Model{
+			{Field: "subscribed", Value: data.BoundValue{Kind: data.BoundProperty, IDs: []string{"checked"}}}}},
+		{"prop(value):name, dataset(age):age; style(color):color", []data.Model{
+			{Field: "name", Value: data.BoundValue{Kind: data.BoundProperty, IDs: []string{"value"}}},
+			{Field: "age", Value: data.BoundValue{Kind: data.BoundDataset, IDs: []string{"age"}}},
+			{Field: "color", Value: data.BoundValue{Kind: data.BoundStyle, IDs: []string{"color"}}}}},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			models, err := ParseModels(tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(models, tc.expected) {
+				t.Errorf("unexpected models:\n  got:  %+v\n  want: %+v", models, tc.expected)
+			}
+		})
+	}
+}
+
+func TestParseModelsErrors(t *testing.T) {
+	for _, tc := range []struct {
+		input  string
+		offset int
+	}{
+		// models require a field name, not a typed variable.
+		{"prop(value):(name string)", 11},
+		{"prop(value)", 11},
+		{"prop(value):name prop(checked):other", 17},
+		{"", 0},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			_, err := ParseModels(tc.input)
+			se, ok := err.(*SyntaxError)
+			if !ok {
+				t.Fatalf("expected SyntaxError, got %v", err)
+			}
+			if se.Offset != tc.offset {
+				t.Errorf("expected offset %d, got %d (%s)", tc.offset, se.Offset, se.Message)
+			}
+		})
+	}
+}
+
+func TestParseAssignmentsSignal(t *testing.T) {
+	prop := func(name string) data.BoundValue {
+		return data.BoundValue{Kind: data.BoundProperty, IDs: []string{name}}
+	}
+	for _, tc := range []struct {
+		input    string
+		expected []data.Assignment
+	}{
+		{"prop(textContent) = signal(o.Label)", []data.Assignment{
+			{Expression: "o.Label", Target: prop("textContent"), Signal: true}}},
+		{"prop(textContent)=signal( label)", []data.Assignment{
+			{Expression: "label", Target: prop("textContent"), Signal: true}}},
+		{"prop(textContent) = signal(o.Label); class(red) = signal(o.Odd)", []data.Assignment{
+			{Expression: "o.Label", Target: prop("textContent"), Signal: true},
+			{Expression: "o.Odd", Target: data.BoundValue{Kind: data.BoundClass, IDs: []string{"red"}},
+				Signal: true}}},
+		{"prop(title) = signal(a), prop(value) = b", []data.Assignment{
+			{Expression: "a", Target: prop("title"), Signal: true},
+			{Expression: "b", Target: prop("value")}}},
+		// expressions that merely start with a call of signal are plain
+		// expressions.
+		{"prop(title) = signal(a) + b", []data.Assignment{
+			{Expression: "signal(a) + b", Target: prop("title")}}},
+		{"prop(title) = signals(a)", []data.Assignment{
+			{Expression: "signals(a)", Target: prop("title")}}},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			assignments, err := ParseAssignments(tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(assignments, tc.expected) {
+				t.Errorf("unexpected assignments:\n  got:  %+v\n  want: %+v", assignments, tc.expected)
+			}
+		})
+	}
+}
+
+func TestParseBindingsTypes(t *testing.T) {
+	bindings, err := ParseBindings(
+		"form(price):(Price float64), prop(value):(At time.Time); prop(value):(P pkg2.Level3), form(n):N")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"float64", "time.Time", "pkg2.Level3", ""}
+	if len(bindings) != len(expected) {
+		t.Fatalf("expected %d bindings, got %d", len(expected), len(bindings))
+	}
+	for i, b := range bindings {
+		var typ string
+		if b.Variable.Type != nil {
+			typ = b.Variable.Type.String()
+		}
+		if typ != expected[i] {
+			t.Errorf("binding of %s: expected type %q, got %q", b.Variable.Name, expected[i], typ)
+		}
+	}
+}
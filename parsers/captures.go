@@ -8,7 +8,7 @@ func ParseCapture(s string) ([]data.UnboundEventMapping, error) {
 		eventHandling: data.AutoPreventDefault}
 	p.Init()
 	if err := p.Parse(int(rulecaptures)); err != nil {
-		return nil, err
+		return nil, syntaxError(err)
 	}
 	p.Execute()
 	return p.eventMappings, p.err
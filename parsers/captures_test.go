@@ -0,0 +1,63 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/flyx/askew/data"
+)
+
+func TestParseCaptureDelegate(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected []data.UnboundEventMapping
+	}{
+		{"click:click()", []data.UnboundEventMapping{
+			{Event: "click", Handler: "click", ParamMappings: map[string]data.BoundValue{},
+				Handling: data.AutoPreventDefault}}},
+		{"click:click() {delegate}", []data.UnboundEventMapping{
+			{Event: "click", Handler: "click", ParamMappings: map[string]data.BoundValue{},
+				Handling: data.AutoPreventDefault, Delegate: true}}},
+		{"click:click {preventDefault, delegate}", []data.UnboundEventMapping{
+			{Event: "click", Handler: "click", ParamMappings: map[string]data.BoundValue{},
+				Handling: data.PreventDefault, Delegate: true}}},
+		// the tag only applies to the capture it is given for.
+		{"submit:Submit(name=form(Name)) { delegate , preventDefault(ask) }, reset:Reset", []data.UnboundEventMapping{
+			{Event: "submit", Handler: "Submit", ParamMappings: map[string]data.BoundValue{
+				"name": {Kind: data.BoundFormValue, IDs: []string{"Name"}}},
+				Handling: data.AskPreventDefault, Delegate: true},
+			{Event: "reset", Handler: "Reset", ParamMappings: map[string]data.BoundValue{},
+				Handling: data.AutoPreventDefault}}},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			mappings, err := ParseCapture(tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(mappings, tc.expected) {
+				t.Errorf("unexpected mappings:\n  got:  %+v\n  want: %+v", mappings, tc.expected)
+			}
+		})
+	}
+}
+
+func TestParseCaptureDelegateErrors(t *testing.T) {
+	for _, tc := range []struct {
+		input   string
+		message string
+	}{
+		{"click:click() {delegate, delegate}", "duplicate delegate"},
+		{"click:click() {delegate(true)}", "delegate does not take parameters"},
+		{"click:click() {delegated}", "unknown tag: delegated"},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			_, err := ParseCapture(tc.input)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if err.Error() != tc.message {
+				t.Errorf("expected error %q, got %q", tc.message, err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package parsers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/flyx/askew/data"
+)
+
+// SyntaxError is returned when the input of a parser is not syntactically
+// valid.
+type SyntaxError struct {
+	// Offset is the number of characters in the input before the position
+	// where the error has been detected.
+	Offset  int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s (at offset %d)", e.Message, e.Offset)
+}
+
+// syntaxError converts an error returned by the generated parser into a
+// SyntaxError.
+func syntaxError(err error) error {
+	pe, ok := err.(*parseError)
+	if !ok {
+		return err
+	}
+	offset := int(pe.max.end)
+	input := []rune(pe.p.Buffer)
+	if offset >= len(input) {
+		return &SyntaxError{Offset: offset, Message: "unexpected end of input"}
+	}
+	return &SyntaxError{Offset: offset,
+		Message: fmt.Sprintf("unexpected %q", input[offset])}
+}
+
+// WrapError adds the given context description to an error returned by one of
+// the parsers. If err is a SyntaxError, the returned error is a *data.Error
+// that retains the offset of err.
+func WrapError(context string, err error) error {
+	if se, ok := err.(*SyntaxError); ok {
+		return &data.Error{Offset: se.Offset, Message: context + ": " + se.Message}
+	}
+	return errors.New(": " + context + ": " + err.Error())
+}
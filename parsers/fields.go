@@ -4,13 +4,14 @@ import "github.com/flyx/askew/data"
 
 //go:generate peg -switch grammar.peg
 
-// ParseFields parses the content of a <a:data> element.
-func ParseFields(s string) ([]*data.Field, error) {
+// ParseFields parses the content of a <a:data> element. It also returns the
+// offset of each field's name in s, counted in runes.
+func ParseFields(s string) ([]*data.Field, []int, error) {
 	p := GeneralParser{Buffer: s}
 	p.Init()
 	if err := p.Parse(int(rulefields)); err != nil {
-		return nil, err
+		return nil, nil, syntaxError(err)
 	}
 	p.Execute()
-	return p.fields, nil
+	return p.fields, p.fieldOffsets, nil
 }
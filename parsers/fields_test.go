@@ -0,0 +1,51 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseFields(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		names    []string
+		types    []string
+		defaults []string
+		offsets  []int
+	}{
+		{"a int", []string{"a"}, []string{"int"}, []string{""}, []int{0}},
+		{"\n\ta, b string\n\tc bool = true\n",
+			[]string{"a", "b", "c"}, []string{"string", "string", "bool"},
+			[]string{"", "", "true"}, []int{2, 5, 15}},
+		{"x1 float64; y2 *askew.Signal[int] = askew.NewSignal(0)",
+			[]string{"x1", "y2"}, []string{"float64", "*askew.Signal[int]"},
+			[]string{"", "askew.NewSignal(0)"}, []int{0, 12}},
+		// offsets are counted in runes.
+		{"s string = `äöü`\nn int", []string{"s", "n"}, []string{"string", "int"},
+			[]string{"`äöü`", ""}, []int{0, 17}},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			fields, offsets, err := ParseFields(tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var names, types, defaults []string
+			for _, f := range fields {
+				names = append(names, f.Name)
+				types = append(types, f.Type.String())
+				if f.DefaultValue == nil {
+					defaults = append(defaults, "")
+				} else {
+					defaults = append(defaults, *f.DefaultValue)
+				}
+			}
+			if !reflect.DeepEqual(names, tc.names) || !reflect.DeepEqual(types, tc.types) ||
+				!reflect.DeepEqual(defaults, tc.defaults) {
+				t.Errorf("unexpected fields: names %v, types %v, defaults %v", names, types, defaults)
+			}
+			if !reflect.DeepEqual(offsets, tc.offsets) {
+				t.Errorf("expected offsets %v, got %v", tc.offsets, offsets)
+			}
+		})
+	}
+}
@@ -21,10 +21,13 @@ const (
 	ruleassignments
 	rulebindings
 	rulebinding
+	rulemodels
+	rulemodel
 	ruleautovar
 	ruletypedvar
 	ruleisp
 	ruleassignment
+	rulesignal
 	rulebound
 	ruleself
 	ruledataset
@@ -52,6 +55,9 @@ const (
 	rulefield
 	rulename
 	ruletype
+	rulegeneric
+	ruletypeargsstart
+	ruletypearg
 	rulesname
 	ruleqname
 	rulearray
@@ -86,8 +92,8 @@ const (
 	ruleimports
 	ruleimport
 	ruleAction0
-	rulePegText
 	ruleAction1
+	rulePegText
 	ruleAction2
 	ruleAction3
 	ruleAction4
@@ -128,6 +134,11 @@ const (
 	ruleAction39
 	ruleAction40
 	ruleAction41
+	ruleAction42
+	ruleAction43
+	ruleAction44
+	ruleAction45
+	ruleAction46
 
 	rulePre
 	ruleIn
@@ -140,10 +151,13 @@ var rul3s = [...]string{
 	"assignments",
 	"bindings",
 	"binding",
+	"models",
+	"model",
 	"autovar",
 	"typedvar",
 	"isp",
 	"assignment",
+	"signal",
 	"bound",
 	"self",
 	"dataset",
@@ -171,6 +185,9 @@ var rul3s = [...]string{
 	"field",
 	"name",
 	"type",
+	"generic",
+	"typeargsstart",
+	"typearg",
 	"sname",
 	"qname",
 	"array",
@@ -205,8 +222,8 @@ var rul3s = [...]string{
 	"imports",
 	"import",
 	"Action0",
-	"PegText",
 	"Action1",
+	"PegText",
 	"Action2",
 	"Action3",
 	"Action4",
@@ -247,6 +264,11 @@ var rul3s = [...]string{
 	"Action39",
 	"Action40",
 	"Action41",
+	"Action42",
+	"Action43",
+	"Action44",
+	"Action45",
+	"Action46",
 
 	"Pre_",
 	"_In_",
@@ -539,21 +561,27 @@ func (t *tokens32) Expand(index int) {
 
 type GeneralParser struct {
 	eventHandling                         data.EventHandling
+	delegate                              bool
 	expr, tagname, handlername, eventName string
 	paramnames                            []string
 	names                                 []string
 	keytype, valuetype                    *data.ParamType
+	generics                              []*data.ParamType
 	fields                                []*data.Field
-	bv                                    data.BoundValue
-	goVal                                 data.GoValue
-	paramMappings                         map[string]data.BoundValue
-	paramIndex                            int
-	params                                []data.Param
-	isVar                                 bool
-	err                                   error
+	// fieldOffsets holds the offset of each field in fields.
+	fieldOffsets  []int
+	bv            data.BoundValue
+	goVal         data.GoValue
+	paramMappings map[string]data.BoundValue
+	paramIndex    int
+	params        []data.Param
+	isVar         bool
+	signal        bool
+	err           error
 
 	assignments   []data.Assignment
 	varMappings   []data.VariableMapping
+	models        []data.Model
 	eventMappings []data.UnboundEventMapping
 	handlers      []HandlerSpec
 	cParams       []data.ComponentParam
@@ -561,7 +589,7 @@ type GeneralParser struct {
 
 	Buffer string
 	buffer []rune
-	rules  [112]func() bool
+	rules  [123]func() bool
 	Parse  func(rule ...int) error
 	Reset  func()
 	Pretty bool
@@ -654,68 +682,78 @@ func (p *GeneralParser) Execute() {
 
 		case ruleAction1:
 
-			p.goVal.Name = buffer[begin:end]
+			p.models = append(p.models, data.Model{Value: p.bv, Field: p.goVal.Name})
+			p.bv.IDs = nil
 
 		case ruleAction2:
 
+			p.goVal.Name = text
+
+		case ruleAction3:
+
 			p.goVal.Type = p.valuetype
 			p.valuetype = nil
 
-		case ruleAction3:
+		case ruleAction4:
 
 			p.assignments = append(p.assignments, data.Assignment{Expression: p.expr,
-				Target: p.bv})
+				Target: p.bv, Signal: p.signal})
 			p.bv.IDs = nil
+			p.signal = false
 
-		case ruleAction4:
+		case ruleAction5:
+
+			p.signal = true
+
+		case ruleAction6:
 
 			p.bv.Kind = data.BoundSelf
 
-		case ruleAction5:
+		case ruleAction7:
 
 			p.bv.Kind = data.BoundDataset
 
-		case ruleAction6:
+		case ruleAction8:
 
 			p.bv.Kind = data.BoundProperty
 
-		case ruleAction7:
+		case ruleAction9:
 
 			p.bv.Kind = data.BoundStyle
 
-		case ruleAction8:
+		case ruleAction10:
 
 			p.bv.Kind = data.BoundClass
 
-		case ruleAction9:
+		case ruleAction11:
 
 			p.bv.Kind = data.BoundFormValue
 
-		case ruleAction10:
+		case ruleAction12:
 
 			p.bv.Kind = data.BoundExpr
 			p.bv.IDs = append(p.bv.IDs, p.expr)
 
-		case ruleAction11:
+		case ruleAction13:
 
 			p.bv.Kind = data.BoundEventValue
 			if len(p.bv.IDs) == 0 {
 				p.bv.IDs = append(p.bv.IDs, "")
 			}
 
-		case ruleAction12:
+		case ruleAction14:
 
-			p.bv.IDs = append(p.bv.IDs, buffer[begin:end])
+			p.bv.IDs = append(p.bv.IDs, text)
 
-		case ruleAction13:
+		case ruleAction15:
 
-			p.bv.IDs = append(p.bv.IDs, buffer[begin:end])
+			p.bv.IDs = append(p.bv.IDs, text)
 
-		case ruleAction14:
+		case ruleAction16:
 
-			p.expr = buffer[begin:end]
+			p.expr = text
 
-		case ruleAction15:
+		case ruleAction17:
 
 			var expr *string
 			if p.expr != "" {
@@ -729,13 +767,28 @@ func (p *GeneralParser) Execute() {
 			p.valuetype = nil
 			p.names = nil
 
-		case ruleAction16:
+		case ruleAction18:
 
-			p.names = append(p.names, buffer[begin:end])
+			p.names = append(p.names, text)
+			p.fieldOffsets = append(p.fieldOffsets, begin)
 
-		case ruleAction17:
+		case ruleAction19:
+
+			p.valuetype = p.generics[len(p.generics)-1]
+			p.generics = p.generics[:len(p.generics)-1]
+
+		case ruleAction20:
+
+			p.generics = append(p.generics, p.valuetype)
+
+		case ruleAction21:
+
+			t := p.generics[len(p.generics)-1]
+			t.TypeArgs = append(t.TypeArgs, p.valuetype)
 
-			switch name := buffer[begin:end]; name {
+		case ruleAction22:
+
+			switch name := text; name {
 			case "int":
 				p.valuetype = &data.ParamType{Kind: data.IntType}
 			case "bool":
@@ -746,64 +799,65 @@ func (p *GeneralParser) Execute() {
 				p.valuetype = &data.ParamType{Kind: data.NamedType, Name: name}
 			}
 
-		case ruleAction18:
+		case ruleAction23:
 
-			name := buffer[begin:end]
+			name := text
 			if name == "js.Value" {
 				p.valuetype = &data.ParamType{Kind: data.JSValueType}
 			} else {
 				p.valuetype = &data.ParamType{Kind: data.NamedType, Name: name}
 			}
 
-		case ruleAction19:
+		case ruleAction24:
 
 			p.valuetype = &data.ParamType{Kind: data.ArrayType, ValueType: p.valuetype}
 
-		case ruleAction20:
+		case ruleAction25:
 
 			p.valuetype = &data.ParamType{Kind: data.MapType, KeyType: p.keytype, ValueType: p.valuetype}
 
-		case ruleAction21:
+		case ruleAction26:
 
 			p.valuetype = &data.ParamType{Kind: data.ChanType, ValueType: p.valuetype}
 
-		case ruleAction22:
+		case ruleAction27:
 
 			p.valuetype = &data.ParamType{Kind: data.FuncType, ValueType: p.valuetype,
 				Params: p.params}
 			p.params = nil
 
-		case ruleAction23:
+		case ruleAction28:
 
 			p.keytype = p.valuetype
 
-		case ruleAction24:
+		case ruleAction29:
 
 			p.valuetype = &data.ParamType{Kind: data.PointerType, ValueType: p.valuetype}
 
-		case ruleAction25:
+		case ruleAction30:
 
 			p.eventMappings = append(p.eventMappings, data.UnboundEventMapping{
 				Event: p.eventName, Handler: p.handlername, ParamMappings: p.paramMappings,
-				Handling: p.eventHandling})
+				Handling: p.eventHandling, Delegate: p.delegate})
 			p.eventHandling = data.AutoPreventDefault
+			p.delegate = false
 			p.expr = ""
 			p.paramMappings = make(map[string]data.BoundValue)
 
-		case ruleAction26:
+		case ruleAction31:
 
-			p.handlername = buffer[begin:end]
+			p.handlername = text
 
-		case ruleAction27:
+		case ruleAction32:
 
-			p.eventName = buffer[begin:end]
+			p.eventName = text
 
-		case ruleAction28:
+		case ruleAction33:
 
 			p.paramIndex = 0
 			p.tagname = ""
 
-		case ruleAction29:
+		case ruleAction34:
 
 			if p.tagname == "" {
 				if p.paramIndex == -1 {
@@ -823,11 +877,11 @@ func (p *GeneralParser) Execute() {
 			p.tagname = ""
 			p.bv.IDs = nil
 
-		case ruleAction30:
+		case ruleAction35:
 
-			p.tagname = buffer[begin:end]
+			p.tagname = text
 
-		case ruleAction31:
+		case ruleAction36:
 
 			switch p.tagname {
 			case "preventDefault":
@@ -854,36 +908,46 @@ func (p *GeneralParser) Execute() {
 					p.err = errors.New("too many parameters for preventDefault")
 					return
 				}
+			case "delegate":
+				if p.delegate {
+					p.err = errors.New("duplicate delegate")
+					return
+				}
+				if len(p.names) != 0 {
+					p.err = errors.New("delegate does not take parameters")
+					return
+				}
+				p.delegate = true
 			default:
 				p.err = errors.New("unknown tag: " + p.tagname)
 				return
 			}
 			p.names = nil
 
-		case ruleAction32:
+		case ruleAction37:
 
-			p.tagname = buffer[begin:end]
+			p.tagname = text
 
-		case ruleAction33:
+		case ruleAction38:
 
-			p.names = append(p.names, buffer[begin:end])
+			p.names = append(p.names, text)
 
-		case ruleAction34:
+		case ruleAction39:
 
-			p.names = append(p.names, buffer[begin:end])
+			p.names = append(p.names, text)
 
-		case ruleAction35:
+		case ruleAction40:
 
 			p.handlers = append(p.handlers, HandlerSpec{
 				Name: p.handlername, Params: p.params, Returns: p.valuetype})
 			p.valuetype = nil
 			p.params = nil
 
-		case ruleAction36:
+		case ruleAction41:
 
-			p.paramnames = append(p.paramnames, buffer[begin:end])
+			p.paramnames = append(p.paramnames, text)
 
-		case ruleAction37:
+		case ruleAction42:
 
 			name := p.paramnames[len(p.paramnames)-1]
 			p.paramnames = p.paramnames[:len(p.paramnames)-1]
@@ -897,24 +961,24 @@ func (p *GeneralParser) Execute() {
 			p.params = append(p.params, data.Param{Name: name, Type: p.valuetype})
 			p.valuetype = nil
 
-		case ruleAction38:
+		case ruleAction43:
 
 			p.cParams = append(p.cParams, data.ComponentParam{
 				Name: p.tagname, Type: *p.valuetype, IsVar: p.isVar})
 			p.valuetype = nil
 			p.isVar = false
 
-		case ruleAction39:
+		case ruleAction44:
 
 			p.isVar = true
 
-		case ruleAction40:
+		case ruleAction45:
 
 			p.names = append(p.names, p.expr)
 
-		case ruleAction41:
+		case ruleAction46:
 
-			path := buffer[begin:end]
+			path := text
 			if p.tagname == "" {
 				lastDot := strings.LastIndexByte(path, '/')
 				if lastDot == -1 {
@@ -998,7 +1062,7 @@ func (p *GeneralParser) Init() {
 
 	_rules = [...]func() bool{
 		nil,
-		/* 0 e <- <(assignments / bindings / captures / fields / for / handlers / cparams / args / imports)> */
+		/* 0 e <- <(assignments / bindings / models / captures / fields / for / handlers / cparams / args / imports)> */
 		func() bool {
 			position0, tokenIndex0, depth0 := position, tokenIndex, depth
 			{
@@ -1018,41 +1082,47 @@ func (p *GeneralParser) Init() {
 					goto l2
 				l4:
 					position, tokenIndex, depth = position2, tokenIndex2, depth2
-					if !_rules[rulecaptures]() {
+					if !_rules[rulemodels]() {
 						goto l5
 					}
 					goto l2
 				l5:
 					position, tokenIndex, depth = position2, tokenIndex2, depth2
-					if !_rules[rulefields]() {
+					if !_rules[rulecaptures]() {
 						goto l6
 					}
 					goto l2
 				l6:
 					position, tokenIndex, depth = position2, tokenIndex2, depth2
-					if !_rules[rulefor]() {
+					if !_rules[rulefields]() {
 						goto l7
 					}
 					goto l2
 				l7:
 					position, tokenIndex, depth = position2, tokenIndex2, depth2
-					if !_rules[rulehandlers]() {
+					if !_rules[rulefor]() {
 						goto l8
 					}
 					goto l2
 				l8:
 					position, tokenIndex, depth = position2, tokenIndex2, depth2
-					if !_rules[rulecparams]() {
+					if !_rules[rulehandlers]() {
 						goto l9
 					}
 					goto l2
 				l9:
 					position, tokenIndex, depth = position2, tokenIndex2, depth2
-					if !_rules[ruleargs]() {
+					if !_rules[rulecparams]() {
 						goto l10
 					}
 					goto l2
 				l10:
+					position, tokenIndex, depth = position2, tokenIndex2, depth2
+					if !_rules[ruleargs]() {
+						goto l11
+					}
+					goto l2
+				l11:
 					position, tokenIndex, depth = position2, tokenIndex2, depth2
 					if !_rules[ruleimports]() {
 						goto l0
@@ -1069,5247 +1139,5792 @@ func (p *GeneralParser) Init() {
 		},
 		/* 1 assignments <- <(isp* assignment isp* ((',' / ';') isp* assignment isp*)* !.)> */
 		func() bool {
-			position11, tokenIndex11, depth11 := position, tokenIndex, depth
+			position12, tokenIndex12, depth12 := position, tokenIndex, depth
 			{
-				position12 := position
+				position13 := position
 				depth++
-			l13:
+			l14:
 				{
-					position14, tokenIndex14, depth14 := position, tokenIndex, depth
+					position15, tokenIndex15, depth15 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l14
+						goto l15
 					}
-					goto l13
-				l14:
-					position, tokenIndex, depth = position14, tokenIndex14, depth14
+					goto l14
+				l15:
+					position, tokenIndex, depth = position15, tokenIndex15, depth15
 				}
 				if !_rules[ruleassignment]() {
-					goto l11
+					goto l12
 				}
-			l15:
+			l16:
 				{
-					position16, tokenIndex16, depth16 := position, tokenIndex, depth
+					position17, tokenIndex17, depth17 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l16
+						goto l17
 					}
-					goto l15
-				l16:
-					position, tokenIndex, depth = position16, tokenIndex16, depth16
+					goto l16
+				l17:
+					position, tokenIndex, depth = position17, tokenIndex17, depth17
 				}
-			l17:
+			l18:
 				{
-					position18, tokenIndex18, depth18 := position, tokenIndex, depth
+					position19, tokenIndex19, depth19 := position, tokenIndex, depth
 					{
-						position19, tokenIndex19, depth19 := position, tokenIndex, depth
+						position20, tokenIndex20, depth20 := position, tokenIndex, depth
 						if buffer[position] != rune(',') {
-							goto l20
+							goto l21
 						}
 						position++
-						goto l19
-					l20:
-						position, tokenIndex, depth = position19, tokenIndex19, depth19
+						goto l20
+					l21:
+						position, tokenIndex, depth = position20, tokenIndex20, depth20
 						if buffer[position] != rune(';') {
-							goto l18
+							goto l19
 						}
 						position++
 					}
-				l19:
-				l21:
+				l20:
+				l22:
 					{
-						position22, tokenIndex22, depth22 := position, tokenIndex, depth
+						position23, tokenIndex23, depth23 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l22
+							goto l23
 						}
-						goto l21
-					l22:
-						position, tokenIndex, depth = position22, tokenIndex22, depth22
+						goto l22
+					l23:
+						position, tokenIndex, depth = position23, tokenIndex23, depth23
 					}
 					if !_rules[ruleassignment]() {
-						goto l18
+						goto l19
 					}
-				l23:
+				l24:
 					{
-						position24, tokenIndex24, depth24 := position, tokenIndex, depth
+						position25, tokenIndex25, depth25 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l24
+							goto l25
 						}
-						goto l23
-					l24:
-						position, tokenIndex, depth = position24, tokenIndex24, depth24
+						goto l24
+					l25:
+						position, tokenIndex, depth = position25, tokenIndex25, depth25
 					}
-					goto l17
-				l18:
-					position, tokenIndex, depth = position18, tokenIndex18, depth18
+					goto l18
+				l19:
+					position, tokenIndex, depth = position19, tokenIndex19, depth19
 				}
 				{
-					position25, tokenIndex25, depth25 := position, tokenIndex, depth
+					position26, tokenIndex26, depth26 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l25
+						goto l26
 					}
-					goto l11
-				l25:
-					position, tokenIndex, depth = position25, tokenIndex25, depth25
+					goto l12
+				l26:
+					position, tokenIndex, depth = position26, tokenIndex26, depth26
 				}
 				depth--
-				add(ruleassignments, position12)
+				add(ruleassignments, position13)
 			}
 			return true
-		l11:
-			position, tokenIndex, depth = position11, tokenIndex11, depth11
+		l12:
+			position, tokenIndex, depth = position12, tokenIndex12, depth12
 			return false
 		},
 		/* 2 bindings <- <(isp* binding isp* ((',' / ';') isp* binding isp*)* !.)> */
 		func() bool {
-			position26, tokenIndex26, depth26 := position, tokenIndex, depth
+			position27, tokenIndex27, depth27 := position, tokenIndex, depth
 			{
-				position27 := position
+				position28 := position
 				depth++
-			l28:
+			l29:
 				{
-					position29, tokenIndex29, depth29 := position, tokenIndex, depth
+					position30, tokenIndex30, depth30 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l29
+						goto l30
 					}
-					goto l28
-				l29:
-					position, tokenIndex, depth = position29, tokenIndex29, depth29
+					goto l29
+				l30:
+					position, tokenIndex, depth = position30, tokenIndex30, depth30
 				}
 				if !_rules[rulebinding]() {
-					goto l26
+					goto l27
 				}
-			l30:
+			l31:
 				{
-					position31, tokenIndex31, depth31 := position, tokenIndex, depth
+					position32, tokenIndex32, depth32 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l31
+						goto l32
 					}
-					goto l30
-				l31:
-					position, tokenIndex, depth = position31, tokenIndex31, depth31
+					goto l31
+				l32:
+					position, tokenIndex, depth = position32, tokenIndex32, depth32
 				}
-			l32:
+			l33:
 				{
-					position33, tokenIndex33, depth33 := position, tokenIndex, depth
+					position34, tokenIndex34, depth34 := position, tokenIndex, depth
 					{
-						position34, tokenIndex34, depth34 := position, tokenIndex, depth
+						position35, tokenIndex35, depth35 := position, tokenIndex, depth
 						if buffer[position] != rune(',') {
-							goto l35
+							goto l36
 						}
 						position++
-						goto l34
-					l35:
-						position, tokenIndex, depth = position34, tokenIndex34, depth34
+						goto l35
+					l36:
+						position, tokenIndex, depth = position35, tokenIndex35, depth35
 						if buffer[position] != rune(';') {
-							goto l33
+							goto l34
 						}
 						position++
 					}
-				l34:
-				l36:
+				l35:
+				l37:
 					{
-						position37, tokenIndex37, depth37 := position, tokenIndex, depth
+						position38, tokenIndex38, depth38 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l37
+							goto l38
 						}
-						goto l36
-					l37:
-						position, tokenIndex, depth = position37, tokenIndex37, depth37
+						goto l37
+					l38:
+						position, tokenIndex, depth = position38, tokenIndex38, depth38
 					}
 					if !_rules[rulebinding]() {
-						goto l33
+						goto l34
 					}
-				l38:
+				l39:
 					{
-						position39, tokenIndex39, depth39 := position, tokenIndex, depth
+						position40, tokenIndex40, depth40 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l39
+							goto l40
 						}
-						goto l38
-					l39:
-						position, tokenIndex, depth = position39, tokenIndex39, depth39
+						goto l39
+					l40:
+						position, tokenIndex, depth = position40, tokenIndex40, depth40
 					}
-					goto l32
-				l33:
-					position, tokenIndex, depth = position33, tokenIndex33, depth33
+					goto l33
+				l34:
+					position, tokenIndex, depth = position34, tokenIndex34, depth34
 				}
 				{
-					position40, tokenIndex40, depth40 := position, tokenIndex, depth
+					position41, tokenIndex41, depth41 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l40
+						goto l41
 					}
-					goto l26
-				l40:
-					position, tokenIndex, depth = position40, tokenIndex40, depth40
+					goto l27
+				l41:
+					position, tokenIndex, depth = position41, tokenIndex41, depth41
 				}
 				depth--
-				add(rulebindings, position27)
+				add(rulebindings, position28)
 			}
 			return true
-		l26:
-			position, tokenIndex, depth = position26, tokenIndex26, depth26
+		l27:
+			position, tokenIndex, depth = position27, tokenIndex27, depth27
 			return false
 		},
 		/* 3 binding <- <(bound isp* ':' isp* (autovar / typedvar) Action0)> */
 		func() bool {
-			position41, tokenIndex41, depth41 := position, tokenIndex, depth
+			position42, tokenIndex42, depth42 := position, tokenIndex, depth
 			{
-				position42 := position
+				position43 := position
 				depth++
 				if !_rules[rulebound]() {
-					goto l41
+					goto l42
 				}
-			l43:
+			l44:
 				{
-					position44, tokenIndex44, depth44 := position, tokenIndex, depth
+					position45, tokenIndex45, depth45 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l44
+						goto l45
 					}
-					goto l43
-				l44:
-					position, tokenIndex, depth = position44, tokenIndex44, depth44
+					goto l44
+				l45:
+					position, tokenIndex, depth = position45, tokenIndex45, depth45
 				}
 				if buffer[position] != rune(':') {
-					goto l41
+					goto l42
 				}
 				position++
-			l45:
+			l46:
 				{
-					position46, tokenIndex46, depth46 := position, tokenIndex, depth
+					position47, tokenIndex47, depth47 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l46
+						goto l47
 					}
-					goto l45
-				l46:
-					position, tokenIndex, depth = position46, tokenIndex46, depth46
+					goto l46
+				l47:
+					position, tokenIndex, depth = position47, tokenIndex47, depth47
 				}
 				{
-					position47, tokenIndex47, depth47 := position, tokenIndex, depth
+					position48, tokenIndex48, depth48 := position, tokenIndex, depth
 					if !_rules[ruleautovar]() {
-						goto l48
+						goto l49
 					}
-					goto l47
-				l48:
-					position, tokenIndex, depth = position47, tokenIndex47, depth47
+					goto l48
+				l49:
+					position, tokenIndex, depth = position48, tokenIndex48, depth48
 					if !_rules[ruletypedvar]() {
-						goto l41
+						goto l42
 					}
 				}
-			l47:
+			l48:
 				if !_rules[ruleAction0]() {
-					goto l41
+					goto l42
+				}
+				depth--
+				add(rulebinding, position43)
+			}
+			return true
+		l42:
+			position, tokenIndex, depth = position42, tokenIndex42, depth42
+			return false
+		},
+		/* 4 models <- <(isp* model isp* ((',' / ';') isp* model isp*)* !.)> */
+		func() bool {
+			position50, tokenIndex50, depth50 := position, tokenIndex, depth
+			{
+				position51 := position
+				depth++
+			l52:
+				{
+					position53, tokenIndex53, depth53 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l53
+					}
+					goto l52
+				l53:
+					position, tokenIndex, depth = position53, tokenIndex53, depth53
+				}
+				if !_rules[rulemodel]() {
+					goto l50
+				}
+			l54:
+				{
+					position55, tokenIndex55, depth55 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l55
+					}
+					goto l54
+				l55:
+					position, tokenIndex, depth = position55, tokenIndex55, depth55
+				}
+			l56:
+				{
+					position57, tokenIndex57, depth57 := position, tokenIndex, depth
+					{
+						position58, tokenIndex58, depth58 := position, tokenIndex, depth
+						if buffer[position] != rune(',') {
+							goto l59
+						}
+						position++
+						goto l58
+					l59:
+						position, tokenIndex, depth = position58, tokenIndex58, depth58
+						if buffer[position] != rune(';') {
+							goto l57
+						}
+						position++
+					}
+				l58:
+				l60:
+					{
+						position61, tokenIndex61, depth61 := position, tokenIndex, depth
+						if !_rules[ruleisp]() {
+							goto l61
+						}
+						goto l60
+					l61:
+						position, tokenIndex, depth = position61, tokenIndex61, depth61
+					}
+					if !_rules[rulemodel]() {
+						goto l57
+					}
+				l62:
+					{
+						position63, tokenIndex63, depth63 := position, tokenIndex, depth
+						if !_rules[ruleisp]() {
+							goto l63
+						}
+						goto l62
+					l63:
+						position, tokenIndex, depth = position63, tokenIndex63, depth63
+					}
+					goto l56
+				l57:
+					position, tokenIndex, depth = position57, tokenIndex57, depth57
+				}
+				{
+					position64, tokenIndex64, depth64 := position, tokenIndex, depth
+					if !matchDot() {
+						goto l64
+					}
+					goto l50
+				l64:
+					position, tokenIndex, depth = position64, tokenIndex64, depth64
+				}
+				depth--
+				add(rulemodels, position51)
+			}
+			return true
+		l50:
+			position, tokenIndex, depth = position50, tokenIndex50, depth50
+			return false
+		},
+		/* 5 model <- <(bound isp* ':' isp* autovar Action1)> */
+		func() bool {
+			position65, tokenIndex65, depth65 := position, tokenIndex, depth
+			{
+				position66 := position
+				depth++
+				if !_rules[rulebound]() {
+					goto l65
+				}
+			l67:
+				{
+					position68, tokenIndex68, depth68 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l68
+					}
+					goto l67
+				l68:
+					position, tokenIndex, depth = position68, tokenIndex68, depth68
+				}
+				if buffer[position] != rune(':') {
+					goto l65
+				}
+				position++
+			l69:
+				{
+					position70, tokenIndex70, depth70 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l70
+					}
+					goto l69
+				l70:
+					position, tokenIndex, depth = position70, tokenIndex70, depth70
+				}
+				if !_rules[ruleautovar]() {
+					goto l65
+				}
+				if !_rules[ruleAction1]() {
+					goto l65
 				}
 				depth--
-				add(rulebinding, position42)
+				add(rulemodel, position66)
 			}
 			return true
-		l41:
-			position, tokenIndex, depth = position41, tokenIndex41, depth41
+		l65:
+			position, tokenIndex, depth = position65, tokenIndex65, depth65
 			return false
 		},
-		/* 4 autovar <- <(<identifier> Action1)> */
+		/* 6 autovar <- <(<identifier> Action2)> */
 		func() bool {
-			position49, tokenIndex49, depth49 := position, tokenIndex, depth
+			position71, tokenIndex71, depth71 := position, tokenIndex, depth
 			{
-				position50 := position
+				position72 := position
 				depth++
 				{
-					position51 := position
+					position73 := position
 					depth++
 					if !_rules[ruleidentifier]() {
-						goto l49
+						goto l71
 					}
 					depth--
-					add(rulePegText, position51)
+					add(rulePegText, position73)
 				}
-				if !_rules[ruleAction1]() {
-					goto l49
+				if !_rules[ruleAction2]() {
+					goto l71
 				}
 				depth--
-				add(ruleautovar, position50)
+				add(ruleautovar, position72)
 			}
 			return true
-		l49:
-			position, tokenIndex, depth = position49, tokenIndex49, depth49
+		l71:
+			position, tokenIndex, depth = position71, tokenIndex71, depth71
 			return false
 		},
-		/* 5 typedvar <- <('(' isp* autovar isp+ type isp* ')' Action2)> */
+		/* 7 typedvar <- <('(' isp* autovar isp+ type isp* ')' Action3)> */
 		func() bool {
-			position52, tokenIndex52, depth52 := position, tokenIndex, depth
+			position74, tokenIndex74, depth74 := position, tokenIndex, depth
 			{
-				position53 := position
+				position75 := position
 				depth++
 				if buffer[position] != rune('(') {
-					goto l52
+					goto l74
 				}
 				position++
-			l54:
+			l76:
 				{
-					position55, tokenIndex55, depth55 := position, tokenIndex, depth
+					position77, tokenIndex77, depth77 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l55
+						goto l77
 					}
-					goto l54
-				l55:
-					position, tokenIndex, depth = position55, tokenIndex55, depth55
+					goto l76
+				l77:
+					position, tokenIndex, depth = position77, tokenIndex77, depth77
 				}
 				if !_rules[ruleautovar]() {
-					goto l52
+					goto l74
 				}
 				if !_rules[ruleisp]() {
-					goto l52
+					goto l74
 				}
-			l56:
+			l78:
 				{
-					position57, tokenIndex57, depth57 := position, tokenIndex, depth
+					position79, tokenIndex79, depth79 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l57
+						goto l79
 					}
-					goto l56
-				l57:
-					position, tokenIndex, depth = position57, tokenIndex57, depth57
+					goto l78
+				l79:
+					position, tokenIndex, depth = position79, tokenIndex79, depth79
 				}
 				if !_rules[ruletype]() {
-					goto l52
+					goto l74
 				}
-			l58:
+			l80:
 				{
-					position59, tokenIndex59, depth59 := position, tokenIndex, depth
+					position81, tokenIndex81, depth81 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l59
+						goto l81
 					}
-					goto l58
-				l59:
-					position, tokenIndex, depth = position59, tokenIndex59, depth59
+					goto l80
+				l81:
+					position, tokenIndex, depth = position81, tokenIndex81, depth81
 				}
 				if buffer[position] != rune(')') {
-					goto l52
+					goto l74
 				}
 				position++
-				if !_rules[ruleAction2]() {
-					goto l52
+				if !_rules[ruleAction3]() {
+					goto l74
 				}
 				depth--
-				add(ruletypedvar, position53)
+				add(ruletypedvar, position75)
 			}
 			return true
-		l52:
-			position, tokenIndex, depth = position52, tokenIndex52, depth52
+		l74:
+			position, tokenIndex, depth = position74, tokenIndex74, depth74
 			return false
 		},
-		/* 6 isp <- <(' ' / '\t')> */
+		/* 8 isp <- <(' ' / '\t')> */
 		func() bool {
-			position60, tokenIndex60, depth60 := position, tokenIndex, depth
+			position82, tokenIndex82, depth82 := position, tokenIndex, depth
 			{
-				position61 := position
+				position83 := position
 				depth++
 				{
-					position62, tokenIndex62, depth62 := position, tokenIndex, depth
+					position84, tokenIndex84, depth84 := position, tokenIndex, depth
 					if buffer[position] != rune(' ') {
-						goto l63
+						goto l85
 					}
 					position++
-					goto l62
-				l63:
-					position, tokenIndex, depth = position62, tokenIndex62, depth62
+					goto l84
+				l85:
+					position, tokenIndex, depth = position84, tokenIndex84, depth84
 					if buffer[position] != rune('\t') {
-						goto l60
+						goto l82
 					}
 					position++
 				}
-			l62:
+			l84:
 				depth--
-				add(ruleisp, position61)
+				add(ruleisp, position83)
 			}
 			return true
-		l60:
-			position, tokenIndex, depth = position60, tokenIndex60, depth60
+		l82:
+			position, tokenIndex, depth = position82, tokenIndex82, depth82
 			return false
 		},
-		/* 7 assignment <- <(isp* bound isp* '=' isp* expr Action3)> */
+		/* 9 assignment <- <(isp* bound isp* '=' isp* (signal / expr) Action4)> */
 		func() bool {
-			position64, tokenIndex64, depth64 := position, tokenIndex, depth
+			position86, tokenIndex86, depth86 := position, tokenIndex, depth
 			{
-				position65 := position
+				position87 := position
 				depth++
-			l66:
+			l88:
 				{
-					position67, tokenIndex67, depth67 := position, tokenIndex, depth
+					position89, tokenIndex89, depth89 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l67
+						goto l89
 					}
-					goto l66
-				l67:
-					position, tokenIndex, depth = position67, tokenIndex67, depth67
+					goto l88
+				l89:
+					position, tokenIndex, depth = position89, tokenIndex89, depth89
 				}
 				if !_rules[rulebound]() {
-					goto l64
+					goto l86
 				}
-			l68:
+			l90:
 				{
-					position69, tokenIndex69, depth69 := position, tokenIndex, depth
+					position91, tokenIndex91, depth91 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l69
+						goto l91
 					}
-					goto l68
-				l69:
-					position, tokenIndex, depth = position69, tokenIndex69, depth69
+					goto l90
+				l91:
+					position, tokenIndex, depth = position91, tokenIndex91, depth91
 				}
 				if buffer[position] != rune('=') {
-					goto l64
+					goto l86
 				}
 				position++
-			l70:
+			l92:
 				{
-					position71, tokenIndex71, depth71 := position, tokenIndex, depth
+					position93, tokenIndex93, depth93 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l71
+						goto l93
 					}
-					goto l70
-				l71:
-					position, tokenIndex, depth = position71, tokenIndex71, depth71
+					goto l92
+				l93:
+					position, tokenIndex, depth = position93, tokenIndex93, depth93
 				}
-				if !_rules[ruleexpr]() {
-					goto l64
+				{
+					position94, tokenIndex94, depth94 := position, tokenIndex, depth
+					if !_rules[rulesignal]() {
+						goto l95
+					}
+					goto l94
+				l95:
+					position, tokenIndex, depth = position94, tokenIndex94, depth94
+					if !_rules[ruleexpr]() {
+						goto l86
+					}
 				}
-				if !_rules[ruleAction3]() {
-					goto l64
+			l94:
+				if !_rules[ruleAction4]() {
+					goto l86
 				}
 				depth--
-				add(ruleassignment, position65)
+				add(ruleassignment, position87)
 			}
 			return true
-		l64:
-			position, tokenIndex, depth = position64, tokenIndex64, depth64
+		l86:
+			position, tokenIndex, depth = position86, tokenIndex86, depth86
 			return false
 		},
-		/* 8 bound <- <(self / ((&('E' | 'e') event) | (&('F' | 'f') form) | (&('G' | 'g') goExpr) | (&('C' | 'c') class) | (&('S' | 's') style) | (&('P' | 'p') prop) | (&('D' | 'd') dataset)))> */
+		/* 10 signal <- <(('s' / 'S') ('i' / 'I') ('g' / 'G') ('n' / 'N') ('a' / 'A') ('l' / 'L') isp* '(' isp* expr isp* ')' !(isp* (!(',' / ';') .)) Action5)> */
 		func() bool {
-			position72, tokenIndex72, depth72 := position, tokenIndex, depth
+			position96, tokenIndex96, depth96 := position, tokenIndex, depth
 			{
-				position73 := position
+				position97 := position
 				depth++
 				{
-					position74, tokenIndex74, depth74 := position, tokenIndex, depth
-					if !_rules[ruleself]() {
-						goto l75
+					position98, tokenIndex98, depth98 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l99
 					}
-					goto l74
-				l75:
-					position, tokenIndex, depth = position74, tokenIndex74, depth74
+					position++
+					goto l98
+				l99:
+					position, tokenIndex, depth = position98, tokenIndex98, depth98
+					if buffer[position] != rune('S') {
+						goto l96
+					}
+					position++
+				}
+			l98:
+				{
+					position100, tokenIndex100, depth100 := position, tokenIndex, depth
+					if buffer[position] != rune('i') {
+						goto l101
+					}
+					position++
+					goto l100
+				l101:
+					position, tokenIndex, depth = position100, tokenIndex100, depth100
+					if buffer[position] != rune('I') {
+						goto l96
+					}
+					position++
+				}
+			l100:
+				{
+					position102, tokenIndex102, depth102 := position, tokenIndex, depth
+					if buffer[position] != rune('g') {
+						goto l103
+					}
+					position++
+					goto l102
+				l103:
+					position, tokenIndex, depth = position102, tokenIndex102, depth102
+					if buffer[position] != rune('G') {
+						goto l96
+					}
+					position++
+				}
+			l102:
+				{
+					position104, tokenIndex104, depth104 := position, tokenIndex, depth
+					if buffer[position] != rune('n') {
+						goto l105
+					}
+					position++
+					goto l104
+				l105:
+					position, tokenIndex, depth = position104, tokenIndex104, depth104
+					if buffer[position] != rune('N') {
+						goto l96
+					}
+					position++
+				}
+			l104:
+				{
+					position106, tokenIndex106, depth106 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l107
+					}
+					position++
+					goto l106
+				l107:
+					position, tokenIndex, depth = position106, tokenIndex106, depth106
+					if buffer[position] != rune('A') {
+						goto l96
+					}
+					position++
+				}
+			l106:
+				{
+					position108, tokenIndex108, depth108 := position, tokenIndex, depth
+					if buffer[position] != rune('l') {
+						goto l109
+					}
+					position++
+					goto l108
+				l109:
+					position, tokenIndex, depth = position108, tokenIndex108, depth108
+					if buffer[position] != rune('L') {
+						goto l96
+					}
+					position++
+				}
+			l108:
+			l110:
+				{
+					position111, tokenIndex111, depth111 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l111
+					}
+					goto l110
+				l111:
+					position, tokenIndex, depth = position111, tokenIndex111, depth111
+				}
+				if buffer[position] != rune('(') {
+					goto l96
+				}
+				position++
+			l112:
+				{
+					position113, tokenIndex113, depth113 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l113
+					}
+					goto l112
+				l113:
+					position, tokenIndex, depth = position113, tokenIndex113, depth113
+				}
+				if !_rules[ruleexpr]() {
+					goto l96
+				}
+			l114:
+				{
+					position115, tokenIndex115, depth115 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l115
+					}
+					goto l114
+				l115:
+					position, tokenIndex, depth = position115, tokenIndex115, depth115
+				}
+				if buffer[position] != rune(')') {
+					goto l96
+				}
+				position++
+				{
+					position116, tokenIndex116, depth116 := position, tokenIndex, depth
+				l117:
+					{
+						position118, tokenIndex118, depth118 := position, tokenIndex, depth
+						if !_rules[ruleisp]() {
+							goto l118
+						}
+						goto l117
+					l118:
+						position, tokenIndex, depth = position118, tokenIndex118, depth118
+					}
+					{
+						position119, tokenIndex119, depth119 := position, tokenIndex, depth
+						{
+							position120, tokenIndex120, depth120 := position, tokenIndex, depth
+							if buffer[position] != rune(',') {
+								goto l121
+							}
+							position++
+							goto l120
+						l121:
+							position, tokenIndex, depth = position120, tokenIndex120, depth120
+							if buffer[position] != rune(';') {
+								goto l119
+							}
+							position++
+						}
+					l120:
+						goto l116
+					l119:
+						position, tokenIndex, depth = position119, tokenIndex119, depth119
+					}
+					if !matchDot() {
+						goto l116
+					}
+					goto l96
+				l116:
+					position, tokenIndex, depth = position116, tokenIndex116, depth116
+				}
+				if !_rules[ruleAction5]() {
+					goto l96
+				}
+				depth--
+				add(rulesignal, position97)
+			}
+			return true
+		l96:
+			position, tokenIndex, depth = position96, tokenIndex96, depth96
+			return false
+		},
+		/* 11 bound <- <(self / ((&('E' | 'e') event) | (&('F' | 'f') form) | (&('G' | 'g') goExpr) | (&('C' | 'c') class) | (&('S' | 's') style) | (&('P' | 'p') prop) | (&('D' | 'd') dataset)))> */
+		func() bool {
+			position122, tokenIndex122, depth122 := position, tokenIndex, depth
+			{
+				position123 := position
+				depth++
+				{
+					position124, tokenIndex124, depth124 := position, tokenIndex, depth
+					if !_rules[ruleself]() {
+						goto l125
+					}
+					goto l124
+				l125:
+					position, tokenIndex, depth = position124, tokenIndex124, depth124
 					{
 						switch buffer[position] {
 						case 'E', 'e':
 							if !_rules[ruleevent]() {
-								goto l72
+								goto l122
 							}
 							break
 						case 'F', 'f':
 							if !_rules[ruleform]() {
-								goto l72
+								goto l122
 							}
 							break
 						case 'G', 'g':
 							if !_rules[rulegoExpr]() {
-								goto l72
+								goto l122
 							}
 							break
 						case 'C', 'c':
 							if !_rules[ruleclass]() {
-								goto l72
+								goto l122
 							}
 							break
 						case 'S', 's':
 							if !_rules[rulestyle]() {
-								goto l72
+								goto l122
 							}
 							break
 						case 'P', 'p':
 							if !_rules[ruleprop]() {
-								goto l72
+								goto l122
 							}
 							break
 						default:
 							if !_rules[ruledataset]() {
-								goto l72
+								goto l122
 							}
 							break
 						}
 					}
 
 				}
-			l74:
+			l124:
 				depth--
-				add(rulebound, position73)
+				add(rulebound, position123)
 			}
 			return true
-		l72:
-			position, tokenIndex, depth = position72, tokenIndex72, depth72
+		l122:
+			position, tokenIndex, depth = position122, tokenIndex122, depth122
 			return false
 		},
-		/* 9 self <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('f' / 'F') isp* '(' isp* ')' Action4)> */
+		/* 12 self <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('f' / 'F') isp* '(' isp* ')' Action6)> */
 		func() bool {
-			position77, tokenIndex77, depth77 := position, tokenIndex, depth
+			position127, tokenIndex127, depth127 := position, tokenIndex, depth
 			{
-				position78 := position
+				position128 := position
 				depth++
 				{
-					position79, tokenIndex79, depth79 := position, tokenIndex, depth
+					position129, tokenIndex129, depth129 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l80
+						goto l130
 					}
 					position++
-					goto l79
-				l80:
-					position, tokenIndex, depth = position79, tokenIndex79, depth79
+					goto l129
+				l130:
+					position, tokenIndex, depth = position129, tokenIndex129, depth129
 					if buffer[position] != rune('S') {
-						goto l77
+						goto l127
 					}
 					position++
 				}
-			l79:
+			l129:
 				{
-					position81, tokenIndex81, depth81 := position, tokenIndex, depth
+					position131, tokenIndex131, depth131 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l82
+						goto l132
 					}
 					position++
-					goto l81
-				l82:
-					position, tokenIndex, depth = position81, tokenIndex81, depth81
+					goto l131
+				l132:
+					position, tokenIndex, depth = position131, tokenIndex131, depth131
 					if buffer[position] != rune('E') {
-						goto l77
+						goto l127
 					}
 					position++
 				}
-			l81:
+			l131:
 				{
-					position83, tokenIndex83, depth83 := position, tokenIndex, depth
+					position133, tokenIndex133, depth133 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l84
+						goto l134
 					}
 					position++
-					goto l83
-				l84:
-					position, tokenIndex, depth = position83, tokenIndex83, depth83
+					goto l133
+				l134:
+					position, tokenIndex, depth = position133, tokenIndex133, depth133
 					if buffer[position] != rune('L') {
-						goto l77
+						goto l127
 					}
 					position++
 				}
-			l83:
+			l133:
 				{
-					position85, tokenIndex85, depth85 := position, tokenIndex, depth
+					position135, tokenIndex135, depth135 := position, tokenIndex, depth
 					if buffer[position] != rune('f') {
-						goto l86
+						goto l136
 					}
 					position++
-					goto l85
-				l86:
-					position, tokenIndex, depth = position85, tokenIndex85, depth85
+					goto l135
+				l136:
+					position, tokenIndex, depth = position135, tokenIndex135, depth135
 					if buffer[position] != rune('F') {
-						goto l77
+						goto l127
 					}
 					position++
 				}
-			l85:
-			l87:
+			l135:
+			l137:
 				{
-					position88, tokenIndex88, depth88 := position, tokenIndex, depth
+					position138, tokenIndex138, depth138 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l88
+						goto l138
 					}
-					goto l87
-				l88:
-					position, tokenIndex, depth = position88, tokenIndex88, depth88
+					goto l137
+				l138:
+					position, tokenIndex, depth = position138, tokenIndex138, depth138
 				}
 				if buffer[position] != rune('(') {
-					goto l77
+					goto l127
 				}
 				position++
-			l89:
+			l139:
 				{
-					position90, tokenIndex90, depth90 := position, tokenIndex, depth
+					position140, tokenIndex140, depth140 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l90
+						goto l140
 					}
-					goto l89
-				l90:
-					position, tokenIndex, depth = position90, tokenIndex90, depth90
+					goto l139
+				l140:
+					position, tokenIndex, depth = position140, tokenIndex140, depth140
 				}
 				if buffer[position] != rune(')') {
-					goto l77
+					goto l127
 				}
 				position++
-				if !_rules[ruleAction4]() {
-					goto l77
+				if !_rules[ruleAction6]() {
+					goto l127
 				}
 				depth--
-				add(ruleself, position78)
+				add(ruleself, position128)
 			}
 			return true
-		l77:
-			position, tokenIndex, depth = position77, tokenIndex77, depth77
+		l127:
+			position, tokenIndex, depth = position127, tokenIndex127, depth127
 			return false
 		},
-		/* 10 dataset <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('a' / 'A') ('s' / 'S') ('e' / 'E') ('t' / 'T') isp* '(' isp* htmlid isp* ')' Action5)> */
+		/* 13 dataset <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('a' / 'A') ('s' / 'S') ('e' / 'E') ('t' / 'T') isp* '(' isp* htmlid isp* ')' Action7)> */
 		func() bool {
-			position91, tokenIndex91, depth91 := position, tokenIndex, depth
+			position141, tokenIndex141, depth141 := position, tokenIndex, depth
 			{
-				position92 := position
+				position142 := position
 				depth++
 				{
-					position93, tokenIndex93, depth93 := position, tokenIndex, depth
+					position143, tokenIndex143, depth143 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l94
+						goto l144
 					}
 					position++
-					goto l93
-				l94:
-					position, tokenIndex, depth = position93, tokenIndex93, depth93
+					goto l143
+				l144:
+					position, tokenIndex, depth = position143, tokenIndex143, depth143
 					if buffer[position] != rune('D') {
-						goto l91
+						goto l141
 					}
 					position++
 				}
-			l93:
+			l143:
 				{
-					position95, tokenIndex95, depth95 := position, tokenIndex, depth
+					position145, tokenIndex145, depth145 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l96
+						goto l146
 					}
 					position++
-					goto l95
-				l96:
-					position, tokenIndex, depth = position95, tokenIndex95, depth95
+					goto l145
+				l146:
+					position, tokenIndex, depth = position145, tokenIndex145, depth145
 					if buffer[position] != rune('A') {
-						goto l91
+						goto l141
 					}
 					position++
 				}
-			l95:
+			l145:
 				{
-					position97, tokenIndex97, depth97 := position, tokenIndex, depth
+					position147, tokenIndex147, depth147 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l98
+						goto l148
 					}
 					position++
-					goto l97
-				l98:
-					position, tokenIndex, depth = position97, tokenIndex97, depth97
+					goto l147
+				l148:
+					position, tokenIndex, depth = position147, tokenIndex147, depth147
 					if buffer[position] != rune('T') {
-						goto l91
+						goto l141
 					}
 					position++
 				}
-			l97:
+			l147:
 				{
-					position99, tokenIndex99, depth99 := position, tokenIndex, depth
+					position149, tokenIndex149, depth149 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l100
+						goto l150
 					}
 					position++
-					goto l99
-				l100:
-					position, tokenIndex, depth = position99, tokenIndex99, depth99
+					goto l149
+				l150:
+					position, tokenIndex, depth = position149, tokenIndex149, depth149
 					if buffer[position] != rune('A') {
-						goto l91
+						goto l141
 					}
 					position++
 				}
-			l99:
+			l149:
 				{
-					position101, tokenIndex101, depth101 := position, tokenIndex, depth
+					position151, tokenIndex151, depth151 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l102
+						goto l152
 					}
 					position++
-					goto l101
-				l102:
-					position, tokenIndex, depth = position101, tokenIndex101, depth101
+					goto l151
+				l152:
+					position, tokenIndex, depth = position151, tokenIndex151, depth151
 					if buffer[position] != rune('S') {
-						goto l91
+						goto l141
 					}
 					position++
 				}
-			l101:
+			l151:
 				{
-					position103, tokenIndex103, depth103 := position, tokenIndex, depth
+					position153, tokenIndex153, depth153 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l104
+						goto l154
 					}
 					position++
-					goto l103
-				l104:
-					position, tokenIndex, depth = position103, tokenIndex103, depth103
+					goto l153
+				l154:
+					position, tokenIndex, depth = position153, tokenIndex153, depth153
 					if buffer[position] != rune('E') {
-						goto l91
+						goto l141
 					}
 					position++
 				}
-			l103:
+			l153:
 				{
-					position105, tokenIndex105, depth105 := position, tokenIndex, depth
+					position155, tokenIndex155, depth155 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l106
+						goto l156
 					}
 					position++
-					goto l105
-				l106:
-					position, tokenIndex, depth = position105, tokenIndex105, depth105
+					goto l155
+				l156:
+					position, tokenIndex, depth = position155, tokenIndex155, depth155
 					if buffer[position] != rune('T') {
-						goto l91
+						goto l141
 					}
 					position++
 				}
-			l105:
-			l107:
+			l155:
+			l157:
 				{
-					position108, tokenIndex108, depth108 := position, tokenIndex, depth
+					position158, tokenIndex158, depth158 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l108
+						goto l158
 					}
-					goto l107
-				l108:
-					position, tokenIndex, depth = position108, tokenIndex108, depth108
+					goto l157
+				l158:
+					position, tokenIndex, depth = position158, tokenIndex158, depth158
 				}
 				if buffer[position] != rune('(') {
-					goto l91
+					goto l141
 				}
 				position++
-			l109:
+			l159:
 				{
-					position110, tokenIndex110, depth110 := position, tokenIndex, depth
+					position160, tokenIndex160, depth160 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l110
+						goto l160
 					}
-					goto l109
-				l110:
-					position, tokenIndex, depth = position110, tokenIndex110, depth110
+					goto l159
+				l160:
+					position, tokenIndex, depth = position160, tokenIndex160, depth160
 				}
 				if !_rules[rulehtmlid]() {
-					goto l91
+					goto l141
 				}
-			l111:
+			l161:
 				{
-					position112, tokenIndex112, depth112 := position, tokenIndex, depth
+					position162, tokenIndex162, depth162 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l112
+						goto l162
 					}
-					goto l111
-				l112:
-					position, tokenIndex, depth = position112, tokenIndex112, depth112
+					goto l161
+				l162:
+					position, tokenIndex, depth = position162, tokenIndex162, depth162
 				}
 				if buffer[position] != rune(')') {
-					goto l91
+					goto l141
 				}
 				position++
-				if !_rules[ruleAction5]() {
-					goto l91
+				if !_rules[ruleAction7]() {
+					goto l141
 				}
 				depth--
-				add(ruledataset, position92)
+				add(ruledataset, position142)
 			}
 			return true
-		l91:
-			position, tokenIndex, depth = position91, tokenIndex91, depth91
+		l141:
+			position, tokenIndex, depth = position141, tokenIndex141, depth141
 			return false
 		},
-		/* 11 prop <- <(('p' / 'P') ('r' / 'R') ('o' / 'O') ('p' / 'P') isp* '(' isp* htmlid isp* ')' Action6)> */
+		/* 14 prop <- <(('p' / 'P') ('r' / 'R') ('o' / 'O') ('p' / 'P') isp* '(' isp* htmlid isp* ')' Action8)> */
 		func() bool {
-			position113, tokenIndex113, depth113 := position, tokenIndex, depth
+			position163, tokenIndex163, depth163 := position, tokenIndex, depth
 			{
-				position114 := position
+				position164 := position
 				depth++
 				{
-					position115, tokenIndex115, depth115 := position, tokenIndex, depth
+					position165, tokenIndex165, depth165 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l116
+						goto l166
 					}
 					position++
-					goto l115
-				l116:
-					position, tokenIndex, depth = position115, tokenIndex115, depth115
+					goto l165
+				l166:
+					position, tokenIndex, depth = position165, tokenIndex165, depth165
 					if buffer[position] != rune('P') {
-						goto l113
+						goto l163
 					}
 					position++
 				}
-			l115:
+			l165:
 				{
-					position117, tokenIndex117, depth117 := position, tokenIndex, depth
+					position167, tokenIndex167, depth167 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l118
+						goto l168
 					}
 					position++
-					goto l117
-				l118:
-					position, tokenIndex, depth = position117, tokenIndex117, depth117
+					goto l167
+				l168:
+					position, tokenIndex, depth = position167, tokenIndex167, depth167
 					if buffer[position] != rune('R') {
-						goto l113
+						goto l163
 					}
 					position++
 				}
-			l117:
+			l167:
 				{
-					position119, tokenIndex119, depth119 := position, tokenIndex, depth
+					position169, tokenIndex169, depth169 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l120
+						goto l170
 					}
 					position++
-					goto l119
-				l120:
-					position, tokenIndex, depth = position119, tokenIndex119, depth119
+					goto l169
+				l170:
+					position, tokenIndex, depth = position169, tokenIndex169, depth169
 					if buffer[position] != rune('O') {
-						goto l113
+						goto l163
 					}
 					position++
 				}
-			l119:
+			l169:
 				{
-					position121, tokenIndex121, depth121 := position, tokenIndex, depth
+					position171, tokenIndex171, depth171 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l122
+						goto l172
 					}
 					position++
-					goto l121
-				l122:
-					position, tokenIndex, depth = position121, tokenIndex121, depth121
+					goto l171
+				l172:
+					position, tokenIndex, depth = position171, tokenIndex171, depth171
 					if buffer[position] != rune('P') {
-						goto l113
+						goto l163
 					}
 					position++
 				}
-			l121:
-			l123:
+			l171:
+			l173:
 				{
-					position124, tokenIndex124, depth124 := position, tokenIndex, depth
+					position174, tokenIndex174, depth174 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l124
+						goto l174
 					}
-					goto l123
-				l124:
-					position, tokenIndex, depth = position124, tokenIndex124, depth124
+					goto l173
+				l174:
+					position, tokenIndex, depth = position174, tokenIndex174, depth174
 				}
 				if buffer[position] != rune('(') {
-					goto l113
+					goto l163
 				}
 				position++
-			l125:
+			l175:
 				{
-					position126, tokenIndex126, depth126 := position, tokenIndex, depth
+					position176, tokenIndex176, depth176 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l126
+						goto l176
 					}
-					goto l125
-				l126:
-					position, tokenIndex, depth = position126, tokenIndex126, depth126
+					goto l175
+				l176:
+					position, tokenIndex, depth = position176, tokenIndex176, depth176
 				}
 				if !_rules[rulehtmlid]() {
-					goto l113
+					goto l163
 				}
-			l127:
+			l177:
 				{
-					position128, tokenIndex128, depth128 := position, tokenIndex, depth
+					position178, tokenIndex178, depth178 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l128
+						goto l178
 					}
-					goto l127
-				l128:
-					position, tokenIndex, depth = position128, tokenIndex128, depth128
+					goto l177
+				l178:
+					position, tokenIndex, depth = position178, tokenIndex178, depth178
 				}
 				if buffer[position] != rune(')') {
-					goto l113
+					goto l163
 				}
 				position++
-				if !_rules[ruleAction6]() {
-					goto l113
+				if !_rules[ruleAction8]() {
+					goto l163
 				}
 				depth--
-				add(ruleprop, position114)
+				add(ruleprop, position164)
 			}
 			return true
-		l113:
-			position, tokenIndex, depth = position113, tokenIndex113, depth113
+		l163:
+			position, tokenIndex, depth = position163, tokenIndex163, depth163
 			return false
 		},
-		/* 12 style <- <(('s' / 'S') ('t' / 'T') ('y' / 'Y') ('l' / 'L') ('e' / 'E') isp* '(' isp* htmlid isp* ')' Action7)> */
+		/* 15 style <- <(('s' / 'S') ('t' / 'T') ('y' / 'Y') ('l' / 'L') ('e' / 'E') isp* '(' isp* htmlid isp* ')' Action9)> */
 		func() bool {
-			position129, tokenIndex129, depth129 := position, tokenIndex, depth
+			position179, tokenIndex179, depth179 := position, tokenIndex, depth
 			{
-				position130 := position
+				position180 := position
 				depth++
 				{
-					position131, tokenIndex131, depth131 := position, tokenIndex, depth
+					position181, tokenIndex181, depth181 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l132
+						goto l182
 					}
 					position++
-					goto l131
-				l132:
-					position, tokenIndex, depth = position131, tokenIndex131, depth131
+					goto l181
+				l182:
+					position, tokenIndex, depth = position181, tokenIndex181, depth181
 					if buffer[position] != rune('S') {
-						goto l129
+						goto l179
 					}
 					position++
 				}
-			l131:
+			l181:
 				{
-					position133, tokenIndex133, depth133 := position, tokenIndex, depth
+					position183, tokenIndex183, depth183 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l134
+						goto l184
 					}
 					position++
-					goto l133
-				l134:
-					position, tokenIndex, depth = position133, tokenIndex133, depth133
+					goto l183
+				l184:
+					position, tokenIndex, depth = position183, tokenIndex183, depth183
 					if buffer[position] != rune('T') {
-						goto l129
+						goto l179
 					}
 					position++
 				}
-			l133:
+			l183:
 				{
-					position135, tokenIndex135, depth135 := position, tokenIndex, depth
+					position185, tokenIndex185, depth185 := position, tokenIndex, depth
 					if buffer[position] != rune('y') {
-						goto l136
+						goto l186
 					}
 					position++
-					goto l135
-				l136:
-					position, tokenIndex, depth = position135, tokenIndex135, depth135
+					goto l185
+				l186:
+					position, tokenIndex, depth = position185, tokenIndex185, depth185
 					if buffer[position] != rune('Y') {
-						goto l129
+						goto l179
 					}
 					position++
 				}
-			l135:
+			l185:
 				{
-					position137, tokenIndex137, depth137 := position, tokenIndex, depth
+					position187, tokenIndex187, depth187 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l138
+						goto l188
 					}
-					position++
-					goto l137
-				l138:
-					position, tokenIndex, depth = position137, tokenIndex137, depth137
+					position++
+					goto l187
+				l188:
+					position, tokenIndex, depth = position187, tokenIndex187, depth187
 					if buffer[position] != rune('L') {
-						goto l129
+						goto l179
 					}
 					position++
 				}
-			l137:
+			l187:
 				{
-					position139, tokenIndex139, depth139 := position, tokenIndex, depth
+					position189, tokenIndex189, depth189 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l140
+						goto l190
 					}
 					position++
-					goto l139
-				l140:
-					position, tokenIndex, depth = position139, tokenIndex139, depth139
+					goto l189
+				l190:
+					position, tokenIndex, depth = position189, tokenIndex189, depth189
 					if buffer[position] != rune('E') {
-						goto l129
+						goto l179
 					}
 					position++
 				}
-			l139:
-			l141:
+			l189:
+			l191:
 				{
-					position142, tokenIndex142, depth142 := position, tokenIndex, depth
+					position192, tokenIndex192, depth192 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l142
+						goto l192
 					}
-					goto l141
-				l142:
-					position, tokenIndex, depth = position142, tokenIndex142, depth142
+					goto l191
+				l192:
+					position, tokenIndex, depth = position192, tokenIndex192, depth192
 				}
 				if buffer[position] != rune('(') {
-					goto l129
+					goto l179
 				}
 				position++
-			l143:
+			l193:
 				{
-					position144, tokenIndex144, depth144 := position, tokenIndex, depth
+					position194, tokenIndex194, depth194 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l144
+						goto l194
 					}
-					goto l143
-				l144:
-					position, tokenIndex, depth = position144, tokenIndex144, depth144
+					goto l193
+				l194:
+					position, tokenIndex, depth = position194, tokenIndex194, depth194
 				}
 				if !_rules[rulehtmlid]() {
-					goto l129
+					goto l179
 				}
-			l145:
+			l195:
 				{
-					position146, tokenIndex146, depth146 := position, tokenIndex, depth
+					position196, tokenIndex196, depth196 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l146
+						goto l196
 					}
-					goto l145
-				l146:
-					position, tokenIndex, depth = position146, tokenIndex146, depth146
+					goto l195
+				l196:
+					position, tokenIndex, depth = position196, tokenIndex196, depth196
 				}
 				if buffer[position] != rune(')') {
-					goto l129
+					goto l179
 				}
 				position++
-				if !_rules[ruleAction7]() {
-					goto l129
+				if !_rules[ruleAction9]() {
+					goto l179
 				}
 				depth--
-				add(rulestyle, position130)
+				add(rulestyle, position180)
 			}
 			return true
-		l129:
-			position, tokenIndex, depth = position129, tokenIndex129, depth129
+		l179:
+			position, tokenIndex, depth = position179, tokenIndex179, depth179
 			return false
 		},
-		/* 13 class <- <(('c' / 'C') ('l' / 'L') ('a' / 'A') ('s' / 'S') ('s' / 'S') isp* '(' isp* htmlid isp* (',' isp* htmlid isp*)* ')' Action8)> */
+		/* 16 class <- <(('c' / 'C') ('l' / 'L') ('a' / 'A') ('s' / 'S') ('s' / 'S') isp* '(' isp* htmlid isp* (',' isp* htmlid isp*)* ')' Action10)> */
 		func() bool {
-			position147, tokenIndex147, depth147 := position, tokenIndex, depth
+			position197, tokenIndex197, depth197 := position, tokenIndex, depth
 			{
-				position148 := position
+				position198 := position
 				depth++
 				{
-					position149, tokenIndex149, depth149 := position, tokenIndex, depth
+					position199, tokenIndex199, depth199 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l150
+						goto l200
 					}
 					position++
-					goto l149
-				l150:
-					position, tokenIndex, depth = position149, tokenIndex149, depth149
+					goto l199
+				l200:
+					position, tokenIndex, depth = position199, tokenIndex199, depth199
 					if buffer[position] != rune('C') {
-						goto l147
+						goto l197
 					}
 					position++
 				}
-			l149:
+			l199:
 				{
-					position151, tokenIndex151, depth151 := position, tokenIndex, depth
+					position201, tokenIndex201, depth201 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l152
+						goto l202
 					}
 					position++
-					goto l151
-				l152:
-					position, tokenIndex, depth = position151, tokenIndex151, depth151
+					goto l201
+				l202:
+					position, tokenIndex, depth = position201, tokenIndex201, depth201
 					if buffer[position] != rune('L') {
-						goto l147
+						goto l197
 					}
 					position++
 				}
-			l151:
+			l201:
 				{
-					position153, tokenIndex153, depth153 := position, tokenIndex, depth
+					position203, tokenIndex203, depth203 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l154
+						goto l204
 					}
 					position++
-					goto l153
-				l154:
-					position, tokenIndex, depth = position153, tokenIndex153, depth153
+					goto l203
+				l204:
+					position, tokenIndex, depth = position203, tokenIndex203, depth203
 					if buffer[position] != rune('A') {
-						goto l147
+						goto l197
 					}
 					position++
 				}
-			l153:
+			l203:
 				{
-					position155, tokenIndex155, depth155 := position, tokenIndex, depth
+					position205, tokenIndex205, depth205 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l156
+						goto l206
 					}
 					position++
-					goto l155
-				l156:
-					position, tokenIndex, depth = position155, tokenIndex155, depth155
+					goto l205
+				l206:
+					position, tokenIndex, depth = position205, tokenIndex205, depth205
 					if buffer[position] != rune('S') {
-						goto l147
+						goto l197
 					}
 					position++
 				}
-			l155:
+			l205:
 				{
-					position157, tokenIndex157, depth157 := position, tokenIndex, depth
+					position207, tokenIndex207, depth207 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l158
+						goto l208
 					}
 					position++
-					goto l157
-				l158:
-					position, tokenIndex, depth = position157, tokenIndex157, depth157
+					goto l207
+				l208:
+					position, tokenIndex, depth = position207, tokenIndex207, depth207
 					if buffer[position] != rune('S') {
-						goto l147
+						goto l197
 					}
 					position++
 				}
-			l157:
-			l159:
+			l207:
+			l209:
 				{
-					position160, tokenIndex160, depth160 := position, tokenIndex, depth
+					position210, tokenIndex210, depth210 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l160
+						goto l210
 					}
-					goto l159
-				l160:
-					position, tokenIndex, depth = position160, tokenIndex160, depth160
+					goto l209
+				l210:
+					position, tokenIndex, depth = position210, tokenIndex210, depth210
 				}
 				if buffer[position] != rune('(') {
-					goto l147
+					goto l197
 				}
 				position++
-			l161:
+			l211:
 				{
-					position162, tokenIndex162, depth162 := position, tokenIndex, depth
+					position212, tokenIndex212, depth212 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l162
+						goto l212
 					}
-					goto l161
-				l162:
-					position, tokenIndex, depth = position162, tokenIndex162, depth162
+					goto l211
+				l212:
+					position, tokenIndex, depth = position212, tokenIndex212, depth212
 				}
 				if !_rules[rulehtmlid]() {
-					goto l147
+					goto l197
 				}
-			l163:
+			l213:
 				{
-					position164, tokenIndex164, depth164 := position, tokenIndex, depth
+					position214, tokenIndex214, depth214 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l164
+						goto l214
 					}
-					goto l163
-				l164:
-					position, tokenIndex, depth = position164, tokenIndex164, depth164
+					goto l213
+				l214:
+					position, tokenIndex, depth = position214, tokenIndex214, depth214
 				}
-			l165:
+			l215:
 				{
-					position166, tokenIndex166, depth166 := position, tokenIndex, depth
+					position216, tokenIndex216, depth216 := position, tokenIndex, depth
 					if buffer[position] != rune(',') {
-						goto l166
+						goto l216
 					}
 					position++
-				l167:
+				l217:
 					{
-						position168, tokenIndex168, depth168 := position, tokenIndex, depth
+						position218, tokenIndex218, depth218 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l168
+							goto l218
 						}
-						goto l167
-					l168:
-						position, tokenIndex, depth = position168, tokenIndex168, depth168
+						goto l217
+					l218:
+						position, tokenIndex, depth = position218, tokenIndex218, depth218
 					}
 					if !_rules[rulehtmlid]() {
-						goto l166
+						goto l216
 					}
-				l169:
+				l219:
 					{
-						position170, tokenIndex170, depth170 := position, tokenIndex, depth
+						position220, tokenIndex220, depth220 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l170
+							goto l220
 						}
-						goto l169
-					l170:
-						position, tokenIndex, depth = position170, tokenIndex170, depth170
+						goto l219
+					l220:
+						position, tokenIndex, depth = position220, tokenIndex220, depth220
 					}
-					goto l165
-				l166:
-					position, tokenIndex, depth = position166, tokenIndex166, depth166
+					goto l215
+				l216:
+					position, tokenIndex, depth = position216, tokenIndex216, depth216
 				}
 				if buffer[position] != rune(')') {
-					goto l147
+					goto l197
 				}
 				position++
-				if !_rules[ruleAction8]() {
-					goto l147
+				if !_rules[ruleAction10]() {
+					goto l197
 				}
 				depth--
-				add(ruleclass, position148)
+				add(ruleclass, position198)
 			}
 			return true
-		l147:
-			position, tokenIndex, depth = position147, tokenIndex147, depth147
+		l197:
+			position, tokenIndex, depth = position197, tokenIndex197, depth197
 			return false
 		},
-		/* 14 form <- <(('f' / 'F') ('o' / 'O') ('r' / 'R') ('m' / 'M') isp* '(' isp* htmlid isp* ')' Action9)> */
+		/* 17 form <- <(('f' / 'F') ('o' / 'O') ('r' / 'R') ('m' / 'M') isp* '(' isp* htmlid isp* ')' Action11)> */
 		func() bool {
-			position171, tokenIndex171, depth171 := position, tokenIndex, depth
+			position221, tokenIndex221, depth221 := position, tokenIndex, depth
 			{
-				position172 := position
+				position222 := position
 				depth++
 				{
-					position173, tokenIndex173, depth173 := position, tokenIndex, depth
+					position223, tokenIndex223, depth223 := position, tokenIndex, depth
 					if buffer[position] != rune('f') {
-						goto l174
+						goto l224
 					}
 					position++
-					goto l173
-				l174:
-					position, tokenIndex, depth = position173, tokenIndex173, depth173
+					goto l223
+				l224:
+					position, tokenIndex, depth = position223, tokenIndex223, depth223
 					if buffer[position] != rune('F') {
-						goto l171
+						goto l221
 					}
 					position++
 				}
-			l173:
+			l223:
 				{
-					position175, tokenIndex175, depth175 := position, tokenIndex, depth
+					position225, tokenIndex225, depth225 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l176
+						goto l226
 					}
 					position++
-					goto l175
-				l176:
-					position, tokenIndex, depth = position175, tokenIndex175, depth175
+					goto l225
+				l226:
+					position, tokenIndex, depth = position225, tokenIndex225, depth225
 					if buffer[position] != rune('O') {
-						goto l171
+						goto l221
 					}
 					position++
 				}
-			l175:
+			l225:
 				{
-					position177, tokenIndex177, depth177 := position, tokenIndex, depth
+					position227, tokenIndex227, depth227 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l178
+						goto l228
 					}
 					position++
-					goto l177
-				l178:
-					position, tokenIndex, depth = position177, tokenIndex177, depth177
+					goto l227
+				l228:
+					position, tokenIndex, depth = position227, tokenIndex227, depth227
 					if buffer[position] != rune('R') {
-						goto l171
+						goto l221
 					}
 					position++
 				}
-			l177:
+			l227:
 				{
-					position179, tokenIndex179, depth179 := position, tokenIndex, depth
+					position229, tokenIndex229, depth229 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l180
+						goto l230
 					}
 					position++
-					goto l179
-				l180:
-					position, tokenIndex, depth = position179, tokenIndex179, depth179
+					goto l229
+				l230:
+					position, tokenIndex, depth = position229, tokenIndex229, depth229
 					if buffer[position] != rune('M') {
-						goto l171
+						goto l221
 					}
 					position++
 				}
-			l179:
-			l181:
+			l229:
+			l231:
 				{
-					position182, tokenIndex182, depth182 := position, tokenIndex, depth
+					position232, tokenIndex232, depth232 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l182
+						goto l232
 					}
-					goto l181
-				l182:
-					position, tokenIndex, depth = position182, tokenIndex182, depth182
+					goto l231
+				l232:
+					position, tokenIndex, depth = position232, tokenIndex232, depth232
 				}
 				if buffer[position] != rune('(') {
-					goto l171
+					goto l221
 				}
 				position++
-			l183:
+			l233:
 				{
-					position184, tokenIndex184, depth184 := position, tokenIndex, depth
+					position234, tokenIndex234, depth234 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l184
+						goto l234
 					}
-					goto l183
-				l184:
-					position, tokenIndex, depth = position184, tokenIndex184, depth184
+					goto l233
+				l234:
+					position, tokenIndex, depth = position234, tokenIndex234, depth234
 				}
 				if !_rules[rulehtmlid]() {
-					goto l171
+					goto l221
 				}
-			l185:
+			l235:
 				{
-					position186, tokenIndex186, depth186 := position, tokenIndex, depth
+					position236, tokenIndex236, depth236 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l186
+						goto l236
 					}
-					goto l185
-				l186:
-					position, tokenIndex, depth = position186, tokenIndex186, depth186
+					goto l235
+				l236:
+					position, tokenIndex, depth = position236, tokenIndex236, depth236
 				}
 				if buffer[position] != rune(')') {
-					goto l171
+					goto l221
 				}
 				position++
-				if !_rules[ruleAction9]() {
-					goto l171
+				if !_rules[ruleAction11]() {
+					goto l221
 				}
 				depth--
-				add(ruleform, position172)
+				add(ruleform, position222)
 			}
 			return true
-		l171:
-			position, tokenIndex, depth = position171, tokenIndex171, depth171
+		l221:
+			position, tokenIndex, depth = position221, tokenIndex221, depth221
 			return false
 		},
-		/* 15 goExpr <- <(('g' / 'G') ('o' / 'O') isp* '(' isp* expr isp* ')' Action10)> */
+		/* 18 goExpr <- <(('g' / 'G') ('o' / 'O') isp* '(' isp* expr isp* ')' Action12)> */
 		func() bool {
-			position187, tokenIndex187, depth187 := position, tokenIndex, depth
+			position237, tokenIndex237, depth237 := position, tokenIndex, depth
 			{
-				position188 := position
+				position238 := position
 				depth++
 				{
-					position189, tokenIndex189, depth189 := position, tokenIndex, depth
+					position239, tokenIndex239, depth239 := position, tokenIndex, depth
 					if buffer[position] != rune('g') {
-						goto l190
+						goto l240
 					}
 					position++
-					goto l189
-				l190:
-					position, tokenIndex, depth = position189, tokenIndex189, depth189
+					goto l239
+				l240:
+					position, tokenIndex, depth = position239, tokenIndex239, depth239
 					if buffer[position] != rune('G') {
-						goto l187
+						goto l237
 					}
 					position++
 				}
-			l189:
+			l239:
 				{
-					position191, tokenIndex191, depth191 := position, tokenIndex, depth
+					position241, tokenIndex241, depth241 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l192
+						goto l242
 					}
 					position++
-					goto l191
-				l192:
-					position, tokenIndex, depth = position191, tokenIndex191, depth191
+					goto l241
+				l242:
+					position, tokenIndex, depth = position241, tokenIndex241, depth241
 					if buffer[position] != rune('O') {
-						goto l187
+						goto l237
 					}
 					position++
 				}
-			l191:
-			l193:
+			l241:
+			l243:
 				{
-					position194, tokenIndex194, depth194 := position, tokenIndex, depth
+					position244, tokenIndex244, depth244 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l194
+						goto l244
 					}
-					goto l193
-				l194:
-					position, tokenIndex, depth = position194, tokenIndex194, depth194
+					goto l243
+				l244:
+					position, tokenIndex, depth = position244, tokenIndex244, depth244
 				}
 				if buffer[position] != rune('(') {
-					goto l187
+					goto l237
 				}
 				position++
-			l195:
+			l245:
 				{
-					position196, tokenIndex196, depth196 := position, tokenIndex, depth
+					position246, tokenIndex246, depth246 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l196
+						goto l246
 					}
-					goto l195
-				l196:
-					position, tokenIndex, depth = position196, tokenIndex196, depth196
+					goto l245
+				l246:
+					position, tokenIndex, depth = position246, tokenIndex246, depth246
 				}
 				if !_rules[ruleexpr]() {
-					goto l187
+					goto l237
 				}
-			l197:
+			l247:
 				{
-					position198, tokenIndex198, depth198 := position, tokenIndex, depth
+					position248, tokenIndex248, depth248 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l198
+						goto l248
 					}
-					goto l197
-				l198:
-					position, tokenIndex, depth = position198, tokenIndex198, depth198
+					goto l247
+				l248:
+					position, tokenIndex, depth = position248, tokenIndex248, depth248
 				}
 				if buffer[position] != rune(')') {
-					goto l187
+					goto l237
 				}
 				position++
-				if !_rules[ruleAction10]() {
-					goto l187
+				if !_rules[ruleAction12]() {
+					goto l237
 				}
 				depth--
-				add(rulegoExpr, position188)
+				add(rulegoExpr, position238)
 			}
 			return true
-		l187:
-			position, tokenIndex, depth = position187, tokenIndex187, depth187
+		l237:
+			position, tokenIndex, depth = position237, tokenIndex237, depth237
 			return false
 		},
-		/* 16 event <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('n' / 'N') ('t' / 'T') isp* '(' isp* jsid? isp* ')' Action11)> */
+		/* 19 event <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('n' / 'N') ('t' / 'T') isp* '(' isp* jsid? isp* ')' Action13)> */
 		func() bool {
-			position199, tokenIndex199, depth199 := position, tokenIndex, depth
+			position249, tokenIndex249, depth249 := position, tokenIndex, depth
 			{
-				position200 := position
+				position250 := position
 				depth++
 				{
-					position201, tokenIndex201, depth201 := position, tokenIndex, depth
+					position251, tokenIndex251, depth251 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l202
+						goto l252
 					}
 					position++
-					goto l201
-				l202:
-					position, tokenIndex, depth = position201, tokenIndex201, depth201
+					goto l251
+				l252:
+					position, tokenIndex, depth = position251, tokenIndex251, depth251
 					if buffer[position] != rune('E') {
-						goto l199
+						goto l249
 					}
 					position++
 				}
-			l201:
+			l251:
 				{
-					position203, tokenIndex203, depth203 := position, tokenIndex, depth
+					position253, tokenIndex253, depth253 := position, tokenIndex, depth
 					if buffer[position] != rune('v') {
-						goto l204
+						goto l254
 					}
 					position++
-					goto l203
-				l204:
-					position, tokenIndex, depth = position203, tokenIndex203, depth203
+					goto l253
+				l254:
+					position, tokenIndex, depth = position253, tokenIndex253, depth253
 					if buffer[position] != rune('V') {
-						goto l199
+						goto l249
 					}
 					position++
 				}
-			l203:
+			l253:
 				{
-					position205, tokenIndex205, depth205 := position, tokenIndex, depth
+					position255, tokenIndex255, depth255 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l206
+						goto l256
 					}
 					position++
-					goto l205
-				l206:
-					position, tokenIndex, depth = position205, tokenIndex205, depth205
+					goto l255
+				l256:
+					position, tokenIndex, depth = position255, tokenIndex255, depth255
 					if buffer[position] != rune('E') {
-						goto l199
+						goto l249
 					}
 					position++
 				}
-			l205:
+			l255:
 				{
-					position207, tokenIndex207, depth207 := position, tokenIndex, depth
+					position257, tokenIndex257, depth257 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l208
+						goto l258
 					}
 					position++
-					goto l207
-				l208:
-					position, tokenIndex, depth = position207, tokenIndex207, depth207
+					goto l257
+				l258:
+					position, tokenIndex, depth = position257, tokenIndex257, depth257
 					if buffer[position] != rune('N') {
-						goto l199
+						goto l249
 					}
 					position++
 				}
-			l207:
+			l257:
 				{
-					position209, tokenIndex209, depth209 := position, tokenIndex, depth
+					position259, tokenIndex259, depth259 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l210
+						goto l260
 					}
 					position++
-					goto l209
-				l210:
-					position, tokenIndex, depth = position209, tokenIndex209, depth209
+					goto l259
+				l260:
+					position, tokenIndex, depth = position259, tokenIndex259, depth259
 					if buffer[position] != rune('T') {
-						goto l199
+						goto l249
 					}
 					position++
 				}
-			l209:
-			l211:
+			l259:
+			l261:
 				{
-					position212, tokenIndex212, depth212 := position, tokenIndex, depth
+					position262, tokenIndex262, depth262 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l212
-					}
-					goto l211
-				l212:
-					position, tokenIndex, depth = position212, tokenIndex212, depth212
+						goto l262
+					}
+					goto l261
+				l262:
+					position, tokenIndex, depth = position262, tokenIndex262, depth262
 				}
 				if buffer[position] != rune('(') {
-					goto l199
+					goto l249
 				}
 				position++
-			l213:
+			l263:
 				{
-					position214, tokenIndex214, depth214 := position, tokenIndex, depth
+					position264, tokenIndex264, depth264 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l214
+						goto l264
 					}
-					goto l213
-				l214:
-					position, tokenIndex, depth = position214, tokenIndex214, depth214
+					goto l263
+				l264:
+					position, tokenIndex, depth = position264, tokenIndex264, depth264
 				}
 				{
-					position215, tokenIndex215, depth215 := position, tokenIndex, depth
+					position265, tokenIndex265, depth265 := position, tokenIndex, depth
 					if !_rules[rulejsid]() {
-						goto l215
+						goto l265
 					}
-					goto l216
-				l215:
-					position, tokenIndex, depth = position215, tokenIndex215, depth215
+					goto l266
+				l265:
+					position, tokenIndex, depth = position265, tokenIndex265, depth265
 				}
-			l216:
-			l217:
+			l266:
+			l267:
 				{
-					position218, tokenIndex218, depth218 := position, tokenIndex, depth
+					position268, tokenIndex268, depth268 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l218
+						goto l268
 					}
-					goto l217
-				l218:
-					position, tokenIndex, depth = position218, tokenIndex218, depth218
+					goto l267
+				l268:
+					position, tokenIndex, depth = position268, tokenIndex268, depth268
 				}
 				if buffer[position] != rune(')') {
-					goto l199
+					goto l249
 				}
 				position++
-				if !_rules[ruleAction11]() {
-					goto l199
+				if !_rules[ruleAction13]() {
+					goto l249
 				}
 				depth--
-				add(ruleevent, position200)
+				add(ruleevent, position250)
 			}
 			return true
-		l199:
-			position, tokenIndex, depth = position199, tokenIndex199, depth199
+		l249:
+			position, tokenIndex, depth = position249, tokenIndex249, depth249
 			return false
 		},
-		/* 17 htmlid <- <(<((&('-') '-') | (&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> Action12)> */
+		/* 20 htmlid <- <(<((&('-') '-') | (&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> Action14)> */
 		func() bool {
-			position219, tokenIndex219, depth219 := position, tokenIndex, depth
+			position269, tokenIndex269, depth269 := position, tokenIndex, depth
 			{
-				position220 := position
+				position270 := position
 				depth++
 				{
-					position221 := position
+					position271 := position
 					depth++
 					{
 						switch buffer[position] {
 						case '-':
 							if buffer[position] != rune('-') {
-								goto l219
+								goto l269
 							}
 							position++
 							break
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l219
+								goto l269
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l219
+								goto l269
 							}
 							position++
 							break
 						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l219
+								goto l269
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l219
+								goto l269
 							}
 							position++
 							break
 						}
 					}
 
-				l222:
+				l272:
 					{
-						position223, tokenIndex223, depth223 := position, tokenIndex, depth
+						position273, tokenIndex273, depth273 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
 							case '-':
 								if buffer[position] != rune('-') {
-									goto l223
+									goto l273
 								}
 								position++
 								break
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l223
+									goto l273
 								}
 								position++
 								break
 							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l223
+									goto l273
 								}
 								position++
 								break
 							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l223
+									goto l273
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l223
+									goto l273
 								}
 								position++
 								break
 							}
 						}
 
-						goto l222
-					l223:
-						position, tokenIndex, depth = position223, tokenIndex223, depth223
+						goto l272
+					l273:
+						position, tokenIndex, depth = position273, tokenIndex273, depth273
 					}
 					depth--
-					add(rulePegText, position221)
+					add(rulePegText, position271)
 				}
-				if !_rules[ruleAction12]() {
-					goto l219
+				if !_rules[ruleAction14]() {
+					goto l269
 				}
 				depth--
-				add(rulehtmlid, position220)
+				add(rulehtmlid, position270)
 			}
 			return true
-		l219:
-			position, tokenIndex, depth = position219, tokenIndex219, depth219
+		l269:
+			position, tokenIndex, depth = position269, tokenIndex269, depth269
 			return false
 		},
-		/* 18 jsid <- <(<(((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])) ((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))*)> Action13)> */
+		/* 21 jsid <- <(<(((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])) ((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))*)> Action15)> */
 		func() bool {
-			position226, tokenIndex226, depth226 := position, tokenIndex, depth
+			position276, tokenIndex276, depth276 := position, tokenIndex, depth
 			{
-				position227 := position
+				position277 := position
 				depth++
 				{
-					position228 := position
+					position278 := position
 					depth++
 					{
 						switch buffer[position] {
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l226
+								goto l276
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l226
+								goto l276
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l226
+								goto l276
 							}
 							position++
 							break
 						}
 					}
 
-				l230:
+				l280:
 					{
-						position231, tokenIndex231, depth231 := position, tokenIndex, depth
+						position281, tokenIndex281, depth281 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l231
+									goto l281
 								}
 								position++
 								break
 							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l231
+									goto l281
 								}
 								position++
 								break
 							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l231
+									goto l281
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l231
+									goto l281
 								}
 								position++
 								break
 							}
 						}
 
-						goto l230
-					l231:
-						position, tokenIndex, depth = position231, tokenIndex231, depth231
+						goto l280
+					l281:
+						position, tokenIndex, depth = position281, tokenIndex281, depth281
 					}
 					depth--
-					add(rulePegText, position228)
+					add(rulePegText, position278)
 				}
-				if !_rules[ruleAction13]() {
-					goto l226
+				if !_rules[ruleAction15]() {
+					goto l276
 				}
 				depth--
-				add(rulejsid, position227)
+				add(rulejsid, position277)
 			}
 			return true
-		l226:
-			position, tokenIndex, depth = position226, tokenIndex226, depth226
+		l276:
+			position, tokenIndex, depth = position276, tokenIndex276, depth276
 			return false
 		},
-		/* 19 expr <- <(<((&('\t' | ' ') isp+) | (&('(' | '[' | '{') enclosed) | (&('!' | '"' | '&' | '*' | '+' | '-' | '.' | '/' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | ':' | '<' | '=' | '>' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '^' | '_' | '`' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | '|') commaless))+> Action14)> */
+		/* 22 expr <- <(<((&('\t' | ' ') isp+) | (&('(' | '[' | '{') enclosed) | (&('!' | '"' | '&' | '*' | '+' | '-' | '.' | '/' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | ':' | '<' | '=' | '>' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '^' | '_' | '`' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | '|') commaless))+> Action16)> */
 		func() bool {
-			position233, tokenIndex233, depth233 := position, tokenIndex, depth
+			position283, tokenIndex283, depth283 := position, tokenIndex, depth
 			{
-				position234 := position
+				position284 := position
 				depth++
 				{
-					position235 := position
+					position285 := position
 					depth++
 					{
 						switch buffer[position] {
 						case '\t', ' ':
 							if !_rules[ruleisp]() {
-								goto l233
+								goto l283
 							}
-						l239:
+						l289:
 							{
-								position240, tokenIndex240, depth240 := position, tokenIndex, depth
+								position290, tokenIndex290, depth290 := position, tokenIndex, depth
 								if !_rules[ruleisp]() {
-									goto l240
+									goto l290
 								}
-								goto l239
-							l240:
-								position, tokenIndex, depth = position240, tokenIndex240, depth240
+								goto l289
+							l290:
+								position, tokenIndex, depth = position290, tokenIndex290, depth290
 							}
 							break
 						case '(', '[', '{':
 							if !_rules[ruleenclosed]() {
-								goto l233
+								goto l283
 							}
 							break
 						default:
 							if !_rules[rulecommaless]() {
-								goto l233
+								goto l283
 							}
 							break
 						}
 					}
 
-				l236:
+				l286:
 					{
-						position237, tokenIndex237, depth237 := position, tokenIndex, depth
+						position287, tokenIndex287, depth287 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
 							case '\t', ' ':
 								if !_rules[ruleisp]() {
-									goto l237
+									goto l287
 								}
-							l242:
+							l292:
 								{
-									position243, tokenIndex243, depth243 := position, tokenIndex, depth
+									position293, tokenIndex293, depth293 := position, tokenIndex, depth
 									if !_rules[ruleisp]() {
-										goto l243
+										goto l293
 									}
-									goto l242
-								l243:
-									position, tokenIndex, depth = position243, tokenIndex243, depth243
+									goto l292
+								l293:
+									position, tokenIndex, depth = position293, tokenIndex293, depth293
 								}
 								break
 							case '(', '[', '{':
 								if !_rules[ruleenclosed]() {
-									goto l237
+									goto l287
 								}
 								break
 							default:
 								if !_rules[rulecommaless]() {
-									goto l237
+									goto l287
 								}
 								break
 							}
 						}
 
-						goto l236
-					l237:
-						position, tokenIndex, depth = position237, tokenIndex237, depth237
+						goto l286
+					l287:
+						position, tokenIndex, depth = position287, tokenIndex287, depth287
 					}
 					depth--
-					add(rulePegText, position235)
+					add(rulePegText, position285)
 				}
-				if !_rules[ruleAction14]() {
-					goto l233
+				if !_rules[ruleAction16]() {
+					goto l283
 				}
 				depth--
-				add(ruleexpr, position234)
+				add(ruleexpr, position284)
 			}
 			return true
-		l233:
-			position, tokenIndex, depth = position233, tokenIndex233, depth233
+		l283:
+			position, tokenIndex, depth = position283, tokenIndex283, depth283
 			return false
 		},
-		/* 20 commaless <- <((((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+ '.' ((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+) / ((&('"' | '`') string) | (&('!' | '&' | '*' | '+' | '-' | '.' | '/' | ':' | '<' | '=' | '>' | '^' | '|') operators) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') identifier)))> */
+		/* 23 commaless <- <((((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+ '.' ((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+) / ((&('"' | '`') string) | (&('!' | '&' | '*' | '+' | '-' | '.' | '/' | ':' | '<' | '=' | '>' | '^' | '|') operators) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') identifier)))> */
 		func() bool {
-			position244, tokenIndex244, depth244 := position, tokenIndex, depth
+			position294, tokenIndex294, depth294 := position, tokenIndex, depth
 			{
-				position245 := position
+				position295 := position
 				depth++
 				{
-					position246, tokenIndex246, depth246 := position, tokenIndex, depth
+					position296, tokenIndex296, depth296 := position, tokenIndex, depth
 					{
 						switch buffer[position] {
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l247
+								goto l297
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l247
+								goto l297
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l247
+								goto l297
 							}
 							position++
 							break
 						}
 					}
 
-				l248:
+				l298:
 					{
-						position249, tokenIndex249, depth249 := position, tokenIndex, depth
+						position299, tokenIndex299, depth299 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l249
+									goto l299
 								}
 								position++
 								break
 							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l249
+									goto l299
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l249
+									goto l299
 								}
 								position++
 								break
 							}
 						}
 
-						goto l248
-					l249:
-						position, tokenIndex, depth = position249, tokenIndex249, depth249
+						goto l298
+					l299:
+						position, tokenIndex, depth = position299, tokenIndex299, depth299
 					}
 					if buffer[position] != rune('.') {
-						goto l247
+						goto l297
 					}
 					position++
 					{
 						switch buffer[position] {
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l247
+								goto l297
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l247
+								goto l297
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l247
+								goto l297
 							}
 							position++
 							break
 						}
 					}
 
-				l252:
+				l302:
 					{
-						position253, tokenIndex253, depth253 := position, tokenIndex, depth
+						position303, tokenIndex303, depth303 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l253
+									goto l303
 								}
 								position++
 								break
 							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l253
+									goto l303
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l253
+									goto l303
 								}
 								position++
 								break
 							}
 						}
 
-						goto l252
-					l253:
-						position, tokenIndex, depth = position253, tokenIndex253, depth253
+						goto l302
+					l303:
+						position, tokenIndex, depth = position303, tokenIndex303, depth303
 					}
-					goto l246
-				l247:
-					position, tokenIndex, depth = position246, tokenIndex246, depth246
+					goto l296
+				l297:
+					position, tokenIndex, depth = position296, tokenIndex296, depth296
 					{
 						switch buffer[position] {
 						case '"', '`':
 							if !_rules[rulestring]() {
-								goto l244
+								goto l294
 							}
 							break
 						case '!', '&', '*', '+', '-', '.', '/', ':', '<', '=', '>', '^', '|':
 							if !_rules[ruleoperators]() {
-								goto l244
+								goto l294
 							}
 							break
 						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 							if !_rules[rulenumber]() {
-								goto l244
+								goto l294
 							}
 							break
 						default:
 							if !_rules[ruleidentifier]() {
-								goto l244
+								goto l294
 							}
 							break
 						}
 					}
 
 				}
-			l246:
+			l296:
 				depth--
-				add(rulecommaless, position245)
+				add(rulecommaless, position295)
 			}
 			return true
-		l244:
-			position, tokenIndex, depth = position244, tokenIndex244, depth244
+		l294:
+			position, tokenIndex, depth = position294, tokenIndex294, depth294
 			return false
 		},
-		/* 21 number <- <[0-9]+> */
+		/* 24 number <- <[0-9]+> */
 		func() bool {
-			position257, tokenIndex257, depth257 := position, tokenIndex, depth
+			position307, tokenIndex307, depth307 := position, tokenIndex, depth
 			{
-				position258 := position
+				position308 := position
 				depth++
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l257
+					goto l307
 				}
 				position++
-			l259:
+			l309:
 				{
-					position260, tokenIndex260, depth260 := position, tokenIndex, depth
+					position310, tokenIndex310, depth310 := position, tokenIndex, depth
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l260
+						goto l310
 					}
 					position++
-					goto l259
-				l260:
-					position, tokenIndex, depth = position260, tokenIndex260, depth260
+					goto l309
+				l310:
+					position, tokenIndex, depth = position310, tokenIndex310, depth310
 				}
 				depth--
-				add(rulenumber, position258)
+				add(rulenumber, position308)
 			}
 			return true
-		l257:
-			position, tokenIndex, depth = position257, tokenIndex257, depth257
+		l307:
+			position, tokenIndex, depth = position307, tokenIndex307, depth307
 			return false
 		},
-		/* 22 operators <- <((&('>') '>') | (&('<') '<') | (&('!') '!') | (&('.') '.') | (&('=') '=') | (&(':') ':') | (&('^') '^') | (&('&') '&') | (&('|') '|') | (&('/') '/') | (&('*') '*') | (&('-') '-') | (&('+') '+'))+> */
+		/* 25 operators <- <((&('>') '>') | (&('<') '<') | (&('!') '!') | (&('.') '.') | (&('=') '=') | (&(':') ':') | (&('^') '^') | (&('&') '&') | (&('|') '|') | (&('/') '/') | (&('*') '*') | (&('-') '-') | (&('+') '+'))+> */
 		func() bool {
-			position261, tokenIndex261, depth261 := position, tokenIndex, depth
+			position311, tokenIndex311, depth311 := position, tokenIndex, depth
 			{
-				position262 := position
+				position312 := position
 				depth++
 				{
 					switch buffer[position] {
 					case '>':
 						if buffer[position] != rune('>') {
-							goto l261
+							goto l311
 						}
 						position++
 						break
 					case '<':
 						if buffer[position] != rune('<') {
-							goto l261
+							goto l311
 						}
 						position++
 						break
 					case '!':
 						if buffer[position] != rune('!') {
-							goto l261
+							goto l311
 						}
 						position++
 						break
 					case '.':
 						if buffer[position] != rune('.') {
-							goto l261
+							goto l311
 						}
 						position++
 						break
 					case '=':
 						if buffer[position] != rune('=') {
-							goto l261
+							goto l311
 						}
 						position++
 						break
 					case ':':
 						if buffer[position] != rune(':') {
-							goto l261
+							goto l311
 						}
 						position++
 						break
 					case '^':
 						if buffer[position] != rune('^') {
-							goto l261
+							goto l311
 						}
 						position++
 						break
 					case '&':
 						if buffer[position] != rune('&') {
-							goto l261
+							goto l311
 						}
 						position++
 						break
 					case '|':
 						if buffer[position] != rune('|') {
-							goto l261
+							goto l311
 						}
 						position++
 						break
 					case '/':
 						if buffer[position] != rune('/') {
-							goto l261
+							goto l311
 						}
 						position++
 						break
 					case '*':
 						if buffer[position] != rune('*') {
-							goto l261
+							goto l311
 						}
 						position++
 						break
 					case '-':
 						if buffer[position] != rune('-') {
-							goto l261
+							goto l311
 						}
 						position++
 						break
 					default:
 						if buffer[position] != rune('+') {
-							goto l261
+							goto l311
 						}
 						position++
 						break
 					}
 				}
 
-			l263:
+			l313:
 				{
-					position264, tokenIndex264, depth264 := position, tokenIndex, depth
+					position314, tokenIndex314, depth314 := position, tokenIndex, depth
 					{
 						switch buffer[position] {
 						case '>':
 							if buffer[position] != rune('>') {
-								goto l264
+								goto l314
 							}
 							position++
 							break
 						case '<':
 							if buffer[position] != rune('<') {
-								goto l264
+								goto l314
 							}
 							position++
 							break
 						case '!':
 							if buffer[position] != rune('!') {
-								goto l264
+								goto l314
 							}
 							position++
 							break
 						case '.':
 							if buffer[position] != rune('.') {
-								goto l264
+								goto l314
 							}
 							position++
 							break
 						case '=':
 							if buffer[position] != rune('=') {
-								goto l264
+								goto l314
 							}
 							position++
 							break
 						case ':':
 							if buffer[position] != rune(':') {
-								goto l264
+								goto l314
 							}
 							position++
 							break
 						case '^':
 							if buffer[position] != rune('^') {
-								goto l264
+								goto l314
 							}
 							position++
 							break
 						case '&':
 							if buffer[position] != rune('&') {
-								goto l264
+								goto l314
 							}
 							position++
 							break
 						case '|':
 							if buffer[position] != rune('|') {
-								goto l264
+								goto l314
 							}
 							position++
 							break
 						case '/':
 							if buffer[position] != rune('/') {
-								goto l264
+								goto l314
 							}
 							position++
 							break
 						case '*':
 							if buffer[position] != rune('*') {
-								goto l264
+								goto l314
 							}
 							position++
 							break
 						case '-':
 							if buffer[position] != rune('-') {
-								goto l264
+								goto l314
 							}
 							position++
 							break
 						default:
 							if buffer[position] != rune('+') {
-								goto l264
+								goto l314
 							}
 							position++
 							break
 						}
 					}
 
-					goto l263
-				l264:
-					position, tokenIndex, depth = position264, tokenIndex264, depth264
+					goto l313
+				l314:
+					position, tokenIndex, depth = position314, tokenIndex314, depth314
 				}
 				depth--
-				add(ruleoperators, position262)
+				add(ruleoperators, position312)
 			}
 			return true
-		l261:
-			position, tokenIndex, depth = position261, tokenIndex261, depth261
+		l311:
+			position, tokenIndex, depth = position311, tokenIndex311, depth311
 			return false
 		},
-		/* 23 string <- <(('`' (!'`' .)* '`') / ('"' ((!'"' .) / ('\\' '"'))* '"'))> */
+		/* 26 string <- <(('`' (!'`' .)* '`') / ('"' ((!'"' .) / ('\\' '"'))* '"'))> */
 		func() bool {
-			position267, tokenIndex267, depth267 := position, tokenIndex, depth
+			position317, tokenIndex317, depth317 := position, tokenIndex, depth
 			{
-				position268 := position
+				position318 := position
 				depth++
 				{
-					position269, tokenIndex269, depth269 := position, tokenIndex, depth
+					position319, tokenIndex319, depth319 := position, tokenIndex, depth
 					if buffer[position] != rune('`') {
-						goto l270
+						goto l320
 					}
 					position++
-				l271:
+				l321:
 					{
-						position272, tokenIndex272, depth272 := position, tokenIndex, depth
+						position322, tokenIndex322, depth322 := position, tokenIndex, depth
 						{
-							position273, tokenIndex273, depth273 := position, tokenIndex, depth
+							position323, tokenIndex323, depth323 := position, tokenIndex, depth
 							if buffer[position] != rune('`') {
-								goto l273
+								goto l323
 							}
 							position++
-							goto l272
-						l273:
-							position, tokenIndex, depth = position273, tokenIndex273, depth273
+							goto l322
+						l323:
+							position, tokenIndex, depth = position323, tokenIndex323, depth323
 						}
 						if !matchDot() {
-							goto l272
+							goto l322
 						}
-						goto l271
-					l272:
-						position, tokenIndex, depth = position272, tokenIndex272, depth272
+						goto l321
+					l322:
+						position, tokenIndex, depth = position322, tokenIndex322, depth322
 					}
 					if buffer[position] != rune('`') {
-						goto l270
+						goto l320
 					}
 					position++
-					goto l269
-				l270:
-					position, tokenIndex, depth = position269, tokenIndex269, depth269
+					goto l319
+				l320:
+					position, tokenIndex, depth = position319, tokenIndex319, depth319
 					if buffer[position] != rune('"') {
-						goto l267
+						goto l317
 					}
 					position++
-				l274:
+				l324:
 					{
-						position275, tokenIndex275, depth275 := position, tokenIndex, depth
+						position325, tokenIndex325, depth325 := position, tokenIndex, depth
 						{
-							position276, tokenIndex276, depth276 := position, tokenIndex, depth
+							position326, tokenIndex326, depth326 := position, tokenIndex, depth
 							{
-								position278, tokenIndex278, depth278 := position, tokenIndex, depth
+								position328, tokenIndex328, depth328 := position, tokenIndex, depth
 								if buffer[position] != rune('"') {
-									goto l278
+									goto l328
 								}
 								position++
-								goto l277
-							l278:
-								position, tokenIndex, depth = position278, tokenIndex278, depth278
+								goto l327
+							l328:
+								position, tokenIndex, depth = position328, tokenIndex328, depth328
 							}
 							if !matchDot() {
-								goto l277
+								goto l327
 							}
-							goto l276
-						l277:
-							position, tokenIndex, depth = position276, tokenIndex276, depth276
+							goto l326
+						l327:
+							position, tokenIndex, depth = position326, tokenIndex326, depth326
 							if buffer[position] != rune('\\') {
-								goto l275
+								goto l325
 							}
 							position++
 							if buffer[position] != rune('"') {
-								goto l275
+								goto l325
 							}
 							position++
 						}
-					l276:
-						goto l274
-					l275:
-						position, tokenIndex, depth = position275, tokenIndex275, depth275
+					l326:
+						goto l324
+					l325:
+						position, tokenIndex, depth = position325, tokenIndex325, depth325
 					}
 					if buffer[position] != rune('"') {
-						goto l267
+						goto l317
 					}
 					position++
 				}
-			l269:
+			l319:
 				depth--
-				add(rulestring, position268)
+				add(rulestring, position318)
 			}
 			return true
-		l267:
-			position, tokenIndex, depth = position267, tokenIndex267, depth267
+		l317:
+			position, tokenIndex, depth = position317, tokenIndex317, depth317
 			return false
 		},
-		/* 24 enclosed <- <((&('[') brackets) | (&('{') braces) | (&('(') parens))> */
+		/* 27 enclosed <- <((&('[') brackets) | (&('{') braces) | (&('(') parens))> */
 		func() bool {
-			position279, tokenIndex279, depth279 := position, tokenIndex, depth
+			position329, tokenIndex329, depth329 := position, tokenIndex, depth
 			{
-				position280 := position
+				position330 := position
 				depth++
 				{
 					switch buffer[position] {
 					case '[':
 						if !_rules[rulebrackets]() {
-							goto l279
+							goto l329
 						}
 						break
 					case '{':
 						if !_rules[rulebraces]() {
-							goto l279
+							goto l329
 						}
 						break
 					default:
 						if !_rules[ruleparens]() {
-							goto l279
+							goto l329
 						}
 						break
 					}
 				}
 
 				depth--
-				add(ruleenclosed, position280)
+				add(ruleenclosed, position330)
 			}
 			return true
-		l279:
-			position, tokenIndex, depth = position279, tokenIndex279, depth279
+		l329:
+			position, tokenIndex, depth = position329, tokenIndex329, depth329
 			return false
 		},
-		/* 25 parens <- <('(' inner ')')> */
+		/* 28 parens <- <('(' inner ')')> */
 		func() bool {
-			position282, tokenIndex282, depth282 := position, tokenIndex, depth
+			position332, tokenIndex332, depth332 := position, tokenIndex, depth
 			{
-				position283 := position
+				position333 := position
 				depth++
 				if buffer[position] != rune('(') {
-					goto l282
+					goto l332
 				}
 				position++
 				if !_rules[ruleinner]() {
-					goto l282
+					goto l332
 				}
 				if buffer[position] != rune(')') {
-					goto l282
+					goto l332
 				}
 				position++
 				depth--
-				add(ruleparens, position283)
+				add(ruleparens, position333)
 			}
 			return true
-		l282:
-			position, tokenIndex, depth = position282, tokenIndex282, depth282
+		l332:
+			position, tokenIndex, depth = position332, tokenIndex332, depth332
 			return false
 		},
-		/* 26 braces <- <('{' inner '}')> */
+		/* 29 braces <- <('{' inner '}')> */
 		func() bool {
-			position284, tokenIndex284, depth284 := position, tokenIndex, depth
+			position334, tokenIndex334, depth334 := position, tokenIndex, depth
 			{
-				position285 := position
+				position335 := position
 				depth++
 				if buffer[position] != rune('{') {
-					goto l284
+					goto l334
 				}
 				position++
 				if !_rules[ruleinner]() {
-					goto l284
+					goto l334
 				}
 				if buffer[position] != rune('}') {
-					goto l284
+					goto l334
 				}
 				position++
 				depth--
-				add(rulebraces, position285)
+				add(rulebraces, position335)
 			}
 			return true
-		l284:
-			position, tokenIndex, depth = position284, tokenIndex284, depth284
+		l334:
+			position, tokenIndex, depth = position334, tokenIndex334, depth334
 			return false
 		},
-		/* 27 brackets <- <('[' inner ']')> */
+		/* 30 brackets <- <('[' inner ']')> */
 		func() bool {
-			position286, tokenIndex286, depth286 := position, tokenIndex, depth
+			position336, tokenIndex336, depth336 := position, tokenIndex, depth
 			{
-				position287 := position
+				position337 := position
 				depth++
 				if buffer[position] != rune('[') {
-					goto l286
+					goto l336
 				}
 				position++
 				if !_rules[ruleinner]() {
-					goto l286
+					goto l336
 				}
 				if buffer[position] != rune(']') {
-					goto l286
+					goto l336
 				}
 				position++
 				depth--
-				add(rulebrackets, position287)
+				add(rulebrackets, position337)
 			}
 			return true
-		l286:
-			position, tokenIndex, depth = position286, tokenIndex286, depth286
+		l336:
+			position, tokenIndex, depth = position336, tokenIndex336, depth336
 			return false
 		},
-		/* 28 inner <- <((&('\t' | ' ') isp+) | (&(',') ',') | (&('(' | '[' | '{') enclosed) | (&('!' | '"' | '&' | '*' | '+' | '-' | '.' | '/' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | ':' | '<' | '=' | '>' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '^' | '_' | '`' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | '|') commaless))*> */
+		/* 31 inner <- <((&('\t' | ' ') isp+) | (&(',') ',') | (&('(' | '[' | '{') enclosed) | (&('!' | '"' | '&' | '*' | '+' | '-' | '.' | '/' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | ':' | '<' | '=' | '>' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '^' | '_' | '`' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | '|') commaless))*> */
 		func() bool {
 			{
-				position289 := position
+				position339 := position
 				depth++
-			l290:
+			l340:
 				{
-					position291, tokenIndex291, depth291 := position, tokenIndex, depth
+					position341, tokenIndex341, depth341 := position, tokenIndex, depth
 					{
 						switch buffer[position] {
 						case '\t', ' ':
 							if !_rules[ruleisp]() {
-								goto l291
+								goto l341
 							}
-						l293:
+						l343:
 							{
-								position294, tokenIndex294, depth294 := position, tokenIndex, depth
+								position344, tokenIndex344, depth344 := position, tokenIndex, depth
 								if !_rules[ruleisp]() {
-									goto l294
+									goto l344
 								}
-								goto l293
-							l294:
-								position, tokenIndex, depth = position294, tokenIndex294, depth294
+								goto l343
+							l344:
+								position, tokenIndex, depth = position344, tokenIndex344, depth344
 							}
 							break
 						case ',':
 							if buffer[position] != rune(',') {
-								goto l291
+								goto l341
 							}
 							position++
 							break
 						case '(', '[', '{':
 							if !_rules[ruleenclosed]() {
-								goto l291
+								goto l341
 							}
 							break
 						default:
 							if !_rules[rulecommaless]() {
-								goto l291
+								goto l341
 							}
 							break
 						}
 					}
 
-					goto l290
-				l291:
-					position, tokenIndex, depth = position291, tokenIndex291, depth291
+					goto l340
+				l341:
+					position, tokenIndex, depth = position341, tokenIndex341, depth341
 				}
 				depth--
-				add(ruleinner, position289)
+				add(ruleinner, position339)
 			}
 			return true
 		},
-		/* 29 identifier <- <(((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])) ((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') ([0-9] / [0-9])) | (&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))*)> */
+		/* 32 identifier <- <(((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])) ((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') ([0-9] / [0-9])) | (&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))*)> */
 		func() bool {
-			position295, tokenIndex295, depth295 := position, tokenIndex, depth
+			position345, tokenIndex345, depth345 := position, tokenIndex, depth
 			{
-				position296 := position
+				position346 := position
 				depth++
 				{
 					switch buffer[position] {
 					case '_':
 						if buffer[position] != rune('_') {
-							goto l295
+							goto l345
 						}
 						position++
 						break
 					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l295
+							goto l345
 						}
 						position++
 						break
 					default:
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l295
+							goto l345
 						}
 						position++
 						break
 					}
 				}
 
-			l298:
+			l348:
 				{
-					position299, tokenIndex299, depth299 := position, tokenIndex, depth
+					position349, tokenIndex349, depth349 := position, tokenIndex, depth
 					{
 						switch buffer[position] {
 						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 							{
-								position301, tokenIndex301, depth301 := position, tokenIndex, depth
+								position351, tokenIndex351, depth351 := position, tokenIndex, depth
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l302
+									goto l352
 								}
 								position++
-								goto l301
-							l302:
-								position, tokenIndex, depth = position301, tokenIndex301, depth301
+								goto l351
+							l352:
+								position, tokenIndex, depth = position351, tokenIndex351, depth351
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l299
+									goto l349
 								}
 								position++
 							}
-						l301:
+						l351:
 							break
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l299
+								goto l349
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l299
+								goto l349
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l299
+								goto l349
 							}
 							position++
 							break
 						}
 					}
 
-					goto l298
-				l299:
-					position, tokenIndex, depth = position299, tokenIndex299, depth299
+					goto l348
+				l349:
+					position, tokenIndex, depth = position349, tokenIndex349, depth349
 				}
 				depth--
-				add(ruleidentifier, position296)
+				add(ruleidentifier, position346)
 			}
 			return true
-		l295:
-			position, tokenIndex, depth = position295, tokenIndex295, depth295
+		l345:
+			position, tokenIndex, depth = position345, tokenIndex345, depth345
 			return false
 		},
-		/* 30 fields <- <(((&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ') | (&(';') ';'))* field isp* (fsep isp* (fsep isp*)* field)* ((&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ') | (&(';') ';'))* !.)> */
+		/* 33 fields <- <(((&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ') | (&(';') ';'))* field isp* (fsep isp* (fsep isp*)* field)* ((&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ') | (&(';') ';'))* !.)> */
 		func() bool {
-			position303, tokenIndex303, depth303 := position, tokenIndex, depth
+			position353, tokenIndex353, depth353 := position, tokenIndex, depth
 			{
-				position304 := position
+				position354 := position
 				depth++
-			l305:
+			l355:
 				{
-					position306, tokenIndex306, depth306 := position, tokenIndex, depth
+					position356, tokenIndex356, depth356 := position, tokenIndex, depth
 					{
 						switch buffer[position] {
 						case '\n':
 							if buffer[position] != rune('\n') {
-								goto l306
+								goto l356
 							}
 							position++
 							break
 						case '\t':
 							if buffer[position] != rune('\t') {
-								goto l306
+								goto l356
 							}
 							position++
 							break
 						case ' ':
 							if buffer[position] != rune(' ') {
-								goto l306
+								goto l356
 							}
 							position++
 							break
 						default:
 							if buffer[position] != rune(';') {
-								goto l306
+								goto l356
 							}
 							position++
 							break
 						}
 					}
 
-					goto l305
-				l306:
-					position, tokenIndex, depth = position306, tokenIndex306, depth306
+					goto l355
+				l356:
+					position, tokenIndex, depth = position356, tokenIndex356, depth356
 				}
 				if !_rules[rulefield]() {
-					goto l303
+					goto l353
 				}
-			l308:
+			l358:
 				{
-					position309, tokenIndex309, depth309 := position, tokenIndex, depth
+					position359, tokenIndex359, depth359 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l309
+						goto l359
 					}
-					goto l308
-				l309:
-					position, tokenIndex, depth = position309, tokenIndex309, depth309
+					goto l358
+				l359:
+					position, tokenIndex, depth = position359, tokenIndex359, depth359
 				}
-			l310:
+			l360:
 				{
-					position311, tokenIndex311, depth311 := position, tokenIndex, depth
+					position361, tokenIndex361, depth361 := position, tokenIndex, depth
 					if !_rules[rulefsep]() {
-						goto l311
+						goto l361
 					}
-				l312:
+				l362:
 					{
-						position313, tokenIndex313, depth313 := position, tokenIndex, depth
+						position363, tokenIndex363, depth363 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l313
+							goto l363
 						}
-						goto l312
-					l313:
-						position, tokenIndex, depth = position313, tokenIndex313, depth313
+						goto l362
+					l363:
+						position, tokenIndex, depth = position363, tokenIndex363, depth363
 					}
-				l314:
+				l364:
 					{
-						position315, tokenIndex315, depth315 := position, tokenIndex, depth
+						position365, tokenIndex365, depth365 := position, tokenIndex, depth
 						if !_rules[rulefsep]() {
-							goto l315
+							goto l365
 						}
-					l316:
+					l366:
 						{
-							position317, tokenIndex317, depth317 := position, tokenIndex, depth
+							position367, tokenIndex367, depth367 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l317
+								goto l367
 							}
-							goto l316
-						l317:
-							position, tokenIndex, depth = position317, tokenIndex317, depth317
+							goto l366
+						l367:
+							position, tokenIndex, depth = position367, tokenIndex367, depth367
 						}
-						goto l314
-					l315:
-						position, tokenIndex, depth = position315, tokenIndex315, depth315
+						goto l364
+					l365:
+						position, tokenIndex, depth = position365, tokenIndex365, depth365
 					}
 					if !_rules[rulefield]() {
-						goto l311
+						goto l361
 					}
-					goto l310
-				l311:
-					position, tokenIndex, depth = position311, tokenIndex311, depth311
+					goto l360
+				l361:
+					position, tokenIndex, depth = position361, tokenIndex361, depth361
 				}
-			l318:
+			l368:
 				{
-					position319, tokenIndex319, depth319 := position, tokenIndex, depth
+					position369, tokenIndex369, depth369 := position, tokenIndex, depth
 					{
 						switch buffer[position] {
 						case '\n':
 							if buffer[position] != rune('\n') {
-								goto l319
+								goto l369
 							}
 							position++
 							break
 						case '\t':
 							if buffer[position] != rune('\t') {
-								goto l319
+								goto l369
 							}
 							position++
 							break
 						case ' ':
 							if buffer[position] != rune(' ') {
-								goto l319
+								goto l369
 							}
 							position++
 							break
 						default:
 							if buffer[position] != rune(';') {
-								goto l319
+								goto l369
 							}
 							position++
 							break
 						}
 					}
 
-					goto l318
-				l319:
-					position, tokenIndex, depth = position319, tokenIndex319, depth319
+					goto l368
+				l369:
+					position, tokenIndex, depth = position369, tokenIndex369, depth369
 				}
 				{
-					position321, tokenIndex321, depth321 := position, tokenIndex, depth
+					position371, tokenIndex371, depth371 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l321
+						goto l371
 					}
-					goto l303
-				l321:
-					position, tokenIndex, depth = position321, tokenIndex321, depth321
+					goto l353
+				l371:
+					position, tokenIndex, depth = position371, tokenIndex371, depth371
 				}
 				depth--
-				add(rulefields, position304)
+				add(rulefields, position354)
 			}
 			return true
-		l303:
-			position, tokenIndex, depth = position303, tokenIndex303, depth303
+		l353:
+			position, tokenIndex, depth = position353, tokenIndex353, depth353
 			return false
 		},
-		/* 31 fsep <- <(';' / '\n')> */
+		/* 34 fsep <- <(';' / '\n')> */
 		func() bool {
-			position322, tokenIndex322, depth322 := position, tokenIndex, depth
+			position372, tokenIndex372, depth372 := position, tokenIndex, depth
 			{
-				position323 := position
+				position373 := position
 				depth++
 				{
-					position324, tokenIndex324, depth324 := position, tokenIndex, depth
+					position374, tokenIndex374, depth374 := position, tokenIndex, depth
 					if buffer[position] != rune(';') {
-						goto l325
+						goto l375
 					}
 					position++
-					goto l324
-				l325:
-					position, tokenIndex, depth = position324, tokenIndex324, depth324
+					goto l374
+				l375:
+					position, tokenIndex, depth = position374, tokenIndex374, depth374
 					if buffer[position] != rune('\n') {
-						goto l322
+						goto l372
 					}
 					position++
 				}
-			l324:
+			l374:
 				depth--
-				add(rulefsep, position323)
+				add(rulefsep, position373)
 			}
 			return true
-		l322:
-			position, tokenIndex, depth = position322, tokenIndex322, depth322
+		l372:
+			position, tokenIndex, depth = position372, tokenIndex372, depth372
 			return false
 		},
-		/* 32 field <- <(name (isp* ',' isp* name)* isp+ type isp* ('=' isp* expr)? Action15)> */
+		/* 35 field <- <(name (isp* ',' isp* name)* isp+ type isp* ('=' isp* expr)? Action17)> */
 		func() bool {
-			position326, tokenIndex326, depth326 := position, tokenIndex, depth
+			position376, tokenIndex376, depth376 := position, tokenIndex, depth
 			{
-				position327 := position
+				position377 := position
 				depth++
 				if !_rules[rulename]() {
-					goto l326
+					goto l376
 				}
-			l328:
+			l378:
 				{
-					position329, tokenIndex329, depth329 := position, tokenIndex, depth
-				l330:
+					position379, tokenIndex379, depth379 := position, tokenIndex, depth
+				l380:
 					{
-						position331, tokenIndex331, depth331 := position, tokenIndex, depth
+						position381, tokenIndex381, depth381 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l331
+							goto l381
 						}
-						goto l330
-					l331:
-						position, tokenIndex, depth = position331, tokenIndex331, depth331
+						goto l380
+					l381:
+						position, tokenIndex, depth = position381, tokenIndex381, depth381
 					}
 					if buffer[position] != rune(',') {
-						goto l329
+						goto l379
 					}
 					position++
-				l332:
+				l382:
 					{
-						position333, tokenIndex333, depth333 := position, tokenIndex, depth
+						position383, tokenIndex383, depth383 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l333
+							goto l383
 						}
-						goto l332
-					l333:
-						position, tokenIndex, depth = position333, tokenIndex333, depth333
+						goto l382
+					l383:
+						position, tokenIndex, depth = position383, tokenIndex383, depth383
 					}
 					if !_rules[rulename]() {
-						goto l329
+						goto l379
 					}
-					goto l328
-				l329:
-					position, tokenIndex, depth = position329, tokenIndex329, depth329
+					goto l378
+				l379:
+					position, tokenIndex, depth = position379, tokenIndex379, depth379
 				}
 				if !_rules[ruleisp]() {
-					goto l326
+					goto l376
 				}
-			l334:
+			l384:
 				{
-					position335, tokenIndex335, depth335 := position, tokenIndex, depth
+					position385, tokenIndex385, depth385 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l335
+						goto l385
 					}
-					goto l334
-				l335:
-					position, tokenIndex, depth = position335, tokenIndex335, depth335
+					goto l384
+				l385:
+					position, tokenIndex, depth = position385, tokenIndex385, depth385
 				}
 				if !_rules[ruletype]() {
-					goto l326
+					goto l376
 				}
-			l336:
+			l386:
 				{
-					position337, tokenIndex337, depth337 := position, tokenIndex, depth
+					position387, tokenIndex387, depth387 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l337
+						goto l387
 					}
-					goto l336
-				l337:
-					position, tokenIndex, depth = position337, tokenIndex337, depth337
+					goto l386
+				l387:
+					position, tokenIndex, depth = position387, tokenIndex387, depth387
 				}
 				{
-					position338, tokenIndex338, depth338 := position, tokenIndex, depth
+					position388, tokenIndex388, depth388 := position, tokenIndex, depth
 					if buffer[position] != rune('=') {
-						goto l338
+						goto l388
 					}
 					position++
-				l340:
+				l390:
 					{
-						position341, tokenIndex341, depth341 := position, tokenIndex, depth
+						position391, tokenIndex391, depth391 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l341
+							goto l391
 						}
-						goto l340
-					l341:
-						position, tokenIndex, depth = position341, tokenIndex341, depth341
+						goto l390
+					l391:
+						position, tokenIndex, depth = position391, tokenIndex391, depth391
 					}
 					if !_rules[ruleexpr]() {
-						goto l338
+						goto l388
 					}
-					goto l339
-				l338:
-					position, tokenIndex, depth = position338, tokenIndex338, depth338
+					goto l389
+				l388:
+					position, tokenIndex, depth = position388, tokenIndex388, depth388
 				}
-			l339:
-				if !_rules[ruleAction15]() {
-					goto l326
+			l389:
+				if !_rules[ruleAction17]() {
+					goto l376
 				}
 				depth--
-				add(rulefield, position327)
+				add(rulefield, position377)
 			}
 			return true
-		l326:
-			position, tokenIndex, depth = position326, tokenIndex326, depth326
+		l376:
+			position, tokenIndex, depth = position376, tokenIndex376, depth376
 			return false
 		},
-		/* 33 name <- <(<((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> Action16)> */
+		/* 36 name <- <(<(((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])) ((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') ([0-9] / [0-9])) | (&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))*)> Action18)> */
 		func() bool {
-			position342, tokenIndex342, depth342 := position, tokenIndex, depth
+			position392, tokenIndex392, depth392 := position, tokenIndex, depth
 			{
-				position343 := position
+				position393 := position
 				depth++
 				{
-					position344 := position
+					position394 := position
 					depth++
 					{
 						switch buffer[position] {
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l342
+								goto l392
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l342
+								goto l392
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l342
+								goto l392
 							}
 							position++
 							break
 						}
 					}
 
-				l345:
+				l396:
 					{
-						position346, tokenIndex346, depth346 := position, tokenIndex, depth
+						position397, tokenIndex397, depth397 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								{
+									position399, tokenIndex399, depth399 := position, tokenIndex, depth
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l400
+									}
+									position++
+									goto l399
+								l400:
+									position, tokenIndex, depth = position399, tokenIndex399, depth399
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l397
+									}
+									position++
+								}
+							l399:
+								break
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l346
+									goto l397
 								}
 								position++
 								break
 							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l346
+									goto l397
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l346
+									goto l397
 								}
 								position++
 								break
 							}
 						}
 
-						goto l345
-					l346:
-						position, tokenIndex, depth = position346, tokenIndex346, depth346
+						goto l396
+					l397:
+						position, tokenIndex, depth = position397, tokenIndex397, depth397
 					}
 					depth--
-					add(rulePegText, position344)
+					add(rulePegText, position394)
 				}
-				if !_rules[ruleAction16]() {
-					goto l342
+				if !_rules[ruleAction18]() {
+					goto l392
 				}
 				depth--
-				add(rulename, position343)
+				add(rulename, position393)
 			}
 			return true
-		l342:
-			position, tokenIndex, depth = position342, tokenIndex342, depth342
+		l392:
+			position, tokenIndex, depth = position392, tokenIndex392, depth392
 			return false
 		},
-		/* 34 type <- <(chan / func / qname / sname / ((&('*') pointer) | (&('[') array) | (&('M' | 'm') map)))> */
+		/* 37 type <- <(chan / func / map / generic / qname / ((&('*') pointer) | (&('[') array) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') sname)))> */
 		func() bool {
-			position349, tokenIndex349, depth349 := position, tokenIndex, depth
+			position401, tokenIndex401, depth401 := position, tokenIndex, depth
 			{
-				position350 := position
+				position402 := position
 				depth++
 				{
-					position351, tokenIndex351, depth351 := position, tokenIndex, depth
+					position403, tokenIndex403, depth403 := position, tokenIndex, depth
 					if !_rules[rulechan]() {
-						goto l352
+						goto l404
 					}
-					goto l351
-				l352:
-					position, tokenIndex, depth = position351, tokenIndex351, depth351
+					goto l403
+				l404:
+					position, tokenIndex, depth = position403, tokenIndex403, depth403
 					if !_rules[rulefunc]() {
-						goto l353
+						goto l405
 					}
-					goto l351
-				l353:
-					position, tokenIndex, depth = position351, tokenIndex351, depth351
-					if !_rules[ruleqname]() {
-						goto l354
+					goto l403
+				l405:
+					position, tokenIndex, depth = position403, tokenIndex403, depth403
+					if !_rules[rulemap]() {
+						goto l406
 					}
-					goto l351
-				l354:
-					position, tokenIndex, depth = position351, tokenIndex351, depth351
-					if !_rules[rulesname]() {
-						goto l355
+					goto l403
+				l406:
+					position, tokenIndex, depth = position403, tokenIndex403, depth403
+					if !_rules[rulegeneric]() {
+						goto l407
+					}
+					goto l403
+				l407:
+					position, tokenIndex, depth = position403, tokenIndex403, depth403
+					if !_rules[ruleqname]() {
+						goto l408
 					}
-					goto l351
-				l355:
-					position, tokenIndex, depth = position351, tokenIndex351, depth351
+					goto l403
+				l408:
+					position, tokenIndex, depth = position403, tokenIndex403, depth403
 					{
 						switch buffer[position] {
 						case '*':
 							if !_rules[rulepointer]() {
-								goto l349
+								goto l401
 							}
 							break
 						case '[':
 							if !_rules[rulearray]() {
-								goto l349
+								goto l401
 							}
 							break
 						default:
-							if !_rules[rulemap]() {
-								goto l349
+							if !_rules[rulesname]() {
+								goto l401
 							}
 							break
 						}
 					}
 
 				}
-			l351:
+			l403:
+				depth--
+				add(ruletype, position402)
+			}
+			return true
+		l401:
+			position, tokenIndex, depth = position401, tokenIndex401, depth401
+			return false
+		},
+		/* 38 generic <- <((qname / sname) typeargsstart isp* typearg isp* (',' isp* typearg isp*)* ']' Action19)> */
+		func() bool {
+			position410, tokenIndex410, depth410 := position, tokenIndex, depth
+			{
+				position411 := position
+				depth++
+				{
+					position412, tokenIndex412, depth412 := position, tokenIndex, depth
+					if !_rules[ruleqname]() {
+						goto l413
+					}
+					goto l412
+				l413:
+					position, tokenIndex, depth = position412, tokenIndex412, depth412
+					if !_rules[rulesname]() {
+						goto l410
+					}
+				}
+			l412:
+				if !_rules[ruletypeargsstart]() {
+					goto l410
+				}
+			l414:
+				{
+					position415, tokenIndex415, depth415 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l415
+					}
+					goto l414
+				l415:
+					position, tokenIndex, depth = position415, tokenIndex415, depth415
+				}
+				if !_rules[ruletypearg]() {
+					goto l410
+				}
+			l416:
+				{
+					position417, tokenIndex417, depth417 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l417
+					}
+					goto l416
+				l417:
+					position, tokenIndex, depth = position417, tokenIndex417, depth417
+				}
+			l418:
+				{
+					position419, tokenIndex419, depth419 := position, tokenIndex, depth
+					if buffer[position] != rune(',') {
+						goto l419
+					}
+					position++
+				l420:
+					{
+						position421, tokenIndex421, depth421 := position, tokenIndex, depth
+						if !_rules[ruleisp]() {
+							goto l421
+						}
+						goto l420
+					l421:
+						position, tokenIndex, depth = position421, tokenIndex421, depth421
+					}
+					if !_rules[ruletypearg]() {
+						goto l419
+					}
+				l422:
+					{
+						position423, tokenIndex423, depth423 := position, tokenIndex, depth
+						if !_rules[ruleisp]() {
+							goto l423
+						}
+						goto l422
+					l423:
+						position, tokenIndex, depth = position423, tokenIndex423, depth423
+					}
+					goto l418
+				l419:
+					position, tokenIndex, depth = position419, tokenIndex419, depth419
+				}
+				if buffer[position] != rune(']') {
+					goto l410
+				}
+				position++
+				if !_rules[ruleAction19]() {
+					goto l410
+				}
+				depth--
+				add(rulegeneric, position411)
+			}
+			return true
+		l410:
+			position, tokenIndex, depth = position410, tokenIndex410, depth410
+			return false
+		},
+		/* 39 typeargsstart <- <('[' Action20)> */
+		func() bool {
+			position424, tokenIndex424, depth424 := position, tokenIndex, depth
+			{
+				position425 := position
+				depth++
+				if buffer[position] != rune('[') {
+					goto l424
+				}
+				position++
+				if !_rules[ruleAction20]() {
+					goto l424
+				}
+				depth--
+				add(ruletypeargsstart, position425)
+			}
+			return true
+		l424:
+			position, tokenIndex, depth = position424, tokenIndex424, depth424
+			return false
+		},
+		/* 40 typearg <- <(type Action21)> */
+		func() bool {
+			position426, tokenIndex426, depth426 := position, tokenIndex, depth
+			{
+				position427 := position
+				depth++
+				if !_rules[ruletype]() {
+					goto l426
+				}
+				if !_rules[ruleAction21]() {
+					goto l426
+				}
 				depth--
-				add(ruletype, position350)
+				add(ruletypearg, position427)
 			}
 			return true
-		l349:
-			position, tokenIndex, depth = position349, tokenIndex349, depth349
+		l426:
+			position, tokenIndex, depth = position426, tokenIndex426, depth426
 			return false
 		},
-		/* 35 sname <- <(<((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> Action17)> */
+		/* 41 sname <- <(<(((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])) ((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') ([0-9] / [0-9])) | (&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))*)> Action22)> */
 		func() bool {
-			position357, tokenIndex357, depth357 := position, tokenIndex, depth
+			position428, tokenIndex428, depth428 := position, tokenIndex, depth
 			{
-				position358 := position
+				position429 := position
 				depth++
 				{
-					position359 := position
+					position430 := position
 					depth++
 					{
 						switch buffer[position] {
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l357
+								goto l428
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l357
+								goto l428
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l357
+								goto l428
 							}
 							position++
 							break
 						}
 					}
 
-				l360:
+				l432:
 					{
-						position361, tokenIndex361, depth361 := position, tokenIndex, depth
+						position433, tokenIndex433, depth433 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								{
+									position435, tokenIndex435, depth435 := position, tokenIndex, depth
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l436
+									}
+									position++
+									goto l435
+								l436:
+									position, tokenIndex, depth = position435, tokenIndex435, depth435
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l433
+									}
+									position++
+								}
+							l435:
+								break
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l361
+									goto l433
 								}
 								position++
 								break
 							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l361
+									goto l433
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l361
+									goto l433
 								}
 								position++
 								break
 							}
 						}
 
-						goto l360
-					l361:
-						position, tokenIndex, depth = position361, tokenIndex361, depth361
+						goto l432
+					l433:
+						position, tokenIndex, depth = position433, tokenIndex433, depth433
 					}
 					depth--
-					add(rulePegText, position359)
+					add(rulePegText, position430)
 				}
-				if !_rules[ruleAction17]() {
-					goto l357
+				if !_rules[ruleAction22]() {
+					goto l428
 				}
 				depth--
-				add(rulesname, position358)
+				add(rulesname, position429)
 			}
 			return true
-		l357:
-			position, tokenIndex, depth = position357, tokenIndex357, depth357
+		l428:
+			position, tokenIndex, depth = position428, tokenIndex428, depth428
 			return false
 		},
-		/* 36 qname <- <(<(((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+ '.' ((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+)> Action18)> */
+		/* 42 qname <- <(<(((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])) ((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') ([0-9] / [0-9])) | (&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))* '.' ((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])) ((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') ([0-9] / [0-9])) | (&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))*)> Action23)> */
 		func() bool {
-			position364, tokenIndex364, depth364 := position, tokenIndex, depth
+			position437, tokenIndex437, depth437 := position, tokenIndex, depth
 			{
-				position365 := position
+				position438 := position
 				depth++
 				{
-					position366 := position
+					position439 := position
 					depth++
 					{
 						switch buffer[position] {
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l364
+								goto l437
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l364
+								goto l437
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l364
+								goto l437
 							}
 							position++
 							break
 						}
 					}
 
-				l367:
+				l441:
 					{
-						position368, tokenIndex368, depth368 := position, tokenIndex, depth
+						position442, tokenIndex442, depth442 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								{
+									position444, tokenIndex444, depth444 := position, tokenIndex, depth
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l445
+									}
+									position++
+									goto l444
+								l445:
+									position, tokenIndex, depth = position444, tokenIndex444, depth444
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l442
+									}
+									position++
+								}
+							l444:
+								break
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l368
+									goto l442
 								}
 								position++
 								break
 							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l368
+									goto l442
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l368
+									goto l442
 								}
 								position++
 								break
 							}
 						}
 
-						goto l367
-					l368:
-						position, tokenIndex, depth = position368, tokenIndex368, depth368
+						goto l441
+					l442:
+						position, tokenIndex, depth = position442, tokenIndex442, depth442
 					}
 					if buffer[position] != rune('.') {
-						goto l364
+						goto l437
 					}
 					position++
 					{
 						switch buffer[position] {
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l364
+								goto l437
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l364
+								goto l437
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l364
+								goto l437
 							}
 							position++
 							break
 						}
 					}
 
-				l371:
+				l447:
 					{
-						position372, tokenIndex372, depth372 := position, tokenIndex, depth
+						position448, tokenIndex448, depth448 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
+							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+								{
+									position450, tokenIndex450, depth450 := position, tokenIndex, depth
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l451
+									}
+									position++
+									goto l450
+								l451:
+									position, tokenIndex, depth = position450, tokenIndex450, depth450
+									if c := buffer[position]; c < rune('0') || c > rune('9') {
+										goto l448
+									}
+									position++
+								}
+							l450:
+								break
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l372
+									goto l448
 								}
 								position++
 								break
 							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l372
+									goto l448
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l372
+									goto l448
 								}
 								position++
 								break
 							}
 						}
 
-						goto l371
-					l372:
-						position, tokenIndex, depth = position372, tokenIndex372, depth372
+						goto l447
+					l448:
+						position, tokenIndex, depth = position448, tokenIndex448, depth448
 					}
 					depth--
-					add(rulePegText, position366)
+					add(rulePegText, position439)
 				}
-				if !_rules[ruleAction18]() {
-					goto l364
+				if !_rules[ruleAction23]() {
+					goto l437
 				}
 				depth--
-				add(ruleqname, position365)
+				add(ruleqname, position438)
 			}
 			return true
-		l364:
-			position, tokenIndex, depth = position364, tokenIndex364, depth364
+		l437:
+			position, tokenIndex, depth = position437, tokenIndex437, depth437
 			return false
 		},
-		/* 37 array <- <('[' ']' type Action19)> */
+		/* 43 array <- <('[' ']' type Action24)> */
 		func() bool {
-			position375, tokenIndex375, depth375 := position, tokenIndex, depth
+			position452, tokenIndex452, depth452 := position, tokenIndex, depth
 			{
-				position376 := position
+				position453 := position
 				depth++
 				if buffer[position] != rune('[') {
-					goto l375
+					goto l452
 				}
 				position++
 				if buffer[position] != rune(']') {
-					goto l375
+					goto l452
 				}
 				position++
 				if !_rules[ruletype]() {
-					goto l375
+					goto l452
 				}
-				if !_rules[ruleAction19]() {
-					goto l375
+				if !_rules[ruleAction24]() {
+					goto l452
 				}
 				depth--
-				add(rulearray, position376)
+				add(rulearray, position453)
 			}
 			return true
-		l375:
-			position, tokenIndex, depth = position375, tokenIndex375, depth375
+		l452:
+			position, tokenIndex, depth = position452, tokenIndex452, depth452
 			return false
 		},
-		/* 38 map <- <(('m' / 'M') ('a' / 'A') ('p' / 'P') '[' isp* keytype isp* ']' type Action20)> */
+		/* 44 map <- <(('m' / 'M') ('a' / 'A') ('p' / 'P') '[' isp* keytype isp* ']' type Action25)> */
 		func() bool {
-			position377, tokenIndex377, depth377 := position, tokenIndex, depth
+			position454, tokenIndex454, depth454 := position, tokenIndex, depth
 			{
-				position378 := position
+				position455 := position
 				depth++
 				{
-					position379, tokenIndex379, depth379 := position, tokenIndex, depth
+					position456, tokenIndex456, depth456 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l380
+						goto l457
 					}
 					position++
-					goto l379
-				l380:
-					position, tokenIndex, depth = position379, tokenIndex379, depth379
+					goto l456
+				l457:
+					position, tokenIndex, depth = position456, tokenIndex456, depth456
 					if buffer[position] != rune('M') {
-						goto l377
+						goto l454
 					}
 					position++
 				}
-			l379:
+			l456:
 				{
-					position381, tokenIndex381, depth381 := position, tokenIndex, depth
+					position458, tokenIndex458, depth458 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l382
+						goto l459
 					}
 					position++
-					goto l381
-				l382:
-					position, tokenIndex, depth = position381, tokenIndex381, depth381
+					goto l458
+				l459:
+					position, tokenIndex, depth = position458, tokenIndex458, depth458
 					if buffer[position] != rune('A') {
-						goto l377
+						goto l454
 					}
 					position++
 				}
-			l381:
+			l458:
 				{
-					position383, tokenIndex383, depth383 := position, tokenIndex, depth
+					position460, tokenIndex460, depth460 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l384
+						goto l461
 					}
 					position++
-					goto l383
-				l384:
-					position, tokenIndex, depth = position383, tokenIndex383, depth383
+					goto l460
+				l461:
+					position, tokenIndex, depth = position460, tokenIndex460, depth460
 					if buffer[position] != rune('P') {
-						goto l377
+						goto l454
 					}
 					position++
 				}
-			l383:
+			l460:
 				if buffer[position] != rune('[') {
-					goto l377
+					goto l454
 				}
 				position++
-			l385:
+			l462:
 				{
-					position386, tokenIndex386, depth386 := position, tokenIndex, depth
+					position463, tokenIndex463, depth463 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l386
+						goto l463
 					}
-					goto l385
-				l386:
-					position, tokenIndex, depth = position386, tokenIndex386, depth386
+					goto l462
+				l463:
+					position, tokenIndex, depth = position463, tokenIndex463, depth463
 				}
 				if !_rules[rulekeytype]() {
-					goto l377
+					goto l454
 				}
-			l387:
+			l464:
 				{
-					position388, tokenIndex388, depth388 := position, tokenIndex, depth
+					position465, tokenIndex465, depth465 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l388
+						goto l465
 					}
-					goto l387
-				l388:
-					position, tokenIndex, depth = position388, tokenIndex388, depth388
+					goto l464
+				l465:
+					position, tokenIndex, depth = position465, tokenIndex465, depth465
 				}
 				if buffer[position] != rune(']') {
-					goto l377
+					goto l454
 				}
 				position++
 				if !_rules[ruletype]() {
-					goto l377
+					goto l454
 				}
-				if !_rules[ruleAction20]() {
-					goto l377
+				if !_rules[ruleAction25]() {
+					goto l454
 				}
 				depth--
-				add(rulemap, position378)
+				add(rulemap, position455)
 			}
 			return true
-		l377:
-			position, tokenIndex, depth = position377, tokenIndex377, depth377
+		l454:
+			position, tokenIndex, depth = position454, tokenIndex454, depth454
 			return false
 		},
-		/* 39 chan <- <(('c' / 'C') ('h' / 'H') ('a' / 'A') ('n' / 'N') isp+ type Action21)> */
+		/* 45 chan <- <(('c' / 'C') ('h' / 'H') ('a' / 'A') ('n' / 'N') isp+ type Action26)> */
 		func() bool {
-			position389, tokenIndex389, depth389 := position, tokenIndex, depth
+			position466, tokenIndex466, depth466 := position, tokenIndex, depth
 			{
-				position390 := position
+				position467 := position
 				depth++
 				{
-					position391, tokenIndex391, depth391 := position, tokenIndex, depth
+					position468, tokenIndex468, depth468 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l392
+						goto l469
 					}
 					position++
-					goto l391
-				l392:
-					position, tokenIndex, depth = position391, tokenIndex391, depth391
+					goto l468
+				l469:
+					position, tokenIndex, depth = position468, tokenIndex468, depth468
 					if buffer[position] != rune('C') {
-						goto l389
+						goto l466
 					}
 					position++
 				}
-			l391:
+			l468:
 				{
-					position393, tokenIndex393, depth393 := position, tokenIndex, depth
+					position470, tokenIndex470, depth470 := position, tokenIndex, depth
 					if buffer[position] != rune('h') {
-						goto l394
+						goto l471
 					}
 					position++
-					goto l393
-				l394:
-					position, tokenIndex, depth = position393, tokenIndex393, depth393
+					goto l470
+				l471:
+					position, tokenIndex, depth = position470, tokenIndex470, depth470
 					if buffer[position] != rune('H') {
-						goto l389
+						goto l466
 					}
 					position++
 				}
-			l393:
+			l470:
 				{
-					position395, tokenIndex395, depth395 := position, tokenIndex, depth
+					position472, tokenIndex472, depth472 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l396
+						goto l473
 					}
 					position++
-					goto l395
-				l396:
-					position, tokenIndex, depth = position395, tokenIndex395, depth395
+					goto l472
+				l473:
+					position, tokenIndex, depth = position472, tokenIndex472, depth472
 					if buffer[position] != rune('A') {
-						goto l389
+						goto l466
 					}
 					position++
 				}
-			l395:
+			l472:
 				{
-					position397, tokenIndex397, depth397 := position, tokenIndex, depth
+					position474, tokenIndex474, depth474 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l398
+						goto l475
 					}
 					position++
-					goto l397
-				l398:
-					position, tokenIndex, depth = position397, tokenIndex397, depth397
+					goto l474
+				l475:
+					position, tokenIndex, depth = position474, tokenIndex474, depth474
 					if buffer[position] != rune('N') {
-						goto l389
+						goto l466
 					}
 					position++
 				}
-			l397:
+			l474:
 				if !_rules[ruleisp]() {
-					goto l389
+					goto l466
 				}
-			l399:
+			l476:
 				{
-					position400, tokenIndex400, depth400 := position, tokenIndex, depth
+					position477, tokenIndex477, depth477 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l400
+						goto l477
 					}
-					goto l399
-				l400:
-					position, tokenIndex, depth = position400, tokenIndex400, depth400
+					goto l476
+				l477:
+					position, tokenIndex, depth = position477, tokenIndex477, depth477
 				}
 				if !_rules[ruletype]() {
-					goto l389
+					goto l466
 				}
-				if !_rules[ruleAction21]() {
-					goto l389
+				if !_rules[ruleAction26]() {
+					goto l466
 				}
 				depth--
-				add(rulechan, position390)
+				add(rulechan, position467)
 			}
 			return true
-		l389:
-			position, tokenIndex, depth = position389, tokenIndex389, depth389
+		l466:
+			position, tokenIndex, depth = position466, tokenIndex466, depth466
 			return false
 		},
-		/* 40 func <- <(('f' / 'F') ('u' / 'U') ('n' / 'N') ('c' / 'C') isp* '(' isp* (param isp* (',' isp* param)*)? ')' isp* type? Action22)> */
+		/* 46 func <- <(('f' / 'F') ('u' / 'U') ('n' / 'N') ('c' / 'C') isp* '(' isp* (param isp* (',' isp* param)*)? ')' isp* type? Action27)> */
 		func() bool {
-			position401, tokenIndex401, depth401 := position, tokenIndex, depth
+			position478, tokenIndex478, depth478 := position, tokenIndex, depth
 			{
-				position402 := position
+				position479 := position
 				depth++
 				{
-					position403, tokenIndex403, depth403 := position, tokenIndex, depth
+					position480, tokenIndex480, depth480 := position, tokenIndex, depth
 					if buffer[position] != rune('f') {
-						goto l404
+						goto l481
 					}
 					position++
-					goto l403
-				l404:
-					position, tokenIndex, depth = position403, tokenIndex403, depth403
+					goto l480
+				l481:
+					position, tokenIndex, depth = position480, tokenIndex480, depth480
 					if buffer[position] != rune('F') {
-						goto l401
+						goto l478
 					}
 					position++
 				}
-			l403:
+			l480:
 				{
-					position405, tokenIndex405, depth405 := position, tokenIndex, depth
+					position482, tokenIndex482, depth482 := position, tokenIndex, depth
 					if buffer[position] != rune('u') {
-						goto l406
+						goto l483
 					}
 					position++
-					goto l405
-				l406:
-					position, tokenIndex, depth = position405, tokenIndex405, depth405
+					goto l482
+				l483:
+					position, tokenIndex, depth = position482, tokenIndex482, depth482
 					if buffer[position] != rune('U') {
-						goto l401
+						goto l478
 					}
 					position++
 				}
-			l405:
+			l482:
 				{
-					position407, tokenIndex407, depth407 := position, tokenIndex, depth
+					position484, tokenIndex484, depth484 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l408
+						goto l485
 					}
 					position++
-					goto l407
-				l408:
-					position, tokenIndex, depth = position407, tokenIndex407, depth407
+					goto l484
+				l485:
+					position, tokenIndex, depth = position484, tokenIndex484, depth484
 					if buffer[position] != rune('N') {
-						goto l401
+						goto l478
 					}
 					position++
 				}
-			l407:
+			l484:
 				{
-					position409, tokenIndex409, depth409 := position, tokenIndex, depth
+					position486, tokenIndex486, depth486 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l410
+						goto l487
 					}
 					position++
-					goto l409
-				l410:
-					position, tokenIndex, depth = position409, tokenIndex409, depth409
+					goto l486
+				l487:
+					position, tokenIndex, depth = position486, tokenIndex486, depth486
 					if buffer[position] != rune('C') {
-						goto l401
+						goto l478
 					}
 					position++
 				}
-			l409:
-			l411:
+			l486:
+			l488:
 				{
-					position412, tokenIndex412, depth412 := position, tokenIndex, depth
+					position489, tokenIndex489, depth489 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l412
+						goto l489
 					}
-					goto l411
-				l412:
-					position, tokenIndex, depth = position412, tokenIndex412, depth412
+					goto l488
+				l489:
+					position, tokenIndex, depth = position489, tokenIndex489, depth489
 				}
 				if buffer[position] != rune('(') {
-					goto l401
+					goto l478
 				}
 				position++
-			l413:
+			l490:
 				{
-					position414, tokenIndex414, depth414 := position, tokenIndex, depth
+					position491, tokenIndex491, depth491 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l414
+						goto l491
 					}
-					goto l413
-				l414:
-					position, tokenIndex, depth = position414, tokenIndex414, depth414
+					goto l490
+				l491:
+					position, tokenIndex, depth = position491, tokenIndex491, depth491
 				}
 				{
-					position415, tokenIndex415, depth415 := position, tokenIndex, depth
+					position492, tokenIndex492, depth492 := position, tokenIndex, depth
 					if !_rules[ruleparam]() {
-						goto l415
+						goto l492
 					}
-				l417:
+				l494:
 					{
-						position418, tokenIndex418, depth418 := position, tokenIndex, depth
+						position495, tokenIndex495, depth495 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l418
+							goto l495
 						}
-						goto l417
-					l418:
-						position, tokenIndex, depth = position418, tokenIndex418, depth418
+						goto l494
+					l495:
+						position, tokenIndex, depth = position495, tokenIndex495, depth495
 					}
-				l419:
+				l496:
 					{
-						position420, tokenIndex420, depth420 := position, tokenIndex, depth
+						position497, tokenIndex497, depth497 := position, tokenIndex, depth
 						if buffer[position] != rune(',') {
-							goto l420
+							goto l497
 						}
 						position++
-					l421:
+					l498:
 						{
-							position422, tokenIndex422, depth422 := position, tokenIndex, depth
+							position499, tokenIndex499, depth499 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l422
+								goto l499
 							}
-							goto l421
-						l422:
-							position, tokenIndex, depth = position422, tokenIndex422, depth422
+							goto l498
+						l499:
+							position, tokenIndex, depth = position499, tokenIndex499, depth499
 						}
 						if !_rules[ruleparam]() {
-							goto l420
+							goto l497
 						}
-						goto l419
-					l420:
-						position, tokenIndex, depth = position420, tokenIndex420, depth420
+						goto l496
+					l497:
+						position, tokenIndex, depth = position497, tokenIndex497, depth497
 					}
-					goto l416
-				l415:
-					position, tokenIndex, depth = position415, tokenIndex415, depth415
+					goto l493
+				l492:
+					position, tokenIndex, depth = position492, tokenIndex492, depth492
 				}
-			l416:
+			l493:
 				if buffer[position] != rune(')') {
-					goto l401
+					goto l478
 				}
 				position++
-			l423:
+			l500:
 				{
-					position424, tokenIndex424, depth424 := position, tokenIndex, depth
+					position501, tokenIndex501, depth501 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l424
+						goto l501
 					}
-					goto l423
-				l424:
-					position, tokenIndex, depth = position424, tokenIndex424, depth424
+					goto l500
+				l501:
+					position, tokenIndex, depth = position501, tokenIndex501, depth501
 				}
 				{
-					position425, tokenIndex425, depth425 := position, tokenIndex, depth
+					position502, tokenIndex502, depth502 := position, tokenIndex, depth
 					if !_rules[ruletype]() {
-						goto l425
+						goto l502
 					}
-					goto l426
-				l425:
-					position, tokenIndex, depth = position425, tokenIndex425, depth425
+					goto l503
+				l502:
+					position, tokenIndex, depth = position502, tokenIndex502, depth502
 				}
-			l426:
-				if !_rules[ruleAction22]() {
-					goto l401
+			l503:
+				if !_rules[ruleAction27]() {
+					goto l478
 				}
 				depth--
-				add(rulefunc, position402)
+				add(rulefunc, position479)
 			}
 			return true
-		l401:
-			position, tokenIndex, depth = position401, tokenIndex401, depth401
+		l478:
+			position, tokenIndex, depth = position478, tokenIndex478, depth478
 			return false
 		},
-		/* 41 keytype <- <(type Action23)> */
+		/* 47 keytype <- <(type Action28)> */
 		func() bool {
-			position427, tokenIndex427, depth427 := position, tokenIndex, depth
+			position504, tokenIndex504, depth504 := position, tokenIndex, depth
 			{
-				position428 := position
+				position505 := position
 				depth++
 				if !_rules[ruletype]() {
-					goto l427
+					goto l504
 				}
-				if !_rules[ruleAction23]() {
-					goto l427
+				if !_rules[ruleAction28]() {
+					goto l504
 				}
 				depth--
-				add(rulekeytype, position428)
+				add(rulekeytype, position505)
 			}
 			return true
-		l427:
-			position, tokenIndex, depth = position427, tokenIndex427, depth427
+		l504:
+			position, tokenIndex, depth = position504, tokenIndex504, depth504
 			return false
 		},
-		/* 42 pointer <- <('*' type Action24)> */
+		/* 48 pointer <- <('*' type Action29)> */
 		func() bool {
-			position429, tokenIndex429, depth429 := position, tokenIndex, depth
+			position506, tokenIndex506, depth506 := position, tokenIndex, depth
 			{
-				position430 := position
+				position507 := position
 				depth++
 				if buffer[position] != rune('*') {
-					goto l429
+					goto l506
 				}
 				position++
 				if !_rules[ruletype]() {
-					goto l429
+					goto l506
 				}
-				if !_rules[ruleAction24]() {
-					goto l429
+				if !_rules[ruleAction29]() {
+					goto l506
 				}
 				depth--
-				add(rulepointer, position430)
+				add(rulepointer, position507)
 			}
 			return true
-		l429:
-			position, tokenIndex, depth = position429, tokenIndex429, depth429
+		l506:
+			position, tokenIndex, depth = position506, tokenIndex506, depth506
 			return false
 		},
-		/* 43 captures <- <(isp* capture isp* (',' isp* capture isp*)* !.)> */
+		/* 49 captures <- <(isp* capture isp* (',' isp* capture isp*)* !.)> */
 		func() bool {
-			position431, tokenIndex431, depth431 := position, tokenIndex, depth
+			position508, tokenIndex508, depth508 := position, tokenIndex, depth
 			{
-				position432 := position
+				position509 := position
 				depth++
-			l433:
+			l510:
 				{
-					position434, tokenIndex434, depth434 := position, tokenIndex, depth
+					position511, tokenIndex511, depth511 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l434
+						goto l511
 					}
-					goto l433
-				l434:
-					position, tokenIndex, depth = position434, tokenIndex434, depth434
+					goto l510
+				l511:
+					position, tokenIndex, depth = position511, tokenIndex511, depth511
 				}
 				if !_rules[rulecapture]() {
-					goto l431
+					goto l508
 				}
-			l435:
+			l512:
 				{
-					position436, tokenIndex436, depth436 := position, tokenIndex, depth
+					position513, tokenIndex513, depth513 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l436
+						goto l513
 					}
-					goto l435
-				l436:
-					position, tokenIndex, depth = position436, tokenIndex436, depth436
+					goto l512
+				l513:
+					position, tokenIndex, depth = position513, tokenIndex513, depth513
 				}
-			l437:
+			l514:
 				{
-					position438, tokenIndex438, depth438 := position, tokenIndex, depth
+					position515, tokenIndex515, depth515 := position, tokenIndex, depth
 					if buffer[position] != rune(',') {
-						goto l438
+						goto l515
 					}
 					position++
-				l439:
+				l516:
 					{
-						position440, tokenIndex440, depth440 := position, tokenIndex, depth
+						position517, tokenIndex517, depth517 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l440
+							goto l517
 						}
-						goto l439
-					l440:
-						position, tokenIndex, depth = position440, tokenIndex440, depth440
+						goto l516
+					l517:
+						position, tokenIndex, depth = position517, tokenIndex517, depth517
 					}
 					if !_rules[rulecapture]() {
-						goto l438
+						goto l515
 					}
-				l441:
+				l518:
 					{
-						position442, tokenIndex442, depth442 := position, tokenIndex, depth
+						position519, tokenIndex519, depth519 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l442
+							goto l519
 						}
-						goto l441
-					l442:
-						position, tokenIndex, depth = position442, tokenIndex442, depth442
+						goto l518
+					l519:
+						position, tokenIndex, depth = position519, tokenIndex519, depth519
 					}
-					goto l437
-				l438:
-					position, tokenIndex, depth = position438, tokenIndex438, depth438
+					goto l514
+				l515:
+					position, tokenIndex, depth = position515, tokenIndex515, depth515
 				}
 				{
-					position443, tokenIndex443, depth443 := position, tokenIndex, depth
+					position520, tokenIndex520, depth520 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l443
+						goto l520
 					}
-					goto l431
-				l443:
-					position, tokenIndex, depth = position443, tokenIndex443, depth443
+					goto l508
+				l520:
+					position, tokenIndex, depth = position520, tokenIndex520, depth520
 				}
 				depth--
-				add(rulecaptures, position432)
+				add(rulecaptures, position509)
 			}
 			return true
-		l431:
-			position, tokenIndex, depth = position431, tokenIndex431, depth431
+		l508:
+			position, tokenIndex, depth = position508, tokenIndex508, depth508
 			return false
 		},
-		/* 44 capture <- <(eventid isp* ':' handlername isp* mappings isp* tags Action25)> */
+		/* 50 capture <- <(eventid isp* ':' handlername isp* mappings isp* tags Action30)> */
 		func() bool {
-			position444, tokenIndex444, depth444 := position, tokenIndex, depth
+			position521, tokenIndex521, depth521 := position, tokenIndex, depth
 			{
-				position445 := position
+				position522 := position
 				depth++
 				if !_rules[ruleeventid]() {
-					goto l444
+					goto l521
 				}
-			l446:
+			l523:
 				{
-					position447, tokenIndex447, depth447 := position, tokenIndex, depth
+					position524, tokenIndex524, depth524 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l447
+						goto l524
 					}
-					goto l446
-				l447:
-					position, tokenIndex, depth = position447, tokenIndex447, depth447
+					goto l523
+				l524:
+					position, tokenIndex, depth = position524, tokenIndex524, depth524
 				}
 				if buffer[position] != rune(':') {
-					goto l444
+					goto l521
 				}
 				position++
 				if !_rules[rulehandlername]() {
-					goto l444
+					goto l521
 				}
-			l448:
+			l525:
 				{
-					position449, tokenIndex449, depth449 := position, tokenIndex, depth
+					position526, tokenIndex526, depth526 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l449
+						goto l526
 					}
-					goto l448
-				l449:
-					position, tokenIndex, depth = position449, tokenIndex449, depth449
+					goto l525
+				l526:
+					position, tokenIndex, depth = position526, tokenIndex526, depth526
 				}
 				if !_rules[rulemappings]() {
-					goto l444
+					goto l521
 				}
-			l450:
+			l527:
 				{
-					position451, tokenIndex451, depth451 := position, tokenIndex, depth
+					position528, tokenIndex528, depth528 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l451
+						goto l528
 					}
-					goto l450
-				l451:
-					position, tokenIndex, depth = position451, tokenIndex451, depth451
+					goto l527
+				l528:
+					position, tokenIndex, depth = position528, tokenIndex528, depth528
 				}
 				if !_rules[ruletags]() {
-					goto l444
+					goto l521
 				}
-				if !_rules[ruleAction25]() {
-					goto l444
+				if !_rules[ruleAction30]() {
+					goto l521
 				}
 				depth--
-				add(rulecapture, position445)
+				add(rulecapture, position522)
 			}
 			return true
-		l444:
-			position, tokenIndex, depth = position444, tokenIndex444, depth444
+		l521:
+			position, tokenIndex, depth = position521, tokenIndex521, depth521
 			return false
 		},
-		/* 45 handlername <- <(<identifier> Action26)> */
+		/* 51 handlername <- <(<identifier> Action31)> */
 		func() bool {
-			position452, tokenIndex452, depth452 := position, tokenIndex, depth
+			position529, tokenIndex529, depth529 := position, tokenIndex, depth
 			{
-				position453 := position
+				position530 := position
 				depth++
 				{
-					position454 := position
+					position531 := position
 					depth++
 					if !_rules[ruleidentifier]() {
-						goto l452
+						goto l529
 					}
 					depth--
-					add(rulePegText, position454)
+					add(rulePegText, position531)
 				}
-				if !_rules[ruleAction26]() {
-					goto l452
+				if !_rules[ruleAction31]() {
+					goto l529
 				}
 				depth--
-				add(rulehandlername, position453)
+				add(rulehandlername, position530)
 			}
 			return true
-		l452:
-			position, tokenIndex, depth = position452, tokenIndex452, depth452
+		l529:
+			position, tokenIndex, depth = position529, tokenIndex529, depth529
 			return false
 		},
-		/* 46 eventid <- <(<[a-z]+> Action27)> */
+		/* 52 eventid <- <(<[a-z]+> Action32)> */
 		func() bool {
-			position455, tokenIndex455, depth455 := position, tokenIndex, depth
+			position532, tokenIndex532, depth532 := position, tokenIndex, depth
 			{
-				position456 := position
+				position533 := position
 				depth++
 				{
-					position457 := position
+					position534 := position
 					depth++
 					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l455
+						goto l532
 					}
 					position++
-				l458:
+				l535:
 					{
-						position459, tokenIndex459, depth459 := position, tokenIndex, depth
+						position536, tokenIndex536, depth536 := position, tokenIndex, depth
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l459
+							goto l536
 						}
 						position++
-						goto l458
-					l459:
-						position, tokenIndex, depth = position459, tokenIndex459, depth459
+						goto l535
+					l536:
+						position, tokenIndex, depth = position536, tokenIndex536, depth536
 					}
 					depth--
-					add(rulePegText, position457)
+					add(rulePegText, position534)
 				}
-				if !_rules[ruleAction27]() {
-					goto l455
+				if !_rules[ruleAction32]() {
+					goto l532
 				}
 				depth--
-				add(ruleeventid, position456)
+				add(ruleeventid, position533)
 			}
 			return true
-		l455:
-			position, tokenIndex, depth = position455, tokenIndex455, depth455
+		l532:
+			position, tokenIndex, depth = position532, tokenIndex532, depth532
 			return false
 		},
-		/* 47 mappings <- <(mappingstart (isp* mapping isp* (',' isp* mapping isp*)*)? ')')?> */
+		/* 53 mappings <- <(mappingstart (isp* mapping isp* (',' isp* mapping isp*)*)? ')')?> */
 		func() bool {
 			{
-				position461 := position
+				position538 := position
 				depth++
 				{
-					position462, tokenIndex462, depth462 := position, tokenIndex, depth
+					position539, tokenIndex539, depth539 := position, tokenIndex, depth
 					if !_rules[rulemappingstart]() {
-						goto l462
+						goto l539
 					}
 					{
-						position464, tokenIndex464, depth464 := position, tokenIndex, depth
-					l466:
+						position541, tokenIndex541, depth541 := position, tokenIndex, depth
+					l543:
 						{
-							position467, tokenIndex467, depth467 := position, tokenIndex, depth
+							position544, tokenIndex544, depth544 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l467
+								goto l544
 							}
-							goto l466
-						l467:
-							position, tokenIndex, depth = position467, tokenIndex467, depth467
+							goto l543
+						l544:
+							position, tokenIndex, depth = position544, tokenIndex544, depth544
 						}
 						if !_rules[rulemapping]() {
-							goto l464
+							goto l541
 						}
-					l468:
+					l545:
 						{
-							position469, tokenIndex469, depth469 := position, tokenIndex, depth
+							position546, tokenIndex546, depth546 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l469
+								goto l546
 							}
-							goto l468
-						l469:
-							position, tokenIndex, depth = position469, tokenIndex469, depth469
+							goto l545
+						l546:
+							position, tokenIndex, depth = position546, tokenIndex546, depth546
 						}
-					l470:
+					l547:
 						{
-							position471, tokenIndex471, depth471 := position, tokenIndex, depth
+							position548, tokenIndex548, depth548 := position, tokenIndex, depth
 							if buffer[position] != rune(',') {
-								goto l471
+								goto l548
 							}
 							position++
-						l472:
+						l549:
 							{
-								position473, tokenIndex473, depth473 := position, tokenIndex, depth
+								position550, tokenIndex550, depth550 := position, tokenIndex, depth
 								if !_rules[ruleisp]() {
-									goto l473
+									goto l550
 								}
-								goto l472
-							l473:
-								position, tokenIndex, depth = position473, tokenIndex473, depth473
+								goto l549
+							l550:
+								position, tokenIndex, depth = position550, tokenIndex550, depth550
 							}
 							if !_rules[rulemapping]() {
-								goto l471
+								goto l548
 							}
-						l474:
+						l551:
 							{
-								position475, tokenIndex475, depth475 := position, tokenIndex, depth
+								position552, tokenIndex552, depth552 := position, tokenIndex, depth
 								if !_rules[ruleisp]() {
-									goto l475
+									goto l552
 								}
-								goto l474
-							l475:
-								position, tokenIndex, depth = position475, tokenIndex475, depth475
+								goto l551
+							l552:
+								position, tokenIndex, depth = position552, tokenIndex552, depth552
 							}
-							goto l470
-						l471:
-							position, tokenIndex, depth = position471, tokenIndex471, depth471
+							goto l547
+						l548:
+							position, tokenIndex, depth = position548, tokenIndex548, depth548
 						}
-						goto l465
-					l464:
-						position, tokenIndex, depth = position464, tokenIndex464, depth464
+						goto l542
+					l541:
+						position, tokenIndex, depth = position541, tokenIndex541, depth541
 					}
-				l465:
+				l542:
 					if buffer[position] != rune(')') {
-						goto l462
+						goto l539
 					}
 					position++
-					goto l463
-				l462:
-					position, tokenIndex, depth = position462, tokenIndex462, depth462
+					goto l540
+				l539:
+					position, tokenIndex, depth = position539, tokenIndex539, depth539
 				}
-			l463:
+			l540:
 				depth--
-				add(rulemappings, position461)
+				add(rulemappings, position538)
 			}
 			return true
 		},
-		/* 48 mappingstart <- <('(' Action28)> */
+		/* 54 mappingstart <- <('(' Action33)> */
 		func() bool {
-			position476, tokenIndex476, depth476 := position, tokenIndex, depth
+			position553, tokenIndex553, depth553 := position, tokenIndex, depth
 			{
-				position477 := position
+				position554 := position
 				depth++
 				if buffer[position] != rune('(') {
-					goto l476
+					goto l553
 				}
 				position++
-				if !_rules[ruleAction28]() {
-					goto l476
+				if !_rules[ruleAction33]() {
+					goto l553
 				}
 				depth--
-				add(rulemappingstart, position477)
+				add(rulemappingstart, position554)
 			}
 			return true
-		l476:
-			position, tokenIndex, depth = position476, tokenIndex476, depth476
+		l553:
+			position, tokenIndex, depth = position553, tokenIndex553, depth553
 			return false
 		},
-		/* 49 mapping <- <((mappingname isp* '=' isp*)? bound Action29)> */
+		/* 55 mapping <- <((mappingname isp* '=' isp*)? bound Action34)> */
 		func() bool {
-			position478, tokenIndex478, depth478 := position, tokenIndex, depth
+			position555, tokenIndex555, depth555 := position, tokenIndex, depth
 			{
-				position479 := position
+				position556 := position
 				depth++
 				{
-					position480, tokenIndex480, depth480 := position, tokenIndex, depth
+					position557, tokenIndex557, depth557 := position, tokenIndex, depth
 					if !_rules[rulemappingname]() {
-						goto l480
+						goto l557
 					}
-				l482:
+				l559:
 					{
-						position483, tokenIndex483, depth483 := position, tokenIndex, depth
+						position560, tokenIndex560, depth560 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l483
+							goto l560
 						}
-						goto l482
-					l483:
-						position, tokenIndex, depth = position483, tokenIndex483, depth483
+						goto l559
+					l560:
+						position, tokenIndex, depth = position560, tokenIndex560, depth560
 					}
 					if buffer[position] != rune('=') {
-						goto l480
+						goto l557
 					}
 					position++
-				l484:
+				l561:
 					{
-						position485, tokenIndex485, depth485 := position, tokenIndex, depth
+						position562, tokenIndex562, depth562 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l485
+							goto l562
 						}
-						goto l484
-					l485:
-						position, tokenIndex, depth = position485, tokenIndex485, depth485
+						goto l561
+					l562:
+						position, tokenIndex, depth = position562, tokenIndex562, depth562
 					}
-					goto l481
-				l480:
-					position, tokenIndex, depth = position480, tokenIndex480, depth480
+					goto l558
+				l557:
+					position, tokenIndex, depth = position557, tokenIndex557, depth557
 				}
-			l481:
+			l558:
 				if !_rules[rulebound]() {
-					goto l478
+					goto l555
 				}
-				if !_rules[ruleAction29]() {
-					goto l478
+				if !_rules[ruleAction34]() {
+					goto l555
 				}
 				depth--
-				add(rulemapping, position479)
+				add(rulemapping, position556)
 			}
 			return true
-		l478:
-			position, tokenIndex, depth = position478, tokenIndex478, depth478
+		l555:
+			position, tokenIndex, depth = position555, tokenIndex555, depth555
 			return false
 		},
-		/* 50 mappingname <- <(<identifier> Action30)> */
+		/* 56 mappingname <- <(<identifier> Action35)> */
 		func() bool {
-			position486, tokenIndex486, depth486 := position, tokenIndex, depth
+			position563, tokenIndex563, depth563 := position, tokenIndex, depth
 			{
-				position487 := position
+				position564 := position
 				depth++
 				{
-					position488 := position
+					position565 := position
 					depth++
 					if !_rules[ruleidentifier]() {
-						goto l486
+						goto l563
 					}
 					depth--
-					add(rulePegText, position488)
+					add(rulePegText, position565)
 				}
-				if !_rules[ruleAction30]() {
-					goto l486
+				if !_rules[ruleAction35]() {
+					goto l563
 				}
 				depth--
-				add(rulemappingname, position487)
+				add(rulemappingname, position564)
 			}
 			return true
-		l486:
-			position, tokenIndex, depth = position486, tokenIndex486, depth486
+		l563:
+			position, tokenIndex, depth = position563, tokenIndex563, depth563
 			return false
 		},
-		/* 51 tags <- <('{' isp* tag isp* (',' isp* tag isp*)* '}')?> */
+		/* 57 tags <- <('{' isp* tag isp* (',' isp* tag isp*)* '}')?> */
 		func() bool {
 			{
-				position490 := position
+				position567 := position
 				depth++
 				{
-					position491, tokenIndex491, depth491 := position, tokenIndex, depth
+					position568, tokenIndex568, depth568 := position, tokenIndex, depth
 					if buffer[position] != rune('{') {
-						goto l491
+						goto l568
 					}
 					position++
-				l493:
+				l570:
 					{
-						position494, tokenIndex494, depth494 := position, tokenIndex, depth
+						position571, tokenIndex571, depth571 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l494
+							goto l571
 						}
-						goto l493
-					l494:
-						position, tokenIndex, depth = position494, tokenIndex494, depth494
+						goto l570
+					l571:
+						position, tokenIndex, depth = position571, tokenIndex571, depth571
 					}
 					if !_rules[ruletag]() {
-						goto l491
+						goto l568
 					}
-				l495:
+				l572:
 					{
-						position496, tokenIndex496, depth496 := position, tokenIndex, depth
+						position573, tokenIndex573, depth573 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l496
+							goto l573
 						}
-						goto l495
-					l496:
-						position, tokenIndex, depth = position496, tokenIndex496, depth496
+						goto l572
+					l573:
+						position, tokenIndex, depth = position573, tokenIndex573, depth573
 					}
-				l497:
+				l574:
 					{
-						position498, tokenIndex498, depth498 := position, tokenIndex, depth
+						position575, tokenIndex575, depth575 := position, tokenIndex, depth
 						if buffer[position] != rune(',') {
-							goto l498
+							goto l575
 						}
 						position++
-					l499:
+					l576:
 						{
-							position500, tokenIndex500, depth500 := position, tokenIndex, depth
+							position577, tokenIndex577, depth577 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l500
+								goto l577
 							}
-							goto l499
-						l500:
-							position, tokenIndex, depth = position500, tokenIndex500, depth500
+							goto l576
+						l577:
+							position, tokenIndex, depth = position577, tokenIndex577, depth577
 						}
 						if !_rules[ruletag]() {
-							goto l498
+							goto l575
 						}
-					l501:
+					l578:
 						{
-							position502, tokenIndex502, depth502 := position, tokenIndex, depth
+							position579, tokenIndex579, depth579 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l502
+								goto l579
 							}
-							goto l501
-						l502:
-							position, tokenIndex, depth = position502, tokenIndex502, depth502
+							goto l578
+						l579:
+							position, tokenIndex, depth = position579, tokenIndex579, depth579
 						}
-						goto l497
-					l498:
-						position, tokenIndex, depth = position498, tokenIndex498, depth498
+						goto l574
+					l575:
+						position, tokenIndex, depth = position575, tokenIndex575, depth575
 					}
 					if buffer[position] != rune('}') {
-						goto l491
+						goto l568
 					}
 					position++
-					goto l492
-				l491:
-					position, tokenIndex, depth = position491, tokenIndex491, depth491
+					goto l569
+				l568:
+					position, tokenIndex, depth = position568, tokenIndex568, depth568
 				}
-			l492:
+			l569:
 				depth--
-				add(ruletags, position490)
+				add(ruletags, position567)
 			}
 			return true
 		},
-		/* 52 tag <- <(tagname ('(' (isp* tagarg isp* (',' isp* tagarg isp*)*)? ')')? Action31)> */
+		/* 58 tag <- <(tagname ('(' (isp* tagarg isp* (',' isp* tagarg isp*)*)? ')')? Action36)> */
 		func() bool {
-			position503, tokenIndex503, depth503 := position, tokenIndex, depth
+			position580, tokenIndex580, depth580 := position, tokenIndex, depth
 			{
-				position504 := position
+				position581 := position
 				depth++
 				if !_rules[ruletagname]() {
-					goto l503
+					goto l580
 				}
 				{
-					position505, tokenIndex505, depth505 := position, tokenIndex, depth
+					position582, tokenIndex582, depth582 := position, tokenIndex, depth
 					if buffer[position] != rune('(') {
-						goto l505
+						goto l582
 					}
 					position++
 					{
-						position507, tokenIndex507, depth507 := position, tokenIndex, depth
-					l509:
+						position584, tokenIndex584, depth584 := position, tokenIndex, depth
+					l586:
 						{
-							position510, tokenIndex510, depth510 := position, tokenIndex, depth
+							position587, tokenIndex587, depth587 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l510
+								goto l587
 							}
-							goto l509
-						l510:
-							position, tokenIndex, depth = position510, tokenIndex510, depth510
+							goto l586
+						l587:
+							position, tokenIndex, depth = position587, tokenIndex587, depth587
 						}
 						if !_rules[ruletagarg]() {
-							goto l507
+							goto l584
 						}
-					l511:
+					l588:
 						{
-							position512, tokenIndex512, depth512 := position, tokenIndex, depth
+							position589, tokenIndex589, depth589 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l512
+								goto l589
 							}
-							goto l511
-						l512:
-							position, tokenIndex, depth = position512, tokenIndex512, depth512
+							goto l588
+						l589:
+							position, tokenIndex, depth = position589, tokenIndex589, depth589
 						}
-					l513:
+					l590:
 						{
-							position514, tokenIndex514, depth514 := position, tokenIndex, depth
+							position591, tokenIndex591, depth591 := position, tokenIndex, depth
 							if buffer[position] != rune(',') {
-								goto l514
+								goto l591
 							}
 							position++
-						l515:
+						l592:
 							{
-								position516, tokenIndex516, depth516 := position, tokenIndex, depth
+								position593, tokenIndex593, depth593 := position, tokenIndex, depth
 								if !_rules[ruleisp]() {
-									goto l516
+									goto l593
 								}
-								goto l515
-							l516:
-								position, tokenIndex, depth = position516, tokenIndex516, depth516
+								goto l592
+							l593:
+								position, tokenIndex, depth = position593, tokenIndex593, depth593
 							}
 							if !_rules[ruletagarg]() {
-								goto l514
+								goto l591
 							}
-						l517:
+						l594:
 							{
-								position518, tokenIndex518, depth518 := position, tokenIndex, depth
+								position595, tokenIndex595, depth595 := position, tokenIndex, depth
 								if !_rules[ruleisp]() {
-									goto l518
+									goto l595
 								}
-								goto l517
-							l518:
-								position, tokenIndex, depth = position518, tokenIndex518, depth518
+								goto l594
+							l595:
+								position, tokenIndex, depth = position595, tokenIndex595, depth595
 							}
-							goto l513
-						l514:
-							position, tokenIndex, depth = position514, tokenIndex514, depth514
+							goto l590
+						l591:
+							position, tokenIndex, depth = position591, tokenIndex591, depth591
 						}
-						goto l508
-					l507:
-						position, tokenIndex, depth = position507, tokenIndex507, depth507
+						goto l585
+					l584:
+						position, tokenIndex, depth = position584, tokenIndex584, depth584
 					}
-				l508:
+				l585:
 					if buffer[position] != rune(')') {
-						goto l505
+						goto l582
 					}
 					position++
-					goto l506
-				l505:
-					position, tokenIndex, depth = position505, tokenIndex505, depth505
+					goto l583
+				l582:
+					position, tokenIndex, depth = position582, tokenIndex582, depth582
 				}
-			l506:
-				if !_rules[ruleAction31]() {
-					goto l503
+			l583:
+				if !_rules[ruleAction36]() {
+					goto l580
 				}
 				depth--
-				add(ruletag, position504)
+				add(ruletag, position581)
 			}
 			return true
-		l503:
-			position, tokenIndex, depth = position503, tokenIndex503, depth503
+		l580:
+			position, tokenIndex, depth = position580, tokenIndex580, depth580
 			return false
 		},
-		/* 53 tagname <- <(<identifier> Action32)> */
+		/* 59 tagname <- <(<identifier> Action37)> */
 		func() bool {
-			position519, tokenIndex519, depth519 := position, tokenIndex, depth
+			position596, tokenIndex596, depth596 := position, tokenIndex, depth
 			{
-				position520 := position
+				position597 := position
 				depth++
 				{
-					position521 := position
+					position598 := position
 					depth++
 					if !_rules[ruleidentifier]() {
-						goto l519
+						goto l596
 					}
 					depth--
-					add(rulePegText, position521)
+					add(rulePegText, position598)
 				}
-				if !_rules[ruleAction32]() {
-					goto l519
+				if !_rules[ruleAction37]() {
+					goto l596
 				}
 				depth--
-				add(ruletagname, position520)
+				add(ruletagname, position597)
 			}
 			return true
-		l519:
-			position, tokenIndex, depth = position519, tokenIndex519, depth519
+		l596:
+			position, tokenIndex, depth = position596, tokenIndex596, depth596
 			return false
 		},
-		/* 54 tagarg <- <(<identifier> Action33)> */
+		/* 60 tagarg <- <(<identifier> Action38)> */
 		func() bool {
-			position522, tokenIndex522, depth522 := position, tokenIndex, depth
+			position599, tokenIndex599, depth599 := position, tokenIndex, depth
 			{
-				position523 := position
+				position600 := position
 				depth++
 				{
-					position524 := position
+					position601 := position
 					depth++
 					if !_rules[ruleidentifier]() {
-						goto l522
+						goto l599
 					}
 					depth--
-					add(rulePegText, position524)
+					add(rulePegText, position601)
 				}
-				if !_rules[ruleAction33]() {
-					goto l522
+				if !_rules[ruleAction38]() {
+					goto l599
 				}
 				depth--
-				add(ruletagarg, position523)
+				add(ruletagarg, position600)
 			}
 			return true
-		l522:
-			position, tokenIndex, depth = position522, tokenIndex522, depth522
+		l599:
+			position, tokenIndex, depth = position599, tokenIndex599, depth599
 			return false
 		},
-		/* 55 for <- <(isp* forVar isp* (',' isp* forVar isp*)? (':' '=') isp* (('r' / 'R') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('e' / 'E')) isp+ expr isp* !.)> */
+		/* 61 for <- <(isp* forVar isp* (',' isp* forVar isp*)? (':' '=') isp* (('r' / 'R') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('e' / 'E')) isp+ expr isp* !.)> */
 		func() bool {
-			position525, tokenIndex525, depth525 := position, tokenIndex, depth
+			position602, tokenIndex602, depth602 := position, tokenIndex, depth
 			{
-				position526 := position
+				position603 := position
 				depth++
-			l527:
+			l604:
 				{
-					position528, tokenIndex528, depth528 := position, tokenIndex, depth
+					position605, tokenIndex605, depth605 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l528
+						goto l605
 					}
-					goto l527
-				l528:
-					position, tokenIndex, depth = position528, tokenIndex528, depth528
+					goto l604
+				l605:
+					position, tokenIndex, depth = position605, tokenIndex605, depth605
 				}
 				if !_rules[ruleforVar]() {
-					goto l525
+					goto l602
 				}
-			l529:
+			l606:
 				{
-					position530, tokenIndex530, depth530 := position, tokenIndex, depth
+					position607, tokenIndex607, depth607 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l530
+						goto l607
 					}
-					goto l529
-				l530:
-					position, tokenIndex, depth = position530, tokenIndex530, depth530
+					goto l606
+				l607:
+					position, tokenIndex, depth = position607, tokenIndex607, depth607
 				}
 				{
-					position531, tokenIndex531, depth531 := position, tokenIndex, depth
+					position608, tokenIndex608, depth608 := position, tokenIndex, depth
 					if buffer[position] != rune(',') {
-						goto l531
+						goto l608
 					}
 					position++
-				l533:
+				l610:
 					{
-						position534, tokenIndex534, depth534 := position, tokenIndex, depth
+						position611, tokenIndex611, depth611 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l534
+							goto l611
 						}
-						goto l533
-					l534:
-						position, tokenIndex, depth = position534, tokenIndex534, depth534
+						goto l610
+					l611:
+						position, tokenIndex, depth = position611, tokenIndex611, depth611
 					}
 					if !_rules[ruleforVar]() {
-						goto l531
+						goto l608
 					}
-				l535:
+				l612:
 					{
-						position536, tokenIndex536, depth536 := position, tokenIndex, depth
+						position613, tokenIndex613, depth613 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l536
+							goto l613
 						}
-						goto l535
-					l536:
-						position, tokenIndex, depth = position536, tokenIndex536, depth536
+						goto l612
+					l613:
+						position, tokenIndex, depth = position613, tokenIndex613, depth613
 					}
-					goto l532
-				l531:
-					position, tokenIndex, depth = position531, tokenIndex531, depth531
+					goto l609
+				l608:
+					position, tokenIndex, depth = position608, tokenIndex608, depth608
 				}
-			l532:
+			l609:
 				if buffer[position] != rune(':') {
-					goto l525
+					goto l602
 				}
 				position++
 				if buffer[position] != rune('=') {
-					goto l525
+					goto l602
 				}
 				position++
-			l537:
+			l614:
 				{
-					position538, tokenIndex538, depth538 := position, tokenIndex, depth
+					position615, tokenIndex615, depth615 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l538
+						goto l615
 					}
-					goto l537
-				l538:
-					position, tokenIndex, depth = position538, tokenIndex538, depth538
+					goto l614
+				l615:
+					position, tokenIndex, depth = position615, tokenIndex615, depth615
 				}
 				{
-					position539, tokenIndex539, depth539 := position, tokenIndex, depth
+					position616, tokenIndex616, depth616 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l540
+						goto l617
 					}
 					position++
-					goto l539
-				l540:
-					position, tokenIndex, depth = position539, tokenIndex539, depth539
+					goto l616
+				l617:
+					position, tokenIndex, depth = position616, tokenIndex616, depth616
 					if buffer[position] != rune('R') {
-						goto l525
+						goto l602
 					}
 					position++
 				}
-			l539:
+			l616:
 				{
-					position541, tokenIndex541, depth541 := position, tokenIndex, depth
+					position618, tokenIndex618, depth618 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l542
+						goto l619
 					}
 					position++
-					goto l541
-				l542:
-					position, tokenIndex, depth = position541, tokenIndex541, depth541
+					goto l618
+				l619:
+					position, tokenIndex, depth = position618, tokenIndex618, depth618
 					if buffer[position] != rune('A') {
-						goto l525
+						goto l602
 					}
 					position++
 				}
-			l541:
+			l618:
 				{
-					position543, tokenIndex543, depth543 := position, tokenIndex, depth
+					position620, tokenIndex620, depth620 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l544
+						goto l621
 					}
 					position++
-					goto l543
-				l544:
-					position, tokenIndex, depth = position543, tokenIndex543, depth543
+					goto l620
+				l621:
+					position, tokenIndex, depth = position620, tokenIndex620, depth620
 					if buffer[position] != rune('N') {
-						goto l525
+						goto l602
 					}
 					position++
 				}
-			l543:
+			l620:
 				{
-					position545, tokenIndex545, depth545 := position, tokenIndex, depth
+					position622, tokenIndex622, depth622 := position, tokenIndex, depth
 					if buffer[position] != rune('g') {
-						goto l546
+						goto l623
 					}
 					position++
-					goto l545
-				l546:
-					position, tokenIndex, depth = position545, tokenIndex545, depth545
+					goto l622
+				l623:
+					position, tokenIndex, depth = position622, tokenIndex622, depth622
 					if buffer[position] != rune('G') {
-						goto l525
+						goto l602
 					}
 					position++
 				}
-			l545:
+			l622:
 				{
-					position547, tokenIndex547, depth547 := position, tokenIndex, depth
+					position624, tokenIndex624, depth624 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l548
+						goto l625
 					}
 					position++
-					goto l547
-				l548:
-					position, tokenIndex, depth = position547, tokenIndex547, depth547
+					goto l624
+				l625:
+					position, tokenIndex, depth = position624, tokenIndex624, depth624
 					if buffer[position] != rune('E') {
-						goto l525
+						goto l602
 					}
 					position++
 				}
-			l547:
+			l624:
 				if !_rules[ruleisp]() {
-					goto l525
+					goto l602
 				}
-			l549:
+			l626:
 				{
-					position550, tokenIndex550, depth550 := position, tokenIndex, depth
+					position627, tokenIndex627, depth627 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l550
+						goto l627
 					}
-					goto l549
-				l550:
-					position, tokenIndex, depth = position550, tokenIndex550, depth550
+					goto l626
+				l627:
+					position, tokenIndex, depth = position627, tokenIndex627, depth627
 				}
 				if !_rules[ruleexpr]() {
-					goto l525
+					goto l602
 				}
-			l551:
+			l628:
 				{
-					position552, tokenIndex552, depth552 := position, tokenIndex, depth
+					position629, tokenIndex629, depth629 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l552
+						goto l629
 					}
-					goto l551
-				l552:
-					position, tokenIndex, depth = position552, tokenIndex552, depth552
+					goto l628
+				l629:
+					position, tokenIndex, depth = position629, tokenIndex629, depth629
 				}
 				{
-					position553, tokenIndex553, depth553 := position, tokenIndex, depth
+					position630, tokenIndex630, depth630 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l553
+						goto l630
 					}
-					goto l525
-				l553:
-					position, tokenIndex, depth = position553, tokenIndex553, depth553
+					goto l602
+				l630:
+					position, tokenIndex, depth = position630, tokenIndex630, depth630
 				}
 				depth--
-				add(rulefor, position526)
+				add(rulefor, position603)
 			}
 			return true
-		l525:
-			position, tokenIndex, depth = position525, tokenIndex525, depth525
+		l602:
+			position, tokenIndex, depth = position602, tokenIndex602, depth602
 			return false
 		},
-		/* 56 forVar <- <(<identifier> Action34)> */
+		/* 62 forVar <- <(<identifier> Action39)> */
 		func() bool {
-			position554, tokenIndex554, depth554 := position, tokenIndex, depth
+			position631, tokenIndex631, depth631 := position, tokenIndex, depth
 			{
-				position555 := position
+				position632 := position
 				depth++
 				{
-					position556 := position
+					position633 := position
 					depth++
 					if !_rules[ruleidentifier]() {
-						goto l554
+						goto l631
 					}
 					depth--
-					add(rulePegText, position556)
+					add(rulePegText, position633)
 				}
-				if !_rules[ruleAction34]() {
-					goto l554
+				if !_rules[ruleAction39]() {
+					goto l631
 				}
 				depth--
-				add(ruleforVar, position555)
+				add(ruleforVar, position632)
 			}
 			return true
-		l554:
-			position, tokenIndex, depth = position554, tokenIndex554, depth554
+		l631:
+			position, tokenIndex, depth = position631, tokenIndex631, depth631
 			return false
 		},
-		/* 57 handlers <- <(isp* (fsep isp*)* handler isp* ((fsep isp*)+ handler isp*)* (fsep isp*)* !.)> */
+		/* 63 handlers <- <(isp* (fsep isp*)* handler isp* ((fsep isp*)+ handler isp*)* (fsep isp*)* !.)> */
 		func() bool {
-			position557, tokenIndex557, depth557 := position, tokenIndex, depth
+			position634, tokenIndex634, depth634 := position, tokenIndex, depth
 			{
-				position558 := position
+				position635 := position
 				depth++
-			l559:
+			l636:
 				{
-					position560, tokenIndex560, depth560 := position, tokenIndex, depth
+					position637, tokenIndex637, depth637 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l560
+						goto l637
 					}
-					goto l559
-				l560:
-					position, tokenIndex, depth = position560, tokenIndex560, depth560
+					goto l636
+				l637:
+					position, tokenIndex, depth = position637, tokenIndex637, depth637
 				}
-			l561:
+			l638:
 				{
-					position562, tokenIndex562, depth562 := position, tokenIndex, depth
+					position639, tokenIndex639, depth639 := position, tokenIndex, depth
 					if !_rules[rulefsep]() {
-						goto l562
+						goto l639
 					}
-				l563:
+				l640:
 					{
-						position564, tokenIndex564, depth564 := position, tokenIndex, depth
+						position641, tokenIndex641, depth641 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l564
+							goto l641
 						}
-						goto l563
-					l564:
-						position, tokenIndex, depth = position564, tokenIndex564, depth564
+						goto l640
+					l641:
+						position, tokenIndex, depth = position641, tokenIndex641, depth641
 					}
-					goto l561
-				l562:
-					position, tokenIndex, depth = position562, tokenIndex562, depth562
+					goto l638
+				l639:
+					position, tokenIndex, depth = position639, tokenIndex639, depth639
 				}
 				if !_rules[rulehandler]() {
-					goto l557
+					goto l634
 				}
-			l565:
+			l642:
 				{
-					position566, tokenIndex566, depth566 := position, tokenIndex, depth
+					position643, tokenIndex643, depth643 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l566
+						goto l643
 					}
-					goto l565
-				l566:
-					position, tokenIndex, depth = position566, tokenIndex566, depth566
+					goto l642
+				l643:
+					position, tokenIndex, depth = position643, tokenIndex643, depth643
 				}
-			l567:
+			l644:
 				{
-					position568, tokenIndex568, depth568 := position, tokenIndex, depth
+					position645, tokenIndex645, depth645 := position, tokenIndex, depth
 					if !_rules[rulefsep]() {
-						goto l568
+						goto l645
 					}
-				l571:
+				l648:
 					{
-						position572, tokenIndex572, depth572 := position, tokenIndex, depth
+						position649, tokenIndex649, depth649 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l572
+							goto l649
 						}
-						goto l571
-					l572:
-						position, tokenIndex, depth = position572, tokenIndex572, depth572
+						goto l648
+					l649:
+						position, tokenIndex, depth = position649, tokenIndex649, depth649
 					}
-				l569:
+				l646:
 					{
-						position570, tokenIndex570, depth570 := position, tokenIndex, depth
+						position647, tokenIndex647, depth647 := position, tokenIndex, depth
 						if !_rules[rulefsep]() {
-							goto l570
+							goto l647
 						}
-					l573:
+					l650:
 						{
-							position574, tokenIndex574, depth574 := position, tokenIndex, depth
+							position651, tokenIndex651, depth651 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l574
+								goto l651
 							}
-							goto l573
-						l574:
-							position, tokenIndex, depth = position574, tokenIndex574, depth574
+							goto l650
+						l651:
+							position, tokenIndex, depth = position651, tokenIndex651, depth651
 						}
-						goto l569
-					l570:
-						position, tokenIndex, depth = position570, tokenIndex570, depth570
+						goto l646
+					l647:
+						position, tokenIndex, depth = position647, tokenIndex647, depth647
 					}
 					if !_rules[rulehandler]() {
-						goto l568
+						goto l645
 					}
-				l575:
+				l652:
 					{
-						position576, tokenIndex576, depth576 := position, tokenIndex, depth
+						position653, tokenIndex653, depth653 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l576
+							goto l653
 						}
-						goto l575
-					l576:
-						position, tokenIndex, depth = position576, tokenIndex576, depth576
+						goto l652
+					l653:
+						position, tokenIndex, depth = position653, tokenIndex653, depth653
 					}
-					goto l567
-				l568:
-					position, tokenIndex, depth = position568, tokenIndex568, depth568
+					goto l644
+				l645:
+					position, tokenIndex, depth = position645, tokenIndex645, depth645
 				}
-			l577:
+			l654:
 				{
-					position578, tokenIndex578, depth578 := position, tokenIndex, depth
+					position655, tokenIndex655, depth655 := position, tokenIndex, depth
 					if !_rules[rulefsep]() {
-						goto l578
+						goto l655
 					}
-				l579:
+				l656:
 					{
-						position580, tokenIndex580, depth580 := position, tokenIndex, depth
+						position657, tokenIndex657, depth657 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l580
+							goto l657
 						}
-						goto l579
-					l580:
-						position, tokenIndex, depth = position580, tokenIndex580, depth580
+						goto l656
+					l657:
+						position, tokenIndex, depth = position657, tokenIndex657, depth657
 					}
-					goto l577
-				l578:
-					position, tokenIndex, depth = position578, tokenIndex578, depth578
+					goto l654
+				l655:
+					position, tokenIndex, depth = position655, tokenIndex655, depth655
 				}
 				{
-					position581, tokenIndex581, depth581 := position, tokenIndex, depth
+					position658, tokenIndex658, depth658 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l581
+						goto l658
 					}
-					goto l557
-				l581:
-					position, tokenIndex, depth = position581, tokenIndex581, depth581
+					goto l634
+				l658:
+					position, tokenIndex, depth = position658, tokenIndex658, depth658
 				}
 				depth--
-				add(rulehandlers, position558)
+				add(rulehandlers, position635)
 			}
 			return true
-		l557:
-			position, tokenIndex, depth = position557, tokenIndex557, depth557
+		l634:
+			position, tokenIndex, depth = position634, tokenIndex634, depth634
 			return false
 		},
-		/* 58 handler <- <(handlername '(' isp* (param isp* (',' isp* param isp*)*)? ')' (isp* type)? Action35)> */
+		/* 64 handler <- <(handlername '(' isp* (param isp* (',' isp* param isp*)*)? ')' (isp* type)? Action40)> */
 		func() bool {
-			position582, tokenIndex582, depth582 := position, tokenIndex, depth
+			position659, tokenIndex659, depth659 := position, tokenIndex, depth
 			{
-				position583 := position
+				position660 := position
 				depth++
 				if !_rules[rulehandlername]() {
-					goto l582
+					goto l659
 				}
 				if buffer[position] != rune('(') {
-					goto l582
+					goto l659
 				}
 				position++
-			l584:
+			l661:
 				{
-					position585, tokenIndex585, depth585 := position, tokenIndex, depth
+					position662, tokenIndex662, depth662 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l585
+						goto l662
 					}
-					goto l584
-				l585:
-					position, tokenIndex, depth = position585, tokenIndex585, depth585
+					goto l661
+				l662:
+					position, tokenIndex, depth = position662, tokenIndex662, depth662
 				}
 				{
-					position586, tokenIndex586, depth586 := position, tokenIndex, depth
+					position663, tokenIndex663, depth663 := position, tokenIndex, depth
 					if !_rules[ruleparam]() {
-						goto l586
+						goto l663
 					}
-				l588:
+				l665:
 					{
-						position589, tokenIndex589, depth589 := position, tokenIndex, depth
+						position666, tokenIndex666, depth666 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l589
+							goto l666
 						}
-						goto l588
-					l589:
-						position, tokenIndex, depth = position589, tokenIndex589, depth589
+						goto l665
+					l666:
+						position, tokenIndex, depth = position666, tokenIndex666, depth666
 					}
-				l590:
+				l667:
 					{
-						position591, tokenIndex591, depth591 := position, tokenIndex, depth
+						position668, tokenIndex668, depth668 := position, tokenIndex, depth
 						if buffer[position] != rune(',') {
-							goto l591
+							goto l668
 						}
 						position++
-					l592:
+					l669:
 						{
-							position593, tokenIndex593, depth593 := position, tokenIndex, depth
+							position670, tokenIndex670, depth670 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l593
+								goto l670
 							}
-							goto l592
-						l593:
-							position, tokenIndex, depth = position593, tokenIndex593, depth593
+							goto l669
+						l670:
+							position, tokenIndex, depth = position670, tokenIndex670, depth670
 						}
 						if !_rules[ruleparam]() {
-							goto l591
+							goto l668
 						}
-					l594:
+					l671:
 						{
-							position595, tokenIndex595, depth595 := position, tokenIndex, depth
+							position672, tokenIndex672, depth672 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l595
+								goto l672
 							}
-							goto l594
-						l595:
-							position, tokenIndex, depth = position595, tokenIndex595, depth595
+							goto l671
+						l672:
+							position, tokenIndex, depth = position672, tokenIndex672, depth672
 						}
-						goto l590
-					l591:
-						position, tokenIndex, depth = position591, tokenIndex591, depth591
+						goto l667
+					l668:
+						position, tokenIndex, depth = position668, tokenIndex668, depth668
 					}
-					goto l587
-				l586:
-					position, tokenIndex, depth = position586, tokenIndex586, depth586
+					goto l664
+				l663:
+					position, tokenIndex, depth = position663, tokenIndex663, depth663
 				}
-			l587:
+			l664:
 				if buffer[position] != rune(')') {
-					goto l582
+					goto l659
 				}
 				position++
 				{
-					position596, tokenIndex596, depth596 := position, tokenIndex, depth
-				l598:
+					position673, tokenIndex673, depth673 := position, tokenIndex, depth
+				l675:
 					{
-						position599, tokenIndex599, depth599 := position, tokenIndex, depth
+						position676, tokenIndex676, depth676 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l599
+							goto l676
 						}
-						goto l598
-					l599:
-						position, tokenIndex, depth = position599, tokenIndex599, depth599
+						goto l675
+					l676:
+						position, tokenIndex, depth = position676, tokenIndex676, depth676
 					}
 					if !_rules[ruletype]() {
-						goto l596
+						goto l673
 					}
-					goto l597
-				l596:
-					position, tokenIndex, depth = position596, tokenIndex596, depth596
+					goto l674
+				l673:
+					position, tokenIndex, depth = position673, tokenIndex673, depth673
 				}
-			l597:
-				if !_rules[ruleAction35]() {
-					goto l582
+			l674:
+				if !_rules[ruleAction40]() {
+					goto l659
 				}
 				depth--
-				add(rulehandler, position583)
+				add(rulehandler, position660)
 			}
 			return true
-		l582:
-			position, tokenIndex, depth = position582, tokenIndex582, depth582
+		l659:
+			position, tokenIndex, depth = position659, tokenIndex659, depth659
 			return false
 		},
-		/* 59 paramname <- <(<identifier> Action36)> */
+		/* 65 paramname <- <(<identifier> Action41)> */
 		func() bool {
-			position600, tokenIndex600, depth600 := position, tokenIndex, depth
+			position677, tokenIndex677, depth677 := position, tokenIndex, depth
 			{
-				position601 := position
+				position678 := position
 				depth++
 				{
-					position602 := position
+					position679 := position
 					depth++
 					if !_rules[ruleidentifier]() {
-						goto l600
+						goto l677
 					}
 					depth--
-					add(rulePegText, position602)
+					add(rulePegText, position679)
 				}
-				if !_rules[ruleAction36]() {
-					goto l600
+				if !_rules[ruleAction41]() {
+					goto l677
 				}
 				depth--
-				add(ruleparamname, position601)
+				add(ruleparamname, position678)
 			}
 			return true
-		l600:
-			position, tokenIndex, depth = position600, tokenIndex600, depth600
+		l677:
+			position, tokenIndex, depth = position677, tokenIndex677, depth677
 			return false
 		},
-		/* 60 param <- <(paramname isp+ type Action37)> */
+		/* 66 param <- <(paramname isp+ type Action42)> */
 		func() bool {
-			position603, tokenIndex603, depth603 := position, tokenIndex, depth
+			position680, tokenIndex680, depth680 := position, tokenIndex, depth
 			{
-				position604 := position
+				position681 := position
 				depth++
 				if !_rules[ruleparamname]() {
-					goto l603
+					goto l680
 				}
 				if !_rules[ruleisp]() {
-					goto l603
+					goto l680
 				}
-			l605:
+			l682:
 				{
-					position606, tokenIndex606, depth606 := position, tokenIndex, depth
+					position683, tokenIndex683, depth683 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l606
+						goto l683
 					}
-					goto l605
-				l606:
-					position, tokenIndex, depth = position606, tokenIndex606, depth606
+					goto l682
+				l683:
+					position, tokenIndex, depth = position683, tokenIndex683, depth683
 				}
 				if !_rules[ruletype]() {
-					goto l603
+					goto l680
 				}
-				if !_rules[ruleAction37]() {
-					goto l603
+				if !_rules[ruleAction42]() {
+					goto l680
 				}
 				depth--
-				add(ruleparam, position604)
+				add(ruleparam, position681)
 			}
 			return true
-		l603:
-			position, tokenIndex, depth = position603, tokenIndex603, depth603
+		l680:
+			position, tokenIndex, depth = position680, tokenIndex680, depth680
 			return false
 		},
-		/* 61 cparams <- <(isp* (cparam isp* (',' isp* cparam isp*)*)? !.)> */
+		/* 67 cparams <- <(isp* (cparam isp* (',' isp* cparam isp*)*)? !.)> */
 		func() bool {
-			position607, tokenIndex607, depth607 := position, tokenIndex, depth
+			position684, tokenIndex684, depth684 := position, tokenIndex, depth
 			{
-				position608 := position
+				position685 := position
 				depth++
-			l609:
+			l686:
 				{
-					position610, tokenIndex610, depth610 := position, tokenIndex, depth
+					position687, tokenIndex687, depth687 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l610
+						goto l687
 					}
-					goto l609
-				l610:
-					position, tokenIndex, depth = position610, tokenIndex610, depth610
+					goto l686
+				l687:
+					position, tokenIndex, depth = position687, tokenIndex687, depth687
 				}
 				{
-					position611, tokenIndex611, depth611 := position, tokenIndex, depth
+					position688, tokenIndex688, depth688 := position, tokenIndex, depth
 					if !_rules[rulecparam]() {
-						goto l611
+						goto l688
 					}
-				l613:
+				l690:
 					{
-						position614, tokenIndex614, depth614 := position, tokenIndex, depth
+						position691, tokenIndex691, depth691 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l614
+							goto l691
 						}
-						goto l613
-					l614:
-						position, tokenIndex, depth = position614, tokenIndex614, depth614
+						goto l690
+					l691:
+						position, tokenIndex, depth = position691, tokenIndex691, depth691
 					}
-				l615:
+				l692:
 					{
-						position616, tokenIndex616, depth616 := position, tokenIndex, depth
+						position693, tokenIndex693, depth693 := position, tokenIndex, depth
 						if buffer[position] != rune(',') {
-							goto l616
+							goto l693
 						}
 						position++
-					l617:
+					l694:
 						{
-							position618, tokenIndex618, depth618 := position, tokenIndex, depth
+							position695, tokenIndex695, depth695 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l618
+								goto l695
 							}
-							goto l617
-						l618:
-							position, tokenIndex, depth = position618, tokenIndex618, depth618
+							goto l694
+						l695:
+							position, tokenIndex, depth = position695, tokenIndex695, depth695
 						}
 						if !_rules[rulecparam]() {
-							goto l616
+							goto l693
 						}
-					l619:
+					l696:
 						{
-							position620, tokenIndex620, depth620 := position, tokenIndex, depth
+							position697, tokenIndex697, depth697 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l620
+								goto l697
 							}
-							goto l619
-						l620:
-							position, tokenIndex, depth = position620, tokenIndex620, depth620
+							goto l696
+						l697:
+							position, tokenIndex, depth = position697, tokenIndex697, depth697
 						}
-						goto l615
-					l616:
-						position, tokenIndex, depth = position616, tokenIndex616, depth616
+						goto l692
+					l693:
+						position, tokenIndex, depth = position693, tokenIndex693, depth693
 					}
-					goto l612
-				l611:
-					position, tokenIndex, depth = position611, tokenIndex611, depth611
+					goto l689
+				l688:
+					position, tokenIndex, depth = position688, tokenIndex688, depth688
 				}
-			l612:
+			l689:
 				{
-					position621, tokenIndex621, depth621 := position, tokenIndex, depth
+					position698, tokenIndex698, depth698 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l621
+						goto l698
 					}
-					goto l607
-				l621:
-					position, tokenIndex, depth = position621, tokenIndex621, depth621
+					goto l684
+				l698:
+					position, tokenIndex, depth = position698, tokenIndex698, depth698
 				}
 				depth--
-				add(rulecparams, position608)
+				add(rulecparams, position685)
 			}
 			return true
-		l607:
-			position, tokenIndex, depth = position607, tokenIndex607, depth607
+		l684:
+			position, tokenIndex, depth = position684, tokenIndex684, depth684
 			return false
 		},
-		/* 62 cparam <- <((var isp+)? tagname isp+ type Action38)> */
+		/* 68 cparam <- <((var isp+)? tagname isp+ type Action43)> */
 		func() bool {
-			position622, tokenIndex622, depth622 := position, tokenIndex, depth
+			position699, tokenIndex699, depth699 := position, tokenIndex, depth
 			{
-				position623 := position
+				position700 := position
 				depth++
 				{
-					position624, tokenIndex624, depth624 := position, tokenIndex, depth
+					position701, tokenIndex701, depth701 := position, tokenIndex, depth
 					if !_rules[rulevar]() {
-						goto l624
+						goto l701
 					}
 					if !_rules[ruleisp]() {
-						goto l624
+						goto l701
 					}
-				l626:
+				l703:
 					{
-						position627, tokenIndex627, depth627 := position, tokenIndex, depth
+						position704, tokenIndex704, depth704 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l627
+							goto l704
 						}
-						goto l626
-					l627:
-						position, tokenIndex, depth = position627, tokenIndex627, depth627
+						goto l703
+					l704:
+						position, tokenIndex, depth = position704, tokenIndex704, depth704
 					}
-					goto l625
-				l624:
-					position, tokenIndex, depth = position624, tokenIndex624, depth624
+					goto l702
+				l701:
+					position, tokenIndex, depth = position701, tokenIndex701, depth701
 				}
-			l625:
+			l702:
 				if !_rules[ruletagname]() {
-					goto l622
+					goto l699
 				}
 				if !_rules[ruleisp]() {
-					goto l622
+					goto l699
 				}
-			l628:
+			l705:
 				{
-					position629, tokenIndex629, depth629 := position, tokenIndex, depth
+					position706, tokenIndex706, depth706 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l629
+						goto l706
 					}
-					goto l628
-				l629:
-					position, tokenIndex, depth = position629, tokenIndex629, depth629
+					goto l705
+				l706:
+					position, tokenIndex, depth = position706, tokenIndex706, depth706
 				}
 				if !_rules[ruletype]() {
-					goto l622
+					goto l699
 				}
-				if !_rules[ruleAction38]() {
-					goto l622
+				if !_rules[ruleAction43]() {
+					goto l699
 				}
 				depth--
-				add(rulecparam, position623)
+				add(rulecparam, position700)
 			}
 			return true
-		l622:
-			position, tokenIndex, depth = position622, tokenIndex622, depth622
+		l699:
+			position, tokenIndex, depth = position699, tokenIndex699, depth699
 			return false
 		},
-		/* 63 var <- <(('v' / 'V') ('a' / 'A') ('r' / 'R') Action39)> */
+		/* 69 var <- <(('v' / 'V') ('a' / 'A') ('r' / 'R') Action44)> */
 		func() bool {
-			position630, tokenIndex630, depth630 := position, tokenIndex, depth
+			position707, tokenIndex707, depth707 := position, tokenIndex, depth
 			{
-				position631 := position
+				position708 := position
 				depth++
 				{
-					position632, tokenIndex632, depth632 := position, tokenIndex, depth
+					position709, tokenIndex709, depth709 := position, tokenIndex, depth
 					if buffer[position] != rune('v') {
-						goto l633
+						goto l710
 					}
 					position++
-					goto l632
-				l633:
-					position, tokenIndex, depth = position632, tokenIndex632, depth632
+					goto l709
+				l710:
+					position, tokenIndex, depth = position709, tokenIndex709, depth709
 					if buffer[position] != rune('V') {
-						goto l630
+						goto l707
 					}
 					position++
 				}
-			l632:
+			l709:
 				{
-					position634, tokenIndex634, depth634 := position, tokenIndex, depth
+					position711, tokenIndex711, depth711 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l635
+						goto l712
 					}
 					position++
-					goto l634
-				l635:
-					position, tokenIndex, depth = position634, tokenIndex634, depth634
+					goto l711
+				l712:
+					position, tokenIndex, depth = position711, tokenIndex711, depth711
 					if buffer[position] != rune('A') {
-						goto l630
+						goto l707
 					}
 					position++
 				}
-			l634:
+			l711:
 				{
-					position636, tokenIndex636, depth636 := position, tokenIndex, depth
+					position713, tokenIndex713, depth713 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l637
+						goto l714
 					}
 					position++
-					goto l636
-				l637:
-					position, tokenIndex, depth = position636, tokenIndex636, depth636
+					goto l713
+				l714:
+					position, tokenIndex, depth = position713, tokenIndex713, depth713
 					if buffer[position] != rune('R') {
-						goto l630
+						goto l707
 					}
 					position++
 				}
-			l636:
-				if !_rules[ruleAction39]() {
-					goto l630
+			l713:
+				if !_rules[ruleAction44]() {
+					goto l707
 				}
 				depth--
-				add(rulevar, position631)
+				add(rulevar, position708)
 			}
 			return true
-		l630:
-			position, tokenIndex, depth = position630, tokenIndex630, depth630
+		l707:
+			position, tokenIndex, depth = position707, tokenIndex707, depth707
 			return false
 		},
-		/* 64 args <- <(isp* arg isp* (',' isp* arg isp*)* !.)> */
+		/* 70 args <- <(isp* arg isp* (',' isp* arg isp*)* !.)> */
 		func() bool {
-			position638, tokenIndex638, depth638 := position, tokenIndex, depth
+			position715, tokenIndex715, depth715 := position, tokenIndex, depth
 			{
-				position639 := position
+				position716 := position
 				depth++
-			l640:
+			l717:
 				{
-					position641, tokenIndex641, depth641 := position, tokenIndex, depth
+					position718, tokenIndex718, depth718 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l641
+						goto l718
 					}
-					goto l640
-				l641:
-					position, tokenIndex, depth = position641, tokenIndex641, depth641
+					goto l717
+				l718:
+					position, tokenIndex, depth = position718, tokenIndex718, depth718
 				}
 				if !_rules[rulearg]() {
-					goto l638
+					goto l715
 				}
-			l642:
+			l719:
 				{
-					position643, tokenIndex643, depth643 := position, tokenIndex, depth
+					position720, tokenIndex720, depth720 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l643
+						goto l720
 					}
-					goto l642
-				l643:
-					position, tokenIndex, depth = position643, tokenIndex643, depth643
+					goto l719
+				l720:
+					position, tokenIndex, depth = position720, tokenIndex720, depth720
 				}
-			l644:
+			l721:
 				{
-					position645, tokenIndex645, depth645 := position, tokenIndex, depth
+					position722, tokenIndex722, depth722 := position, tokenIndex, depth
 					if buffer[position] != rune(',') {
-						goto l645
+						goto l722
 					}
 					position++
-				l646:
+				l723:
 					{
-						position647, tokenIndex647, depth647 := position, tokenIndex, depth
+						position724, tokenIndex724, depth724 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l647
+							goto l724
 						}
-						goto l646
-					l647:
-						position, tokenIndex, depth = position647, tokenIndex647, depth647
+						goto l723
+					l724:
+						position, tokenIndex, depth = position724, tokenIndex724, depth724
 					}
 					if !_rules[rulearg]() {
-						goto l645
+						goto l722
 					}
-				l648:
+				l725:
 					{
-						position649, tokenIndex649, depth649 := position, tokenIndex, depth
+						position726, tokenIndex726, depth726 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l649
+							goto l726
 						}
-						goto l648
-					l649:
-						position, tokenIndex, depth = position649, tokenIndex649, depth649
+						goto l725
+					l726:
+						position, tokenIndex, depth = position726, tokenIndex726, depth726
 					}
-					goto l644
-				l645:
-					position, tokenIndex, depth = position645, tokenIndex645, depth645
+					goto l721
+				l722:
+					position, tokenIndex, depth = position722, tokenIndex722, depth722
 				}
 				{
-					position650, tokenIndex650, depth650 := position, tokenIndex, depth
+					position727, tokenIndex727, depth727 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l650
+						goto l727
 					}
-					goto l638
-				l650:
-					position, tokenIndex, depth = position650, tokenIndex650, depth650
+					goto l715
+				l727:
+					position, tokenIndex, depth = position727, tokenIndex727, depth727
 				}
 				depth--
-				add(ruleargs, position639)
+				add(ruleargs, position716)
 			}
 			return true
-		l638:
-			position, tokenIndex, depth = position638, tokenIndex638, depth638
+		l715:
+			position, tokenIndex, depth = position715, tokenIndex715, depth715
 			return false
 		},
-		/* 65 arg <- <(expr Action40)> */
+		/* 71 arg <- <(expr Action45)> */
 		func() bool {
-			position651, tokenIndex651, depth651 := position, tokenIndex, depth
+			position728, tokenIndex728, depth728 := position, tokenIndex, depth
 			{
-				position652 := position
+				position729 := position
 				depth++
 				if !_rules[ruleexpr]() {
-					goto l651
+					goto l728
 				}
-				if !_rules[ruleAction40]() {
-					goto l651
+				if !_rules[ruleAction45]() {
+					goto l728
 				}
 				depth--
-				add(rulearg, position652)
+				add(rulearg, position729)
 			}
 			return true
-		l651:
-			position, tokenIndex, depth = position651, tokenIndex651, depth651
+		l728:
+			position, tokenIndex, depth = position728, tokenIndex728, depth728
 			return false
 		},
-		/* 66 imports <- <(isp* (fsep isp*)* import isp* (fsep isp* (fsep isp*)* import isp*)* (fsep isp*)* !.)> */
+		/* 72 imports <- <(isp* (fsep isp*)* import isp* (fsep isp* (fsep isp*)* import isp*)* (fsep isp*)* !.)> */
 		func() bool {
-			position653, tokenIndex653, depth653 := position, tokenIndex, depth
+			position730, tokenIndex730, depth730 := position, tokenIndex, depth
 			{
-				position654 := position
+				position731 := position
 				depth++
-			l655:
+			l732:
 				{
-					position656, tokenIndex656, depth656 := position, tokenIndex, depth
+					position733, tokenIndex733, depth733 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l656
+						goto l733
 					}
-					goto l655
-				l656:
-					position, tokenIndex, depth = position656, tokenIndex656, depth656
+					goto l732
+				l733:
+					position, tokenIndex, depth = position733, tokenIndex733, depth733
 				}
-			l657:
+			l734:
 				{
-					position658, tokenIndex658, depth658 := position, tokenIndex, depth
+					position735, tokenIndex735, depth735 := position, tokenIndex, depth
 					if !_rules[rulefsep]() {
-						goto l658
+						goto l735
 					}
-				l659:
+				l736:
 					{
-						position660, tokenIndex660, depth660 := position, tokenIndex, depth
+						position737, tokenIndex737, depth737 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l660
+							goto l737
 						}
-						goto l659
-					l660:
-						position, tokenIndex, depth = position660, tokenIndex660, depth660
+						goto l736
+					l737:
+						position, tokenIndex, depth = position737, tokenIndex737, depth737
 					}
-					goto l657
-				l658:
-					position, tokenIndex, depth = position658, tokenIndex658, depth658
+					goto l734
+				l735:
+					position, tokenIndex, depth = position735, tokenIndex735, depth735
 				}
 				if !_rules[ruleimport]() {
-					goto l653
+					goto l730
 				}
-			l661:
+			l738:
 				{
-					position662, tokenIndex662, depth662 := position, tokenIndex, depth
+					position739, tokenIndex739, depth739 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l662
+						goto l739
 					}
-					goto l661
-				l662:
-					position, tokenIndex, depth = position662, tokenIndex662, depth662
+					goto l738
+				l739:
+					position, tokenIndex, depth = position739, tokenIndex739, depth739
 				}
-			l663:
+			l740:
 				{
-					position664, tokenIndex664, depth664 := position, tokenIndex, depth
+					position741, tokenIndex741, depth741 := position, tokenIndex, depth
 					if !_rules[rulefsep]() {
-						goto l664
+						goto l741
 					}
-				l665:
+				l742:
 					{
-						position666, tokenIndex666, depth666 := position, tokenIndex, depth
+						position743, tokenIndex743, depth743 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l666
+							goto l743
 						}
-						goto l665
-					l666:
-						position, tokenIndex, depth = position666, tokenIndex666, depth666
+						goto l742
+					l743:
+						position, tokenIndex, depth = position743, tokenIndex743, depth743
 					}
-				l667:
+				l744:
 					{
-						position668, tokenIndex668, depth668 := position, tokenIndex, depth
+						position745, tokenIndex745, depth745 := position, tokenIndex, depth
 						if !_rules[rulefsep]() {
-							goto l668
+							goto l745
 						}
-					l669:
+					l746:
 						{
-							position670, tokenIndex670, depth670 := position, tokenIndex, depth
+							position747, tokenIndex747, depth747 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l670
+								goto l747
 							}
-							goto l669
-						l670:
-							position, tokenIndex, depth = position670, tokenIndex670, depth670
+							goto l746
+						l747:
+							position, tokenIndex, depth = position747, tokenIndex747, depth747
 						}
-						goto l667
-					l668:
-						position, tokenIndex, depth = position668, tokenIndex668, depth668
+						goto l744
+					l745:
+						position, tokenIndex, depth = position745, tokenIndex745, depth745
 					}
 					if !_rules[ruleimport]() {
-						goto l664
+						goto l741
 					}
-				l671:
+				l748:
 					{
-						position672, tokenIndex672, depth672 := position, tokenIndex, depth
+						position749, tokenIndex749, depth749 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l672
+							goto l749
 						}
-						goto l671
-					l672:
-						position, tokenIndex, depth = position672, tokenIndex672, depth672
+						goto l748
+					l749:
+						position, tokenIndex, depth = position749, tokenIndex749, depth749
 					}
-					goto l663
-				l664:
-					position, tokenIndex, depth = position664, tokenIndex664, depth664
+					goto l740
+				l741:
+					position, tokenIndex, depth = position741, tokenIndex741, depth741
 				}
-			l673:
+			l750:
 				{
-					position674, tokenIndex674, depth674 := position, tokenIndex, depth
+					position751, tokenIndex751, depth751 := position, tokenIndex, depth
 					if !_rules[rulefsep]() {
-						goto l674
+						goto l751
 					}
-				l675:
+				l752:
 					{
-						position676, tokenIndex676, depth676 := position, tokenIndex, depth
+						position753, tokenIndex753, depth753 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l676
+							goto l753
 						}
-						goto l675
-					l676:
-						position, tokenIndex, depth = position676, tokenIndex676, depth676
+						goto l752
+					l753:
+						position, tokenIndex, depth = position753, tokenIndex753, depth753
 					}
-					goto l673
-				l674:
-					position, tokenIndex, depth = position674, tokenIndex674, depth674
+					goto l750
+				l751:
+					position, tokenIndex, depth = position751, tokenIndex751, depth751
 				}
 				{
-					position677, tokenIndex677, depth677 := position, tokenIndex, depth
+					position754, tokenIndex754, depth754 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l677
+						goto l754
 					}
-					goto l653
-				l677:
-					position, tokenIndex, depth = position677, tokenIndex677, depth677
+					goto l730
+				l754:
+					position, tokenIndex, depth = position754, tokenIndex754, depth754
 				}
 				depth--
-				add(ruleimports, position654)
+				add(ruleimports, position731)
 			}
 			return true
-		l653:
-			position, tokenIndex, depth = position653, tokenIndex653, depth653
+		l730:
+			position, tokenIndex, depth = position730, tokenIndex730, depth730
 			return false
 		},
-		/* 67 import <- <((tagname isp+)? '"' <(!'"' .)*> '"' Action41)> */
+		/* 73 import <- <((tagname isp+)? '"' <(!'"' .)*> '"' Action46)> */
 		func() bool {
-			position678, tokenIndex678, depth678 := position, tokenIndex, depth
+			position755, tokenIndex755, depth755 := position, tokenIndex, depth
 			{
-				position679 := position
+				position756 := position
 				depth++
 				{
-					position680, tokenIndex680, depth680 := position, tokenIndex, depth
+					position757, tokenIndex757, depth757 := position, tokenIndex, depth
 					if !_rules[ruletagname]() {
-						goto l680
+						goto l757
 					}
 					if !_rules[ruleisp]() {
-						goto l680
+						goto l757
 					}
-				l682:
+				l759:
 					{
-						position683, tokenIndex683, depth683 := position, tokenIndex, depth
+						position760, tokenIndex760, depth760 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l683
+							goto l760
 						}
-						goto l682
-					l683:
-						position, tokenIndex, depth = position683, tokenIndex683, depth683
+						goto l759
+					l760:
+						position, tokenIndex, depth = position760, tokenIndex760, depth760
 					}
-					goto l681
-				l680:
-					position, tokenIndex, depth = position680, tokenIndex680, depth680
+					goto l758
+				l757:
+					position, tokenIndex, depth = position757, tokenIndex757, depth757
 				}
-			l681:
+			l758:
 				if buffer[position] != rune('"') {
-					goto l678
+					goto l755
 				}
 				position++
 				{
-					position684 := position
+					position761 := position
 					depth++
-				l685:
+				l762:
 					{
-						position686, tokenIndex686, depth686 := position, tokenIndex, depth
+						position763, tokenIndex763, depth763 := position, tokenIndex, depth
 						{
-							position687, tokenIndex687, depth687 := position, tokenIndex, depth
+							position764, tokenIndex764, depth764 := position, tokenIndex, depth
 							if buffer[position] != rune('"') {
-								goto l687
+								goto l764
 							}
 							position++
-							goto l686
-						l687:
-							position, tokenIndex, depth = position687, tokenIndex687, depth687
+							goto l763
+						l764:
+							position, tokenIndex, depth = position764, tokenIndex764, depth764
 						}
 						if !matchDot() {
-							goto l686
+							goto l763
 						}
-						goto l685
-					l686:
-						position, tokenIndex, depth = position686, tokenIndex686, depth686
+						goto l762
+					l763:
+						position, tokenIndex, depth = position763, tokenIndex763, depth763
 					}
 					depth--
-					add(rulePegText, position684)
+					add(rulePegText, position761)
 				}
 				if buffer[position] != rune('"') {
-					goto l678
+					goto l755
 				}
 				position++
-				if !_rules[ruleAction41]() {
-					goto l678
+				if !_rules[ruleAction46]() {
+					goto l755
 				}
 				depth--
-				add(ruleimport, position679)
+				add(ruleimport, position756)
 			}
 			return true
-		l678:
-			position, tokenIndex, depth = position678, tokenIndex678, depth678
+		l755:
+			position, tokenIndex, depth = position755, tokenIndex755, depth755
 			return false
 		},
-		/* 69 Action0 <- <{
+		/* 75 Action0 <- <{
 			p.varMappings = append(p.varMappings,
 				data.VariableMapping{Value: p.bv, Variable: p.goVal})
 			p.goVal.Type = nil
@@ -6321,9 +6936,9 @@ func (p *GeneralParser) Init() {
 			}
 			return true
 		},
-		nil,
-		/* 71 Action1 <- <{
-			p.goVal.Name = buffer[begin:end]
+		/* 76 Action1 <- <{
+			p.models = append(p.models, data.Model{Value: p.bv, Field: p.goVal.Name})
+			p.bv.IDs = nil
 		}> */
 		func() bool {
 			{
@@ -6331,92 +6946,112 @@ func (p *GeneralParser) Init() {
 			}
 			return true
 		},
-		/* 72 Action2 <- <{
+		nil,
+		/* 78 Action2 <- <{
+			p.goVal.Name = text
+		}> */
+		func() bool {
+			{
+				add(ruleAction2, position)
+			}
+			return true
+		},
+		/* 79 Action3 <- <{
 			p.goVal.Type = p.valuetype
 			p.valuetype = nil
 		}> */
 		func() bool {
 			{
-				add(ruleAction2, position)
+				add(ruleAction3, position)
 			}
 			return true
 		},
-		/* 73 Action3 <- <{
+		/* 80 Action4 <- <{
 			p.assignments = append(p.assignments, data.Assignment{Expression: p.expr,
-				Target: p.bv})
+				Target: p.bv, Signal: p.signal})
 			p.bv.IDs = nil
+			p.signal = false
 		}> */
 		func() bool {
 			{
-				add(ruleAction3, position)
+				add(ruleAction4, position)
+			}
+			return true
+		},
+		/* 81 Action5 <- <{
+			p.signal = true
+		}> */
+		func() bool {
+			{
+				add(ruleAction5, position)
 			}
 			return true
 		},
-		/* 74 Action4 <- <{
+		/* 82 Action6 <- <{
 			p.bv.Kind = data.BoundSelf
 		}> */
 		func() bool {
 			{
-				add(ruleAction4, position)
+				add(ruleAction6, position)
 			}
 			return true
 		},
-		/* 75 Action5 <- <{
+		/* 83 Action7 <- <{
 			p.bv.Kind = data.BoundDataset
 		}> */
 		func() bool {
 			{
-				add(ruleAction5, position)
+				add(ruleAction7, position)
 			}
 			return true
 		},
-		/* 76 Action6 <- <{
+		/* 84 Action8 <- <{
 			p.bv.Kind = data.BoundProperty
 		}> */
 		func() bool {
 			{
-				add(ruleAction6, position)
+				add(ruleAction8, position)
 			}
 			return true
 		},
-		/* 77 Action7 <- <{
+		/* 85 Action9 <- <{
 			p.bv.Kind = data.BoundStyle
 		}> */
 		func() bool {
 			{
-				add(ruleAction7, position)
+				add(ruleAction9, position)
 			}
 			return true
 		},
-		/* 78 Action8 <- <{
+		/* 86 Action10 <- <{
 			p.bv.Kind = data.BoundClass
 		}> */
 		func() bool {
 			{
-				add(ruleAction8, position)
+				add(ruleAction10, position)
 			}
 			return true
 		},
-		/* 79 Action9 <- <{
+		/* 87 Action11 <- <{
 			p.bv.Kind = data.BoundFormValue
 		}> */
 		func() bool {
 			{
-				add(ruleAction9, position)
+				add(ruleAction11, position)
 			}
 			return true
 		},
-		/* 80 Action10 <- <{
+		/* 88 Action12 <- <{
 			p.bv.Kind = data.BoundExpr
 			p.bv.IDs = append(p.bv.IDs, p.expr)
 		}> */
 		func() bool {
 			{
-				add(ruleAction10, position)
+				add(ruleAction12, position)
 			}
 			return true
 		},
-		/* 81 Action11 <- <{
+		/* 89 Action13 <- <{
 			p.bv.Kind = data.BoundEventValue
 			if len(p.bv.IDs) == 0 {
 				p.bv.IDs = append(p.bv.IDs, "")
@@ -6424,38 +7059,38 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction11, position)
+				add(ruleAction13, position)
 			}
 			return true
 		},
-		/* 82 Action12 <- <{
-			p.bv.IDs = append(p.bv.IDs, buffer[begin:end])
+		/* 90 Action14 <- <{
+			p.bv.IDs = append(p.bv.IDs, text)
 		}> */
 		func() bool {
 			{
-				add(ruleAction12, position)
+				add(ruleAction14, position)
 			}
 			return true
 		},
-		/* 83 Action13 <- <{
-			p.bv.IDs = append(p.bv.IDs, buffer[begin:end])
+		/* 91 Action15 <- <{
+			p.bv.IDs = append(p.bv.IDs, text)
 		}> */
 		func() bool {
 			{
-				add(ruleAction13, position)
+				add(ruleAction15, position)
 			}
 			return true
 		},
-		/* 84 Action14 <- <{
-			p.expr = buffer[begin:end]
+		/* 92 Action16 <- <{
+			p.expr = text
 		}> */
 		func() bool {
 			{
-				add(ruleAction14, position)
+				add(ruleAction16, position)
 			}
 			return true
 		},
-		/* 85 Action15 <- <{
+		/* 93 Action17 <- <{
 			var expr *string
 			if p.expr != "" {
 				expr = new(string)
@@ -6470,21 +7105,51 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction15, position)
+				add(ruleAction17, position)
 			}
 			return true
 		},
-		/* 86 Action16 <- <{
-			p.names = append(p.names, buffer[begin:end])
+		/* 94 Action18 <- <{
+			p.names = append(p.names, text)
+			p.fieldOffsets = append(p.fieldOffsets, begin)
 		}> */
 		func() bool {
 			{
-				add(ruleAction16, position)
+				add(ruleAction18, position)
+			}
+			return true
+		},
+		/* 95 Action19 <- <{
+			p.valuetype = p.generics[len(p.generics)-1]
+			p.generics = p.generics[:len(p.generics)-1]
+		}> */
+		func() bool {
+			{
+				add(ruleAction19, position)
+			}
+			return true
+		},
+		/* 96 Action20 <- <{
+			p.generics = append(p.generics, p.valuetype)
+		}> */
+		func() bool {
+			{
+				add(ruleAction20, position)
 			}
 			return true
 		},
-		/* 87 Action17 <- <{
-			switch name := buffer[begin:end]; name {
+		/* 97 Action21 <- <{
+			t := p.generics[len(p.generics)-1]
+			t.TypeArgs = append(t.TypeArgs, p.valuetype)
+		}> */
+		func() bool {
+			{
+				add(ruleAction21, position)
+			}
+			return true
+		},
+		/* 98 Action22 <- <{
+			switch name := text; name {
 			case "int":
 				p.valuetype = &data.ParamType{Kind: data.IntType}
 			case "bool":
@@ -6497,12 +7162,12 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction17, position)
+				add(ruleAction22, position)
 			}
 			return true
 		},
-		/* 88 Action18 <- <{
-			name := buffer[begin:end]
+		/* 99 Action23 <- <{
+			name := text
 			if name == "js.Value" {
 				p.valuetype = &data.ParamType{Kind: data.JSValueType}
 			} else {
@@ -6511,109 +7176,110 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction18, position)
+				add(ruleAction23, position)
 			}
 			return true
 		},
-		/* 89 Action19 <- <{
+		/* 100 Action24 <- <{
 			p.valuetype = &data.ParamType{Kind: data.ArrayType, ValueType: p.valuetype}
 		}> */
 		func() bool {
 			{
-				add(ruleAction19, position)
+				add(ruleAction24, position)
 			}
 			return true
 		},
-		/* 90 Action20 <- <{
+		/* 101 Action25 <- <{
 			p.valuetype = &data.ParamType{Kind: data.MapType, KeyType: p.keytype, ValueType: p.valuetype}
 		}> */
 		func() bool {
 			{
-				add(ruleAction20, position)
+				add(ruleAction25, position)
 			}
 			return true
 		},
-		/* 91 Action21 <- <{
+		/* 102 Action26 <- <{
 			p.valuetype = &data.ParamType{Kind: data.ChanType, ValueType: p.valuetype}
 		}> */
 		func() bool {
 			{
-				add(ruleAction21, position)
+				add(ruleAction26, position)
 			}
 			return true
 		},
-		/* 92 Action22 <- <{
+		/* 103 Action27 <- <{
 			p.valuetype = &data.ParamType{Kind: data.FuncType, ValueType: p.valuetype,
 				Params: p.params}
 			p.params = nil
 		}> */
 		func() bool {
 			{
-				add(ruleAction22, position)
+				add(ruleAction27, position)
 			}
 			return true
 		},
-		/* 93 Action23 <- <{
+		/* 104 Action28 <- <{
 			p.keytype = p.valuetype
 		}> */
 		func() bool {
 			{
-				add(ruleAction23, position)
+				add(ruleAction28, position)
 			}
 			return true
 		},
-		/* 94 Action24 <- <{
+		/* 105 Action29 <- <{
 			p.valuetype = &data.ParamType{Kind: data.PointerType, ValueType: p.valuetype}
 		}> */
 		func() bool {
 			{
-				add(ruleAction24, position)
+				add(ruleAction29, position)
 			}
 			return true
 		},
-		/* 95 Action25 <- <{
+		/* 106 Action30 <- <{
 			p.eventMappings = append(p.eventMappings, data.UnboundEventMapping{
 				Event: p.eventName, Handler: p.handlername, ParamMappings: p.paramMappings,
-				Handling: p.eventHandling})
+				Handling: p.eventHandling, Delegate: p.delegate})
 			p.eventHandling = data.AutoPreventDefault
+			p.delegate = false
 			p.expr = ""
 			p.paramMappings = make(map[string]data.BoundValue)
 		}> */
 		func() bool {
 			{
-				add(ruleAction25, position)
+				add(ruleAction30, position)
 			}
 			return true
 		},
-		/* 96 Action26 <- <{
-			p.handlername = buffer[begin:end]
+		/* 107 Action31 <- <{
+			p.handlername = text
 		}> */
 		func() bool {
 			{
-				add(ruleAction26, position)
+				add(ruleAction31, position)
 			}
 			return true
 		},
-		/* 97 Action27 <- <{
-			p.eventName = buffer[begin:end]
+		/* 108 Action32 <- <{
+			p.eventName = text
 		}> */
 		func() bool {
 			{
-				add(ruleAction27, position)
+				add(ruleAction32, position)
 			}
 			return true
 		},
-		/* 98 Action28 <- <{
+		/* 109 Action33 <- <{
 			p.paramIndex = 0
 			p.tagname = ""
 		}> */
 		func() bool {
 			{
-				add(ruleAction28, position)
+				add(ruleAction33, position)
 			}
 			return true
 		},
-		/* 99 Action29 <- <{
+		/* 110 Action34 <- <{
 			if p.tagname == "" {
 				if p.paramIndex == -1 {
 					p.err = errors.New("unnamed parameter mapping after named one")
@@ -6634,20 +7300,20 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction29, position)
+				add(ruleAction34, position)
 			}
 			return true
 		},
-		/* 100 Action30 <- <{
-			p.tagname = buffer[begin:end]
+		/* 111 Action35 <- <{
+			p.tagname = text
 		}> */
 		func() bool {
 			{
-				add(ruleAction30, position)
+				add(ruleAction35, position)
 			}
 			return true
 		},
-		/* 101 Action31 <- <{
+		/* 112 Action36 <- <{
 			switch p.tagname {
 			case "preventDefault":
 				if p.eventHandling != data.AutoPreventDefault {
@@ -6673,6 +7339,16 @@ func (p *GeneralParser) Init() {
 					p.err = errors.New("too many parameters for preventDefault")
 					return
 				}
+			case "delegate":
+				if p.delegate {
+					p.err = errors.New("duplicate delegate")
+					return
+				}
+				if len(p.names) != 0 {
+					p.err = errors.New("delegate does not take parameters")
+					return
+				}
+				p.delegate = true
 			default:
 				p.err = errors.New("unknown tag: " + p.tagname)
 				return
@@ -6681,38 +7357,38 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction31, position)
+				add(ruleAction36, position)
 			}
 			return true
 		},
-		/* 102 Action32 <- <{
-			p.tagname = buffer[begin:end]
+		/* 113 Action37 <- <{
+			p.tagname = text
 		}> */
 		func() bool {
 			{
-				add(ruleAction32, position)
+				add(ruleAction37, position)
 			}
 			return true
 		},
-		/* 103 Action33 <- <{
-			p.names = append(p.names, buffer[begin:end])
+		/* 114 Action38 <- <{
+			p.names = append(p.names, text)
 		}> */
 		func() bool {
 			{
-				add(ruleAction33, position)
+				add(ruleAction38, position)
 			}
 			return true
 		},
-		/* 104 Action34 <- <{
-			p.names = append(p.names, buffer[begin:end])
+		/* 115 Action39 <- <{
+			p.names = append(p.names, text)
 		}> */
 		func() bool {
 			{
-				add(ruleAction34, position)
+				add(ruleAction39, position)
 			}
 			return true
 		},
-		/* 105 Action35 <- <{
+		/* 116 Action40 <- <{
 			p.handlers = append(p.handlers, HandlerSpec{
 				Name: p.handlername, Params: p.params, Returns: p.valuetype})
 			p.valuetype = nil
@@ -6720,20 +7396,20 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction35, position)
+				add(ruleAction40, position)
 			}
 			return true
 		},
-		/* 106 Action36 <- <{
-			p.paramnames = append(p.paramnames, buffer[begin:end])
+		/* 117 Action41 <- <{
+			p.paramnames = append(p.paramnames, text)
 		}> */
 		func() bool {
 			{
-				add(ruleAction36, position)
+				add(ruleAction41, position)
 			}
 			return true
 		},
-		/* 107 Action37 <- <{
+		/* 118 Action42 <- <{
 			name := p.paramnames[len(p.paramnames)-1]
 			p.paramnames = p.paramnames[:len(p.paramnames)-1]
 			for _, para := range p.params {
@@ -6748,11 +7424,11 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction37, position)
+				add(ruleAction42, position)
 			}
 			return true
 		},
-		/* 108 Action38 <- <{
+		/* 119 Action43 <- <{
 			p.cParams = append(p.cParams, data.ComponentParam{
 				Name: p.tagname, Type: *p.valuetype, IsVar: p.isVar})
 			p.valuetype = nil
@@ -6760,30 +7436,30 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction38, position)
+				add(ruleAction43, position)
 			}
 			return true
 		},
-		/* 109 Action39 <- <{
+		/* 120 Action44 <- <{
 			p.isVar = true
 		}> */
 		func() bool {
 			{
-				add(ruleAction39, position)
+				add(ruleAction44, position)
 			}
 			return true
 		},
-		/* 110 Action40 <- <{
+		/* 121 Action45 <- <{
 		  p.names = append(p.names, p.expr)
 		}> */
 		func() bool {
 			{
-				add(ruleAction40, position)
+				add(ruleAction45, position)
 			}
 			return true
 		},
-		/* 111 Action41 <- <{
-			path := buffer[begin:end]
+		/* 122 Action46 <- <{
+			path := text
 			if p.tagname == "" {
 				lastDot := strings.LastIndexByte(path, '/')
 				if lastDot == -1 {
@@ -6801,7 +7477,7 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction41, position)
+				add(ruleAction46, position)
 			}
 			return true
 		},
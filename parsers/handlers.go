@@ -14,7 +14,7 @@ func ParseHandlers(s string) ([]HandlerSpec, error) {
 	p := GeneralParser{Buffer: s}
 	p.Init()
 	if err := p.Parse(int(rulehandlers)); err != nil {
-		return nil, err
+		return nil, syntaxError(err)
 	}
 	p.Execute()
 	return p.handlers, p.err
@@ -5,7 +5,7 @@ func ParseImports(s string) (map[string]string, error) {
 	p := GeneralParser{Buffer: s, imports: make(map[string]string)}
 	p.Init()
 	if err := p.Parse(int(ruleimports)); err != nil {
-		return nil, err
+		return nil, syntaxError(err)
 	}
 	p.Execute()
 	return p.imports, p.err
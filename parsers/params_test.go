@@ -0,0 +1,75 @@
+package parsers
+
+import (
+	"testing"
+)
+
+func TestParseParametersTypes(t *testing.T) {
+	for _, tc := range []struct {
+		input    string
+		expected []string
+	}{
+		{"a int, b string, c bool", []string{"int", "string", "bool"}},
+		{"s *askew.Signal[int]", []string{"*askew.Signal[int]"}},
+		{"m Pair[string, []T]", []string{"Pair[string, []T]"}},
+		{"n Tree[Node[int], map[string]Leaf[bool]]",
+			[]string{"Tree[Node[int], map[string]Leaf[bool]]"}},
+		{"var l askew.List[*Item, Controller]", []string{"askew.List[*Item, Controller]"}},
+		// type names may contain digits after their first character.
+		{"x float64, y int64, z []uint8", []string{"float64", "int64", "[]uint8"}},
+		{"t pkg2.T3, m map[int32]v1.Item", []string{"pkg2.T3", "map[int32]v1.Item"}},
+		{"s askew.Signal[float64]", []string{"askew.Signal[float64]"}},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			params, err := ParseParameters(tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(params) != len(tc.expected) {
+				t.Fatalf("expected %d params, got %d", len(tc.expected), len(params))
+			}
+			for i, p := range params {
+				if s := p.Type.String(); s != tc.expected[i] {
+					t.Errorf("param %s: expected type %s, got %s", p.Name, tc.expected[i], s)
+				}
+			}
+		})
+	}
+}
+
+func TestParseParametersGenericStructure(t *testing.T) {
+	params, err := ParseParameters("s askew.Signal[map[string]int]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := params[0].Type
+	if st.Name != "askew.Signal" || len(st.TypeArgs) != 1 {
+		t.Fatalf("unexpected type: %+v", st)
+	}
+	if arg := st.TypeArgs[0]; arg.KeyType == nil || arg.KeyType.String() != "string" ||
+		arg.ValueType == nil || arg.ValueType.String() != "int" {
+		t.Errorf("unexpected type argument: %+v", arg)
+	}
+}
+
+func TestParseParametersErrors(t *testing.T) {
+	for _, tc := range []struct {
+		input  string
+		offset int
+	}{
+		{"x 2d", 2},
+		{"x pkg.2d", 5},
+		{"x 2pkg.T", 2},
+	} {
+		t.Run(tc.input, func(t *testing.T) {
+			_, err := ParseParameters(tc.input)
+			se, ok := err.(*SyntaxError)
+			if !ok {
+				t.Fatalf("expected SyntaxError, got %v", err)
+			}
+			if se.Offset != tc.offset {
+				t.Errorf("expected offset %d, got %d (%s)", tc.offset, se.Offset, se.Message)
+			}
+		})
+	}
+}
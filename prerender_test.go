@@ -0,0 +1,151 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/flyx/askew/data"
+	"github.com/flyx/askew/output"
+	"github.com/flyx/askew/packages"
+)
+
+var prerenderSources = map[string]string{
+	"ui/ui.askew": `<a:import>
+	"strconv"
+</a:import>
+
+<a:component name="Greeting" params="name string, count int" gen-new-init>
+	<p class="greeting">Hello, <a:text expr="name"></a:text>!</p>
+	<span a:assign="dataset(count) = strconv.Itoa(count)"></span>
+	<em a:assign="prop(title) = name"></em>
+</a:component>
+`,
+	"main.asite": `<!doctype html>
+<a:site lang="en">
+	<a:package>main</a:package>
+	<a:import>
+		"example.com/site/ui"
+	</a:import>
+	<head><title>Test</title></head>
+	<body>
+		<a:embed name="First" type="ui.Greeting" args='"constant", 1'></a:embed>
+		<a:embed name="Second" type="ui.Greeting" args="greeting, len(greeting)"></a:embed>
+	</body>
+</a:site>
+`,
+	"main.go": `package main
+
+import "strings"
+
+var greeting = strings.ToUpper("native")
+
+func main() {}
+`,
+}
+
+// prerender generates the code and HTML of a module consisting of
+// prerenderSources and returns the generated HTML.
+func prerender(t *testing.T, native bool) string {
+	root, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	sum, err := ioutil.ReadFile("go.sum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"go.mod": "module example.com/site\n\ngo 1.22.0\n\n" +
+			"require github.com/flyx/askew v0.0.0\n\n" +
+			"replace github.com/flyx/askew => " + root + "\n",
+		"go.sum": string(sum),
+	}
+	for path, content := range prerenderSources {
+		files[path] = content
+	}
+	for path, content := range files {
+		path = filepath.Join(dir, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(root)
+	var diag data.Diagnostics
+	base, err := packages.Discover(nil, nil, &diag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	order, err := packages.Sort(base.ImportPath, base.Packages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := processor{prerender: true, native: native}
+	p.init(base, &diag)
+	if !p.process(order) {
+		t.Fatalf("processing failed: %v", diag.Items)
+	}
+	if err := p.dump(order, dir, output.WasmBackend); err != nil {
+		t.Fatal(err)
+	}
+	for _, item := range diag.Items {
+		t.Errorf("unexpected diagnostic: %s", item.Error())
+	}
+	if _, err := os.Stat("main.asite.prerender.go"); !os.IsNotExist(err) {
+		t.Errorf("native program has not been removed")
+	}
+	content, err := ioutil.ReadFile(filepath.Join(dir, "index.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(content)
+}
+
+func expectRendered(t *testing.T, content string, expected []string, unexpected []string) {
+	t.Helper()
+	for _, s := range expected {
+		if !strings.Contains(content, s) {
+			t.Errorf("HTML misses `%s`:\n%s", s, content)
+		}
+	}
+	for _, s := range unexpected {
+		if strings.Contains(content, s) {
+			t.Errorf("HTML unexpectedly contains `%s`:\n%s", s, content)
+		}
+	}
+}
+
+func TestPrerenderConstants(t *testing.T) {
+	content := prerender(t, false)
+	expectRendered(t, content, []string{
+		`<!--askew:component Greeting-->`,
+		`<p class="greeting">Hello, <!--askew:dynamic-->constant<!--/askew:dynamic-->!</p>`,
+		`<em title="constant"></em>`,
+		`<em></em>`,
+		// the second embed's arguments are not constant.
+		`<p class="greeting">Hello, <!--askew:dynamic--><!--/askew:dynamic-->!</p>`,
+	}, []string{"NATIVE", "data-count"})
+}
+
+func TestPrerenderNative(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("`go` is not available")
+	}
+	content := prerender(t, true)
+	expectRendered(t, content, []string{
+		`<p class="greeting">Hello, <!--askew:dynamic-->constant<!--/askew:dynamic-->!</p>`,
+		`<p class="greeting">Hello, <!--askew:dynamic-->NATIVE<!--/askew:dynamic-->!</p>`,
+		`<em title="NATIVE"></em>`,
+	}, []string{"data-count"})
+}
@@ -1,12 +1,12 @@
 package main
 
 import (
-	"errors"
 	"os"
 	"strings"
 
 	"github.com/flyx/askew/data"
 	"github.com/flyx/askew/output"
+	"github.com/flyx/askew/typecheck"
 	"github.com/flyx/askew/units"
 	"github.com/flyx/askew/walker"
 
@@ -17,23 +17,47 @@ import (
 type processor struct {
 	syms data.Symbols
 	mod  *modfile.File
+	diag *data.Diagnostics
+	// goimports specifies whether generated code is formatted with goimports.
+	goimports bool
+	// prerender specifies whether sites are rendered with their components.
+	prerender bool
+	// native specifies whether the arguments of prerendered embeds are
+	// evaluated by running the site's package natively.
+	native bool
+	// ignoreUnused specifies whether warnings about handlers that are never
+	// captured are suppressed.
+	ignoreUnused bool
 }
 
-func (p *processor) init(base *data.BaseDir) {
+func (p *processor) init(base *data.BaseDir, diag *data.Diagnostics) {
 	p.syms.BaseDir = *base
+	p.syms.IgnoreUnused = p.ignoreUnused
+	p.setDiagnostics(diag)
 }
 
-func (p *processor) processMacros(pkgName string) error {
+// setDiagnostics sets the target for errors and warnings of subsequent
+// processing.
+func (p *processor) setDiagnostics(diag *data.Diagnostics) {
+	p.syms.Diagnostics = diag
+	p.diag = diag
+}
+
+// processMacros processes the macros of all files in the given package.
+// Errors are recorded in the processor's diagnostics.
+func (p *processor) processMacros(pkgName string) {
 	p.syms.CurPkg = pkgName
 	pkg := p.syms.Packages[pkgName]
 	for _, file := range pkg.Files {
 		var err error
 
 		p.syms.SetAskewFile(file)
+		p.diag.CurFile = file.Path
 		os.Stdout.WriteString("[info] processing macros: " + file.Path + "\n")
 		var dummyParent *html.Node
 		if dummyParent, err = processMacros(file.Content, &p.syms); err != nil {
-			return errors.New(file.Path + err.Error())
+			p.diag.Add(data.ErrorAt(data.Position{File: file.Path}, err))
+			continue
 		}
 
 		// we need to write out the nodes and parse it again since text nodes may
@@ -48,42 +72,95 @@ func (p *processor) processMacros(pkgName string) error {
 			strings.NewReader(b.String()), &data.BodyEnv,
 			html.ParseOptionCustomElements(walker.AskewElements))
 		if err != nil {
-			return errors.New(file.Path + ": " + err.Error())
+			p.diag.Add(data.ErrorAt(data.Position{File: file.Path}, err))
 		}
 	}
-	return nil
 }
 
-func (p *processor) processComponents(pkgName string) error {
+// processComponents processes the components of all files in the given
+// package, and its site if it has one. Errors are recorded in the processor's
+// diagnostics.
+func (p *processor) processComponents(pkgName string) {
 	p.syms.CurPkg = pkgName
 	pkg := p.syms.Packages[pkgName]
 	for _, file := range pkg.Files {
+		p.diag.CurFile = file.Path
 		if err := units.ProcessFile(file, &p.syms); err != nil {
-			return err
+			p.diag.Add(err)
 		}
 	}
 	if pkg.Site != nil {
-		return units.ProcessSite(pkg.Site, &p.syms)
+		p.diag.CurFile = pkg.Site.Path
+		if err := units.ProcessSite(pkg.Site, &p.syms); err != nil {
+			p.diag.Add(err)
+		}
 	}
-	return nil
 }
 
-func (p *processor) dump(outputPath string, backend output.Backend) error {
-	for relPath, pkg := range p.syms.Packages {
-		w := output.PackageWriter{Syms: &p.syms, PackageName: pkg.Name, RelPath: relPath}
-		if err := os.MkdirAll(relPath, 0755); err != nil {
-			panic("failed to create package directory '" + relPath +
-				"': " + err.Error())
+// process processes the macros and components of the packages at the given
+// relative paths. The paths must be ordered by their dependencies.
+// Returns false if any errors have been recorded in the processor's
+// diagnostics.
+func (p *processor) process(order []string) bool {
+	for _, path := range order {
+		p.processMacros(path)
+	}
+	for _, path := range order {
+		p.processComponents(path)
+	}
+	return p.diag.Count(data.SeverityError) == 0
+}
+
+// packages returns the relative paths of all known packages.
+func (p *processor) packages() []string {
+	ret := make([]string, 0, len(p.syms.Packages))
+	for relPath := range p.syms.Packages {
+		ret = append(ret, relPath)
+	}
+	return ret
+}
+
+// typecheck type-checks the generated code of the packages at the given
+// relative paths. Errors are recorded in the processor's diagnostics.
+func (p *processor) typecheck(relPaths []string) {
+	os.Stdout.WriteString("[info] type-checking generated code\n")
+	if err := typecheck.Check(relPaths, p.diag); err != nil {
+		p.diag.Warn(data.Position{},
+			"unable to type-check generated code: "+err.Error())
+	}
+}
+
+// dump writes the code of the packages at the given relative paths. The paths
+// must be ordered by their dependencies, since evaluating the arguments of a
+// prerendered site natively requires the code of its dependencies.
+func (p *processor) dump(order []string, outputPath string,
+	backend output.Backend) error {
+	for _, relPath := range order {
+		if err := p.dumpPackage(relPath, outputPath, backend); err != nil {
+			return err
 		}
-		for _, f := range pkg.Files {
-			if err := w.WriteFile(f); err != nil {
-				return err
-			}
+	}
+	return nil
+}
+
+func (p *processor) dumpPackage(relPath string, outputPath string,
+	backend output.Backend) error {
+	pkg := p.syms.Packages[relPath]
+	w := output.PackageWriter{Syms: &p.syms, PackageName: pkg.Name,
+		RelPath: relPath, Goimports: p.goimports, Prerender: p.prerender,
+		Native: p.native}
+	if err := os.MkdirAll(relPath, 0755); err != nil {
+		panic("failed to create package directory '" + relPath +
+			"': " + err.Error())
+	}
+	for _, f := range pkg.Files {
+		if err := w.WriteFile(f); err != nil {
+			return err
 		}
-		if pkg.Site != nil {
-			if err := w.WriteSite(pkg.Site, outputPath, backend); err != nil {
-				return err
-			}
+	}
+	if pkg.Site != nil {
+		if err := w.WriteSite(pkg.Site, outputPath, backend); err != nil {
+			return err
 		}
 	}
 	return nil
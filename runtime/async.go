@@ -0,0 +1,46 @@
+package askew
+
+import "sync"
+
+// async tracks the calls of captured handlers that run in their own goroutine.
+var async struct {
+	sync.Mutex
+	done    *sync.Cond
+	running int
+}
+
+func init() {
+	async.done = sync.NewCond(&async.Mutex)
+}
+
+// BeginAsync registers a handler call that is about to be started in its own
+// goroutine. The returned function must be called when the call has finished.
+//
+// Generated code uses this for captured events whose handler does not return
+// a value, so that WaitAsync can wait for these calls.
+func BeginAsync() func() {
+	async.Lock()
+	async.running++
+	async.Unlock()
+	return func() {
+		async.Lock()
+		async.running--
+		if async.running == 0 {
+			async.done.Broadcast()
+		}
+		async.Unlock()
+	}
+}
+
+// WaitAsync blocks until all handler calls registered with BeginAsync have
+// finished. This is useful in tests, which want to inspect the results of a
+// handler after firing an event.
+//
+// Must not be called from a handler, since it would wait for itself.
+func WaitAsync() {
+	async.Lock()
+	for async.running > 0 {
+		async.done.Wait()
+	}
+	async.Unlock()
+}
@@ -0,0 +1,13 @@
+//go:build !js
+// +build !js
+
+package askew
+
+// KeepAlive sends the main thread to sleep if compiled for WASM.
+// This is required if your main() entry point would exit; otherwise the
+// handlers for DOM events wouldn't be called.
+//
+// Does nothing in native builds, where the in-memory DOM dispatches events
+// synchronously.
+func KeepAlive() {
+}
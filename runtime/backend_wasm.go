@@ -1,4 +1,5 @@
-// +build wasm
+//go:build js && wasm
+// +build js,wasm
 
 package askew
 
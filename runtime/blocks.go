@@ -0,0 +1,80 @@
+package askew
+
+import "github.com/flyx/askew/runtime/js"
+
+// BlockManager is the backend for a:if and a:for blocks of components.
+//
+// The element a block is declared on is removed from the DOM and used as
+// template for the nodes generated by the block. Like ListManager, the
+// BlockManager inserts those nodes in front of a fixed node, which is a
+// placeholder comment that takes the element's place.
+//
+// The block's content is generated by a render func that evaluates the
+// block's condition or range expression. Since that func is created when the
+// component is initialized, it can capture the component's parameters.
+type BlockManager struct {
+	start, end, template js.Value
+	nodes                []js.Value
+	reuse                bool
+	render               func(bm *BlockManager)
+}
+
+// Init replaces the given node with a placeholder and uses the node as
+// template for the block's content.
+//
+// render generates the block's content. It must call Item for each generated
+// node, starting at index 0, and Truncate with the number of generated nodes
+// at the end. If reuse is true, Item returns nodes of previous renderings
+// instead of fresh copies of the template. This may only be set if render
+// overwrites all dynamic content of the nodes.
+//
+// Init does not render the block's content so that the paths of the nodes
+// following the block stay valid. Call Update afterwards.
+func (bm *BlockManager) Init(node js.Value, reuse bool, render func(bm *BlockManager)) {
+	bm.end = js.Global().Get("document").Call("createComment", "end block")
+	node.Get("parentNode").Call("replaceChild", bm.end, node)
+	bm.start, bm.template, bm.nodes = js.Undefined(), node, nil
+	bm.reuse, bm.render = reuse, render
+}
+
+// Update renders the block's content again, which re-evaluates the block's
+// condition or range expression.
+func (bm *BlockManager) Update() {
+	if bm.start.IsUndefined() {
+		// the start placeholder ensures that the block's content is never the
+		// first node of a component, which ComponentData requires to be fixed.
+		// it is created lazily so that Init does not change the number of nodes.
+		bm.start = js.Global().Get("document").Call("createComment", "block")
+		bm.end.Get("parentNode").Call("insertBefore", bm.start, bm.end)
+	}
+	bm.render(bm)
+}
+
+// Item returns the node with the given index in the block's content. If the
+// content does not have that many nodes, a copy of the template is appended.
+// If the node exists but cannot be reused, it is replaced by a fresh copy.
+func (bm *BlockManager) Item(index int) js.Value {
+	parent := bm.end.Get("parentNode")
+	if index < len(bm.nodes) {
+		if bm.reuse {
+			return bm.nodes[index]
+		}
+		node := bm.template.Call("cloneNode", true)
+		parent.Call("replaceChild", node, bm.nodes[index])
+		bm.nodes[index] = node
+		return node
+	}
+	node := bm.template.Call("cloneNode", true)
+	parent.Call("insertBefore", node, bm.end)
+	bm.nodes = append(bm.nodes, node)
+	return node
+}
+
+// Truncate removes all nodes with an index of at least length from the
+// block's content.
+func (bm *BlockManager) Truncate(length int) {
+	for _, node := range bm.nodes[length:] {
+		node.Call("remove")
+	}
+	bm.nodes = bm.nodes[:length]
+}
@@ -1,6 +1,6 @@
 package askew
 
-import "syscall/js"
+import "github.com/flyx/askew/runtime/js"
 
 // BoundValue is the interface for retrieving and setting bound values in
 // the HTML DOM.
@@ -160,6 +160,11 @@ func (bc *BoundClasses) set(value interface{}) {
 
 // BoundFormValue implements BoundValue as a reference to an element supplying
 // a value to the current form.
+//
+// The value depends on the type of the element: A checkbox gives its
+// checkedness as boolean, a group of checkboxes with the same name and a
+// <select multiple> give an array of the values of the checked items, and a
+// file input gives its FileList. All other elements give their value.
 type BoundFormValue struct {
 	form  js.Value
 	name  string
@@ -178,9 +183,20 @@ func BoundFormValueAt(form js.Value, name string, radio bool) *BoundFormValue {
 	return &BoundFormValue{form: form, name: name, radio: radio}
 }
 
+// checkboxes returns the given element list if it is a group of checkboxes
+// sharing the same name.
+func checkboxes(elm js.Value) (js.Value, bool) {
+	if !elm.Get("nodeType").IsUndefined() || elm.Length() == 0 ||
+		elm.Index(0).Get("type").String() != "checkbox" {
+		return js.Value{}, false
+	}
+	return elm, true
+}
+
 func (bfv *BoundFormValue) get() js.Value {
+	elm := bfv.form.Get("elements").Get(bfv.name)
 	if bfv.radio {
-		list := bfv.form.Get("elements").Get(bfv.name)
+		list := elm
 		for i := 0; i < list.Length(); i++ {
 			item := list.Index(i)
 			if item.Get("checked").Bool() {
@@ -189,7 +205,51 @@ func (bfv *BoundFormValue) get() js.Value {
 		}
 		return js.Value{}
 	}
-	return bfv.form.Get("elements").Get(bfv.name).Get("value")
+	if list, ok := checkboxes(elm); ok {
+		return checkedValues(list, "checked")
+	}
+	switch elm.Get("type").String() {
+	case "checkbox":
+		return elm.Get("checked")
+	case "file":
+		return elm.Get("files")
+	case "select-multiple":
+		return checkedValues(elm.Get("options"), "selected")
+	}
+	return elm.Get("value")
+}
+
+// checkedValues returns an array of the values of the given items whose
+// property prop is true.
+func checkedValues(items js.Value, prop string) js.Value {
+	ret := js.Global().Get("Array").New()
+	for i := 0; i < items.Length(); i++ {
+		if item := items.Index(i); item.Get(prop).Bool() {
+			ret.Call("push", item.Get("value"))
+		}
+	}
+	return ret
+}
+
+// checkValues sets the property prop of each of the given items to whether its
+// value is contained in values.
+func checkValues(items js.Value, prop string, values interface{}) {
+	list, ok := values.([]interface{})
+	if !ok {
+		panic("unsupported value type for BoundFormValue on multiple values!")
+	}
+	for i := 0; i < items.Length(); i++ {
+		item := items.Index(i)
+		value := item.Get("value").String()
+		checked := false
+		for _, v := range list {
+			if s, ok := v.(string); ok && s == value {
+				checked = true
+				break
+			}
+		}
+		item.Set(prop, checked)
+	}
 }
 
 func (bfv *BoundFormValue) set(value interface{}) {
@@ -216,6 +276,23 @@ func (bfv *BoundFormValue) set(value interface{}) {
 		}
 		panic("unknown radio value!")
 	}
+	if list, ok := checkboxes(elm); ok {
+		checkValues(list, "checked", value)
+		return
+	}
+	switch elm.Get("type").String() {
+	case "checkbox":
+		if b, ok := value.(bool); ok {
+			elm.Set("checked", b)
+			return
+		}
+	case "file":
+		elm.Set("files", value)
+		return
+	case "select-multiple":
+		checkValues(elm.Get("options"), "selected", value)
+		return
+	}
 	elm.Set("value", value)
 }
 
@@ -1,6 +1,6 @@
 package askew
 
-import "syscall/js"
+import "github.com/flyx/askew/runtime/js"
 
 // ComponentData holds the content of an instance of a <a:component>.
 //
@@ -23,12 +23,26 @@ import "syscall/js"
 // to use it directly if the website is defined with a skeleton.
 type ComponentData struct {
 	fragment, first, last js.Value
+	mounted               bool
+	listeners             []listener
+	delegates             []int
+	// routes holds the functions removing the route tables registered via
+	// AddRoutes.
+	routes []func()
+	// signals holds the functions stopping the observations started by
+	// BindSignal.
+	signals []func()
 }
 
 // Init initializes the ComponentData with the given DocumentFragment node.
 // Previous data is discarded. The Component will be in initial state afterwards.
 func (cd *ComponentData) Init(frag js.Value) {
+	cd.releaseListeners()
+	cd.releaseDelegates()
+	cd.releaseRoutes()
+	cd.releaseSignals()
 	cd.fragment, cd.first, cd.last = frag, js.Value{}, js.Value{}
+	cd.mounted = false
 
 }
 
@@ -70,9 +84,11 @@ func (cd *ComponentData) DoExtract() {
 }
 
 // DoDestroy removes the component from the DOM if it is currently inserted.
-// Then it resets all links to the nodes, letting them eventually be garbage
-// collected. Afterwards, the component is is destroyed state and must not be
-// used anymore.
+// Then it removes all event listeners registered via AddEventListener or
+// Delegate, the routes registered via AddRoutes and the signals bound via
+// BindSignal, and resets all links to the nodes, letting them eventually be
+// garbage collected. Afterwards, the component is is destroyed state and must
+// not be used anymore.
 func (cd *ComponentData) DoDestroy() {
 	if !cd.first.IsUndefined() {
 		cur := cd.first
@@ -85,6 +101,10 @@ func (cd *ComponentData) DoDestroy() {
 			cur = next
 		}
 	}
+	cd.releaseListeners()
+	cd.releaseDelegates()
+	cd.releaseRoutes()
+	cd.releaseSignals()
 	cd.fragment, cd.first, cd.last = js.Undefined(), js.Undefined(), js.Undefined()
 }
 
@@ -0,0 +1,148 @@
+package askew
+
+import "github.com/flyx/askew/runtime/js"
+
+// delegator is implemented by generated components that have captures with
+// the `delegate` tag.
+type delegator interface {
+	// DelegatedEvents returns the types of all delegated events.
+	DelegatedEvents() []string
+}
+
+// handlerFunc is the signature of the functions wrapped by js.FuncOf.
+type handlerFunc = func(this js.Value, arguments []js.Value) interface{}
+
+const (
+	// delegateProp is the property of a node that holds the index of its
+	// delegated handlers in delegates.
+	delegateProp = "askewDelegate"
+	// handledProp is the property of an event that holds the node up to
+	// which delegated handlers have been called for the event. This prevents
+	// calling handlers twice if lists are nested.
+	handledProp = "askewHandled"
+	// dispatchProp is the prefix of the property that is set on a node if the
+	// dispatcher of the event type given by the rest of the name has been
+	// registered on it.
+	dispatchProp = "askewDispatch:"
+)
+
+var (
+	// delegates holds the delegated handlers of all nodes, indexed by the
+	// value of their delegateProp property, and then by event type.
+	delegates = make(map[int]map[string]handlerFunc)
+	// nextDelegate is the next free index in delegates.
+	nextDelegate = 1
+	// dispatchers holds the listener used for each delegated event type.
+	// It is shared by all lists.
+	dispatchers = make(map[string]js.Func)
+)
+
+// Delegate registers the given handler for the given event on target, which
+// must be a node of the component. Instead of registering a listener on
+// target, the handler is called by the listener of the list the component is
+// part of. The handler is called with target as `this`.
+//
+// If the component is not part of a list or optional when it is mounted, and
+// no other ancestor has a listener for the event, the listener is registered
+// on the component's parent node instead.
+//
+// The handler is removed when the component is destroyed.
+func (cd *ComponentData) Delegate(target js.Value, event string, handler handlerFunc) {
+	var handlers map[string]handlerFunc
+	if id := target.Get(delegateProp); id.IsUndefined() {
+		handlers = make(map[string]handlerFunc)
+		delegates[nextDelegate] = handlers
+		target.Set(delegateProp, nextDelegate)
+		cd.delegates = append(cd.delegates, nextDelegate)
+		nextDelegate++
+	} else {
+		handlers = delegates[id.Int()]
+	}
+	handlers[event] = handler
+}
+
+// releaseDelegates removes all handlers registered via Delegate.
+func (cd *ComponentData) releaseDelegates() {
+	for _, id := range cd.delegates {
+		delete(delegates, id)
+	}
+	cd.delegates = nil
+}
+
+// dispatcher returns the listener for the given delegated event type.
+func dispatcher(event string) js.Func {
+	fn, ok := dispatchers[event]
+	if !ok {
+		fn = js.FuncOf(dispatchDelegated)
+		dispatchers[event] = fn
+	}
+	return fn
+}
+
+// dispatchDelegated calls the delegated handlers of all nodes between the
+// event's target and the list's parent node, which is `this`, starting at the
+// target.
+func dispatchDelegated(this js.Value, arguments []js.Value) interface{} {
+	event := arguments[0]
+	typ := event.Get("type").String()
+	cur := event.Get(handledProp)
+	if cur.IsUndefined() {
+		cur = event.Get("target")
+	}
+	for !cur.IsNull() && !cur.Equal(this) {
+		if id := cur.Get(delegateProp); !id.IsUndefined() {
+			if handler, ok := delegates[id.Int()][typ]; ok {
+				handler(cur, arguments)
+			}
+		}
+		cur = cur.Get("parentNode")
+	}
+	event.Set(handledProp, this)
+	return nil
+}
+
+// listenDelegated registers the dispatcher for the given event type on node
+// unless it has already been registered there.
+func listenDelegated(node js.Value, event string) {
+	if node.Get(dispatchProp + event).IsUndefined() {
+		node.Call("addEventListener", event, dispatcher(event))
+		node.Set(dispatchProp+event, true)
+	}
+}
+
+// listen registers the listeners for the events delegated by the given
+// component on the list's parent node.
+func (lm ListManager) listen(c Component) {
+	d, ok := c.(delegator)
+	if !ok {
+		return
+	}
+	for _, event := range d.DelegatedEvents() {
+		if _, ok := lm.delegated[event]; !ok {
+			lm.delegated[event] = struct{}{}
+			listenDelegated(lm.parent, event)
+		}
+	}
+}
+
+// dispatchFallback registers the dispatcher for each event type delegated
+// by the component on the component's parent node, unless that node or one of
+// its ancestors already has it. This makes delegated handlers work for
+// components that are not part of a list or optional.
+func (cd *ComponentData) dispatchFallback() {
+	if len(cd.delegates) == 0 {
+		return
+	}
+	parent := cd.First().Get("parentNode")
+	for _, id := range cd.delegates {
+		for event := range delegates[id] {
+			cur := parent
+			for !cur.IsNull() && cur.Get(dispatchProp+event).IsUndefined() {
+				cur = cur.Get("parentNode")
+			}
+			if cur.IsNull() {
+				listenDelegated(parent, event)
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package askew
+
+import (
+	"testing"
+
+	"github.com/flyx/askew/runtime/js"
+)
+
+// delegatingItem is an item whose <li> delegates click events.
+type delegatingItem struct {
+	*item
+	clicks int
+	// this is the node given as `this` to the last call of the handler.
+	this js.Value
+}
+
+func newDelegatingItem(label string) *delegatingItem {
+	ret := &delegatingItem{item: newItem(label)}
+	ret.cd.Delegate(ret.FirstNode(), "click", func(this js.Value, arguments []js.Value) interface{} {
+		ret.clicks++
+		ret.this = this
+		return nil
+	})
+	return ret
+}
+
+func (it *delegatingItem) DelegatedEvents() []string {
+	return []string{"click"}
+}
+
+func delegatingList(labels ...string) (*List[*delegatingItem, interface{}], js.Value) {
+	container := newContainer()
+	l := &List[*delegatingItem, interface{}]{}
+	l.Init(container, 0)
+	for _, label := range labels {
+		l.Append(newDelegatingItem(label))
+	}
+	return l, container
+}
+
+func TestDelegateDispatchesViaList(t *testing.T) {
+	before := LiveFuncs()
+	l, container := delegatingList("a", "b", "c")
+	if container.Get(dispatchProp + "click").IsUndefined() {
+		t.Fatalf("dispatcher has not been registered on the list's container")
+	}
+	for i := 0; i < l.Len(); i++ {
+		if !l.Item(i).FirstNode().Get(dispatchProp + "click").IsUndefined() {
+			t.Errorf("dispatcher has been registered on item %d", i)
+		}
+	}
+	// all lists share a single dispatcher per event type.
+	if n := LiveFuncs() - before; n > 1 {
+		t.Errorf("expected at most one new func, got %d", n)
+	}
+
+	target := l.Item(1)
+	dispatch(target.FirstNode(), "click")
+	if l.Item(0).clicks != 0 || target.clicks != 1 || l.Item(2).clicks != 0 {
+		t.Fatalf("unexpected clicks: %d %d %d",
+			l.Item(0).clicks, target.clicks, l.Item(2).clicks)
+	}
+	if !target.this.Equal(target.FirstNode()) {
+		t.Errorf("handler has not been called with its node as `this`")
+	}
+
+	// events dispatched at a child of the node reach the handler, too.
+	span := js.Global().Get("document").Call("createElement", "span")
+	target.FirstNode().Call("appendChild", span)
+	dispatch(span, "click")
+	if target.clicks != 2 {
+		t.Errorf("event at child node did not reach the handler")
+	}
+
+	// items appended later are handled by the same dispatcher.
+	l.Append(newDelegatingItem("d"))
+	dispatch(l.Item(3).FirstNode(), "click")
+	if l.Item(3).clicks != 1 {
+		t.Errorf("appended item has not been handled")
+	}
+}
+
+func TestDelegateNestedLists(t *testing.T) {
+	outer, _ := delegatingList("outer")
+	parent := outer.Item(0)
+	inner := &List[*delegatingItem, interface{}]{}
+	innerContainer := newContainer()
+	parent.FirstNode().Call("appendChild", innerContainer)
+	inner.Init(innerContainer, 0)
+	inner.Append(newDelegatingItem("inner"))
+	child := inner.Item(0)
+
+	dispatch(child.FirstNode(), "click")
+	if child.clicks != 1 || parent.clicks != 1 {
+		t.Errorf("expected each handler to be called once, got inner=%d outer=%d",
+			child.clicks, parent.clicks)
+	}
+	dispatch(parent.FirstNode(), "click")
+	if child.clicks != 1 || parent.clicks != 2 {
+		t.Errorf("expected only the outer handler to be called, got inner=%d outer=%d",
+			child.clicks, parent.clicks)
+	}
+}
+
+func TestDelegateFallback(t *testing.T) {
+	doc := js.Global().Get("document")
+	div := doc.Call("createElement", "div")
+	it := newDelegatingItem("a")
+	it.InsertInto(div, js.Null())
+	it.cd.DoMount()
+	if div.Get(dispatchProp + "click").IsUndefined() {
+		t.Fatalf("dispatcher has not been registered on the parent node")
+	}
+	dispatch(it.FirstNode(), "click")
+	if it.clicks != 1 {
+		t.Errorf("expected handler to be called once, got %d", it.clicks)
+	}
+
+	// a component below a node that already dispatches does not register the
+	// dispatcher again.
+	inner := doc.Call("createElement", "div")
+	div.Call("appendChild", inner)
+	other := newDelegatingItem("b")
+	other.InsertInto(inner, js.Null())
+	other.cd.DoMount()
+	if !inner.Get(dispatchProp + "click").IsUndefined() {
+		t.Errorf("dispatcher has been registered below an existing one")
+	}
+	dispatch(other.FirstNode(), "click")
+	if other.clicks != 1 || it.clicks != 1 {
+		t.Errorf("unexpected clicks: a=%d b=%d", it.clicks, other.clicks)
+	}
+}
+
+func TestDestroyReleasesDelegates(t *testing.T) {
+	before := len(delegates)
+	l, container := delegatingList("a", "b")
+	if n := len(delegates) - before; n != 2 {
+		t.Fatalf("expected 2 delegates, got %d", n)
+	}
+	destroyed := l.Item(0)
+	li := destroyed.FirstNode()
+	l.Destroy(0)
+	if n := len(delegates) - before; n != 1 {
+		t.Errorf("expected 1 delegate after Destroy, got %d", n)
+	}
+	// the handler must not be called even if the node is put back.
+	container.Call("appendChild", li)
+	dispatch(li, "click")
+	if destroyed.clicks != 0 {
+		t.Errorf("handler has been called after Destroy")
+	}
+	l.DestroyAll()
+	if n := len(delegates) - before; n != 0 {
+		t.Errorf("expected all delegates to be released, %d remain", n)
+	}
+}
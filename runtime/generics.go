@@ -1,135 +1,355 @@
 package askew
 
 import (
-	"syscall/js"
+	"github.com/flyx/askew/runtime/js"
 )
 
-// GenericList is a list of Components whose manipulation methods auto-update
-// the corresponding nodes in the document.
-type GenericList struct {
+// controlled is implemented by generated components that have a controller.
+// It is used to propagate the DefaultController of lists and optionals.
+type controlled interface {
+	DoSetController(controller interface{})
+}
+
+// NoController is the controller type of the lists and optionals of
+// components that do not have a controller. Since it cannot be implemented
+// outside of this package, their DefaultController is always nil.
+type NoController interface {
+	noController()
+}
+
+// control sets the controller of c if controller is not nil and c has a
+// controller.
+func control[C any](c Component, controller C) {
+	var dynamic interface{} = controller
+	if dynamic == nil {
+		return
+	}
+	if cc, ok := c.(controlled); ok {
+		cc.DoSetController(dynamic)
+	}
+}
+
+// isNil returns true iff item is the zero value of T, i.e. a nil pointer or a
+// nil interface.
+func isNil[T Component](item T) bool {
+	var zero T
+	return Component(item) == Component(zero)
+}
+
+// components returns the given items as slice of Component.
+func components[T Component](items []T) []Component {
+	ret := make([]Component, len(items))
+	for i, item := range items {
+		ret[i] = item
+	}
+	return ret
+}
+
+// List is a list of components of type T whose manipulation methods
+// auto-update the corresponding nodes in the document. C is the type of the
+// components' controller. For each component, Askew generates an alias
+// `<name>List` of `List[*<name>, <name>Controller]`, or of
+// `List[*<name>, NoController]` if the component has no controller.
+type List[T Component, C any] struct {
 	mgr   ListManager
-	items []Component
+	items []T
+	keys  []interface{}
+	// DefaultController is set as controller of each item added to the list
+	// unless it is nil.
+	DefaultController C
 }
 
+// GenericList is a list of arbitrary Components. Its DefaultController is
+// set on each item that has a controller and must implement the item's
+// controller interface.
+type GenericList = List[Component, interface{}]
+
 // Init initializes the list, discarding previous data.
 // The list's items will be placed in the given container, starting at the
 // given index.
-func (l *GenericList) Init(container js.Value, index int) {
+func (l *List[T, C]) Init(container js.Value, index int) {
 	l.mgr = CreateListManager(container, index)
-	l.items = nil
+	l.items, l.keys = nil, nil
 }
 
 // Len returns the number of items in the list.
-func (l *GenericList) Len() int {
+func (l *List[T, C]) Len() int {
 	return len(l.items)
 }
 
 // Item returns the item at the current index.
-func (l *GenericList) Item(index int) Component {
+func (l *List[T, C]) Item(index int) T {
 	return l.items[index]
 }
 
 // Append appends the given item to the list.
-func (l *GenericList) Append(item Component) {
-	if item == nil {
+func (l *List[T, C]) Append(item T) {
+	if isNil(item) {
 		panic("cannot append nil to list")
 	}
-	l.items = append(l.items, item)
+	control(item, l.DefaultController)
 	l.mgr.Append(item)
-	return
+	l.items = append(l.items, item)
+	l.keys = append(l.keys, nil)
 }
 
 // Insert inserts the given item at the given index into the list.
-func (l *GenericList) Insert(index int, item Component) {
+func (l *List[T, C]) Insert(index int, item T) {
 	var prev js.Value
 	if index < len(l.items) {
 		prev = l.items[index].FirstNode()
 	}
-	if item == nil {
+	if isNil(item) {
 		panic("cannot insert nil into list")
 	}
-	l.items = append(l.items, nil)
+	control(item, l.DefaultController)
+	l.mgr.Insert(item, prev)
+	var zero T
+	l.items = append(l.items, zero)
 	copy(l.items[index+1:], l.items[index:])
 	l.items[index] = item
-	l.mgr.Insert(item, prev)
-	return
+	l.keys = append(l.keys, nil)
+	copy(l.keys[index+1:], l.keys[index:])
+	l.keys[index] = nil
 }
 
 // Remove removes the item at the given index from the list and returns it.
-func (l *GenericList) Remove(index int) Component {
+func (l *List[T, C]) Remove(index int) T {
 	item := l.items[index]
 	item.Extract()
 	copy(l.items[index:], l.items[index+1:])
 	l.items = l.items[:len(l.items)-1]
+	copy(l.keys[index:], l.keys[index+1:])
+	l.keys = l.keys[:len(l.keys)-1]
 	return item
 }
 
-// Destroy destroys the item at the given index in the list.
-func (l *GenericList) Destroy(index int) {
+// Destroy destroys the item at the given index and removes it from the list.
+func (l *List[T, C]) Destroy(index int) {
 	l.items[index].Destroy()
 	copy(l.items[index:], l.items[index+1:])
 	l.items = l.items[:len(l.items)-1]
+	copy(l.keys[index:], l.keys[index+1:])
+	l.keys = l.keys[:len(l.keys)-1]
 }
 
 // DestroyAll destroys all items in the list and empties it.
-func (l *GenericList) DestroyAll() {
+func (l *List[T, C]) DestroyAll() {
 	for _, item := range l.items {
 		item.Destroy()
 	}
+	l.items, l.keys = l.items[:0], l.keys[:0]
+}
+
+// AppendAll appends the given items to the list. The items are inserted into
+// the document with a single operation.
+func (l *List[T, C]) AppendAll(items ...T) {
+	for _, item := range items {
+		if isNil(item) {
+			panic("cannot append nil to list")
+		}
+		control(item, l.DefaultController)
+	}
+	l.mgr.AppendAll(components(items))
+	l.items = append(l.items, items...)
+	l.keys = append(l.keys, make([]interface{}, len(items))...)
+}
+
+// Move moves the item at index from to index to.
+func (l *List[T, C]) Move(from, to int) {
+	if from == to {
+		return
+	}
+	item, key := l.items[from], l.keys[from]
+	if from < to {
+		copy(l.items[from:], l.items[from+1:to+1])
+		copy(l.keys[from:], l.keys[from+1:to+1])
+	} else {
+		copy(l.items[to+1:], l.items[to:from])
+		copy(l.keys[to+1:], l.keys[to:from])
+	}
+	l.items[to], l.keys[to] = item, key
+	var next js.Value
+	if to+1 < len(l.items) {
+		next = l.items[to+1].FirstNode()
+	}
+	l.mgr.Move(item, next)
+}
+
+// Swap swaps the items at the given indexes.
+func (l *List[T, C]) Swap(i, j int) {
+	if i == j {
+		return
+	} else if i > j {
+		i, j = j, i
+	}
+	var next js.Value
+	if j+1 < len(l.items) {
+		next = l.items[j+1].FirstNode()
+	}
+	l.mgr.Move(l.items[j], l.items[i].FirstNode())
+	if j > i+1 {
+		l.mgr.Move(l.items[i], next)
+	}
+	l.items[i], l.items[j] = l.items[j], l.items[i]
+	l.keys[i], l.keys[j] = l.keys[j], l.keys[i]
+}
+
+// Sort sorts the list with the given less func. The sort is stable. Only
+// items whose position relative to the other items changes are moved in the
+// document.
+func (l *List[T, C]) Sort(less func(a, b T) bool) {
+	items := components(l.items)
+	source := l.mgr.Sort(items, func(a, b Component) bool {
+		return less(a.(T), b.(T))
+	})
+	keys := make([]interface{}, len(source))
+	for i, j := range source {
+		l.items[i], keys[i] = items[i].(T), l.keys[j]
+	}
+	l.keys = keys
+}
+
+// Clear removes all items from the list without destroying them and returns
+// them.
+func (l *List[T, C]) Clear() []T {
+	ret := l.items
+	for _, item := range ret {
+		item.Extract()
+	}
+	l.items, l.keys = nil, nil
+	return ret
+}
+
+// Reconcile updates the list so that it contains one item for each of the
+// given keys, in that order. Keys must be comparable.
+//
+// Items created by a previous call for a key that is still given are kept
+// and passed to update, which may be nil. For the other keys, factory creates
+// a new item. All other items, including those not added via Reconcile, are
+// destroyed. Only new items and kept items that changed their relative
+// position are moved in the document.
+func (l *List[T, C]) Reconcile(keys []interface{},
+	factory func(key interface{}) T,
+	update func(item T, key interface{})) {
+	var genericUpdate func(item Component, key interface{})
+	if update != nil {
+		genericUpdate = func(item Component, key interface{}) {
+			update(item.(T), key)
+		}
+	}
+	items := l.mgr.Reconcile(components(l.items), l.keys, keys,
+		func(key interface{}) Component {
+			item := factory(key)
+			if isNil(item) {
+				panic("factory returned nil")
+			}
+			control(item, l.DefaultController)
+			return item
+		}, genericUpdate)
 	l.items = l.items[:0]
+	for _, item := range items {
+		l.items = append(l.items, item.(T))
+	}
+	l.keys = append(l.keys[:0], keys...)
 }
 
 // DoUpdateParent calls the underlying list manager's UpdateParent.
 // This is an implementation detail and should not be called from user code.
-func (l *GenericList) DoUpdateParent(oldParent, newParent, newEnd js.Value) {
+func (l *List[T, C]) DoUpdateParent(oldParent, newParent, newEnd js.Value) {
 	l.mgr.UpdateParent(oldParent, newParent, newEnd)
 }
 
-// GenericOptional is a container that may optionally hold one arbitrary component.
-type GenericOptional struct {
+// DoMount propagates the mounted hooks to the list's items.
+// This is an implementation detail and should not be called from user code.
+func (l *List[T, C]) DoMount() {
+	for _, item := range l.items {
+		mount(item)
+	}
+}
+
+// DoUnmount propagates the unmounted hooks to the list's items.
+// This is an implementation detail and should not be called from user code.
+func (l *List[T, C]) DoUnmount() {
+	for _, item := range l.items {
+		unmount(item)
+	}
+}
+
+// Optional is a container that may optionally hold one component of type T.
+// C is the type of the component's controller. For each component, Askew
+// generates an alias `Optional<name>` of `Optional[*<name>, <name>Controller]`,
+// or of `Optional[*<name>, NoController]` if the component has no controller.
+type Optional[T Component, C any] struct {
 	mgr ListManager
-	cur Component
+	cur T
+	// DefaultController is set as controller of each item assigned to the
+	// container unless it is nil.
+	DefaultController C
 }
 
+// GenericOptional is a container that may optionally hold one arbitrary
+// component. Its DefaultController is set on the item if it has a controller
+// and must implement the item's controller interface.
+type GenericOptional = Optional[Component, interface{}]
+
 // Init initializes the container to be empty.
 // The contained item, if any, will be placed in the given container at the
 // given index.
-func (o *GenericOptional) Init(container js.Value, index int) {
+func (o *Optional[T, C]) Init(container js.Value, index int) {
 	o.mgr = CreateListManager(container, index)
-	o.cur = nil
+	var zero T
+	o.cur = zero
 }
 
 // Item returns the current item, or nil if no item is assigned
-func (o *GenericOptional) Item() Component {
+func (o *Optional[T, C]) Item() T {
 	return o.cur
 }
 
 // Set sets the contained item destroying the current one.
 // Give nil as value to simply destroy the current item.
-func (o *GenericOptional) Set(value Component) {
-	if o.cur != nil {
+func (o *Optional[T, C]) Set(value T) {
+	if !isNil(o.cur) {
 		o.cur.Destroy()
 	}
 	o.cur = value
-	if value != nil {
+	if !isNil(value) {
+		control(value, o.DefaultController)
 		o.mgr.Append(value)
 	}
 }
 
 // Remove removes the contained item and returns it.
 // Returns nil if no item is currently contained.
-func (o *GenericOptional) Remove() Component {
-	if o.cur != nil {
-		o.cur.Extract()
-		ret := o.cur
-		o.cur = nil
-		return ret
+func (o *Optional[T, C]) Remove() T {
+	ret := o.cur
+	if !isNil(ret) {
+		ret.Extract()
+		var zero T
+		o.cur = zero
 	}
-	return nil
+	return ret
 }
 
 // DoUpdateParent calls the underlying list manager's UpdateParent.
 // This is an implementation detail and should not be called from user code.
-func (o *GenericOptional) DoUpdateParent(oldParent, newParent, newEnd js.Value) {
+func (o *Optional[T, C]) DoUpdateParent(oldParent, newParent, newEnd js.Value) {
 	o.mgr.UpdateParent(oldParent, newParent, newEnd)
 }
+
+// DoMount propagates the mounted hooks to the contained item.
+// This is an implementation detail and should not be called from user code.
+func (o *Optional[T, C]) DoMount() {
+	if !isNil(o.cur) {
+		mount(o.cur)
+	}
+}
+
+// DoUnmount propagates the unmounted hooks to the contained item.
+// This is an implementation detail and should not be called from user code.
+func (o *Optional[T, C]) DoUnmount() {
+	if !isNil(o.cur) {
+		unmount(o.cur)
+	}
+}
@@ -0,0 +1,128 @@
+package askew
+
+import (
+	"strings"
+
+	"github.com/flyx/askew/runtime/js"
+)
+
+// prerendered is the content of a component that has been rendered into the
+// HTML document by askew. It is identified by the placeholder of the embed
+// the component has been rendered for.
+type prerendered struct {
+	name                 string
+	placeholder, content js.Value
+}
+
+var (
+	prerenderedComponents []prerendered
+	adoptAt               js.Value
+)
+
+// Hydrate removes the content of all prerendered components from the
+// document. The content is adopted by the components initialized for the
+// respective embeds instead of copies of the components' templates.
+//
+// This must be called before any component of the document is initialized.
+// It is called by the generated code of sites that have been prerendered.
+func Hydrate() {
+	prerenderedComponents = collectPrerendered(
+		js.Global().Get("document").Get("documentElement"), nil)
+}
+
+// Adopt specifies that the next component to be initialized belongs to the
+// embed with the given placeholder and shall adopt the content prerendered
+// for that embed, if any.
+func Adopt(placeholder js.Value) {
+	adoptAt = placeholder
+}
+
+// Instantiate returns the initial content of a new instance of the component
+// with the given template and name. This is the content prerendered for the
+// embed given to Adopt if it belongs to that component, or a copy of the
+// template's content otherwise.
+func Instantiate(template js.Value, name string) js.Value {
+	content := template.Get("content").Call("cloneNode", true)
+	if adoptAt.IsUndefined() {
+		return content
+	}
+	for i, p := range prerenderedComponents {
+		if p.name == name && p.placeholder.Equal(adoptAt) {
+			prerenderedComponents = append(prerenderedComponents[:i],
+				prerenderedComponents[i+1:]...)
+			adoptAt = js.Undefined()
+			restoreDynamic(p.content, content)
+			return p.content
+		}
+	}
+	return content
+}
+
+func isComment(node js.Value, prefix string) (rest string, ok bool) {
+	if node.Get("nodeType").Int() != 8 {
+		return "", false
+	}
+	data := node.Get("data").String()
+	if !strings.HasPrefix(data, prefix) {
+		return "", false
+	}
+	return data[len(prefix):], true
+}
+
+// collectPrerendered moves the content of all prerendered components inside
+// parent into DocumentFragments and appends them to list. This includes
+// components nested in other prerendered components.
+func collectPrerendered(parent js.Value, list []prerendered) []prerendered {
+	for cur := parent.Get("firstChild"); !cur.IsNull(); {
+		next := cur.Get("nextSibling")
+		if name, ok := isComment(cur, "askew:component "); ok {
+			content := js.Global().Get("document").Call("createDocumentFragment")
+			depth := 0
+			for node := next; ; node = next {
+				next = node.Get("nextSibling")
+				if _, ok := isComment(node, "askew:component "); ok {
+					depth++
+				} else if _, ok := isComment(node, "/askew:component"); ok {
+					if depth == 0 {
+						node.Call("remove")
+						break
+					}
+					depth--
+				}
+				content.Call("appendChild", node)
+			}
+			cur.Call("remove")
+			list = append(list, prerendered{name: name, placeholder: next,
+				content: content})
+			list = collectPrerendered(content, list)
+		} else if cur.Get("nodeType").Int() == 1 {
+			list = collectPrerendered(cur, list)
+		}
+		cur = next
+	}
+	return list
+}
+
+// restoreDynamic replaces the dynamic parts of the prerendered content with
+// the respective nodes of the component's template, so that the content has
+// the structure expected by the component.
+func restoreDynamic(content, template js.Value) {
+	orig := template.Get("firstChild")
+	for cur := content.Get("firstChild"); !cur.IsNull() && !orig.IsNull(); {
+		next, origNext := cur.Get("nextSibling"), orig.Get("nextSibling")
+		if _, ok := isComment(cur, "askew:dynamic"); ok {
+			for {
+				node := next
+				next = node.Get("nextSibling")
+				node.Call("remove")
+				if _, ok := isComment(node, "/askew:dynamic"); ok {
+					break
+				}
+			}
+			content.Call("replaceChild", orig, cur)
+		} else if cur.Get("nodeType").Int() == 1 {
+			restoreDynamic(cur, orig)
+		}
+		cur, orig = next, origNext
+	}
+}
@@ -0,0 +1,93 @@
+package askew
+
+import (
+	"testing"
+
+	"github.com/flyx/askew/runtime/js"
+)
+
+// item is a hand-written component consisting of a single <li> element. It
+// counts how often it has been inserted into a parent node.
+type item struct {
+	cd        ComponentData
+	label     string
+	inserts   int
+	destroyed bool
+}
+
+func newItem(label string) *item {
+	doc := js.Global().Get("document")
+	frag := doc.Call("createDocumentFragment")
+	li := doc.Call("createElement", "li")
+	li.Set("textContent", label)
+	frag.Call("appendChild", li)
+	ret := &item{label: label}
+	ret.cd.Init(frag)
+	return ret
+}
+
+func (it *item) FirstNode() js.Value {
+	return it.cd.First()
+}
+
+func (it *item) InsertInto(parent js.Value, before js.Value) {
+	it.inserts++
+	it.cd.DoInsert(parent, before)
+}
+
+func (it *item) Extract() {
+	it.cd.DoExtract()
+}
+
+func (it *item) Destroy() {
+	it.cd.DoDestroy()
+	it.destroyed = true
+}
+
+// newContainer returns a <ul> that contains a single <hr>. Lists created
+// with index 0 insert their items in front of the <hr>.
+func newContainer() js.Value {
+	doc := js.Global().Get("document")
+	ul := doc.Call("createElement", "ul")
+	ul.Call("appendChild", doc.Call("createElement", "hr"))
+	return ul
+}
+
+// expectChildren checks the text content of the <li> children of the given
+// container, and that the <hr> is its last child.
+func expectChildren(t *testing.T, container js.Value, expected ...string) {
+	t.Helper()
+	var labels []string
+	children := container.Get("childNodes")
+	for i := 0; i < children.Length(); i++ {
+		child := children.Index(i)
+		if child.Get("nodeName").String() == "HR" {
+			if i != children.Length()-1 {
+				t.Errorf("<hr> is not the last child")
+			}
+			continue
+		}
+		labels = append(labels, child.Get("textContent").String())
+	}
+	if len(labels) != len(expected) {
+		t.Fatalf("expected children %v, got %v", expected, labels)
+	}
+	for i := range labels {
+		if labels[i] != expected[i] {
+			t.Fatalf("expected children %v, got %v", expected, labels)
+		}
+	}
+}
+
+// resetInserts sets the insert counters of the given items to zero.
+func resetInserts(items ...*item) {
+	for _, it := range items {
+		it.inserts = 0
+	}
+}
+
+// dispatch fires a bubbling event of the given type at target.
+func dispatch(target js.Value, event string) {
+	target.Call("dispatchEvent", js.Global().Get("Event").New(event,
+		map[string]interface{}{"bubbles": true, "cancelable": true}))
+}
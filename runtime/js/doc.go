@@ -0,0 +1,15 @@
+// Package js provides the subset of syscall/js used by askew's runtime and
+// generated code.
+//
+// When compiling for GOOS=js (WASM or GopherJS), the package simply forwards
+// to syscall/js and all its types are aliases of the types in syscall/js.
+//
+// On every other platform, the package implements an in-memory DOM with the
+// same API. This allows instantiating components, firing events and
+// inspecting the resulting node tree in ordinary Go tests. The in-memory DOM
+// implements the operations askew needs, plus querySelector,
+// querySelectorAll, innerHTML and outerHTML for inspection. The session
+// history starts at http://localhost/ and can be changed via the window's
+// history object. It is not a complete browser: there is no layout, no script
+// execution and no network.
+package js
@@ -0,0 +1,1162 @@
+//go:build !js
+// +build !js
+
+package js
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/flyx/net/html"
+)
+
+// The node types of the DOM.
+const (
+	elementNode          = 1
+	textNode             = 3
+	commentNode          = 8
+	documentNode         = 9
+	doctypeNode          = 10
+	documentFragmentNode = 11
+)
+
+// listener is an event listener registered on a node.
+type listener struct {
+	typ     string
+	fn      Value
+	capture bool
+	once    bool
+}
+
+// node is a node of the in-memory DOM.
+type node struct {
+	nodeType int
+	// tag is the lower-case name of an element, or the name of a doctype.
+	tag string
+	// data is the content of a text or comment node.
+	data  string
+	attrs []html.Attribute
+	// props holds properties that have been set on the node and do not
+	// correspond to attributes.
+	props map[string]Value
+
+	parent, firstChild, lastChild, prev, next *node
+	// content is the DocumentFragment holding the content of a <template>.
+	content   *node
+	listeners listeners
+
+	// state of form controls. nil if the state has not been changed and is
+	// derived from the attributes.
+	value    *string
+	checked  *bool
+	selected *bool
+}
+
+func newNode(nodeType int, tag, data string) *node {
+	ret := &node{nodeType: nodeType, tag: tag, data: data}
+	if nodeType == elementNode && tag == "template" {
+		ret.content = &node{nodeType: documentFragmentNode}
+	}
+	return ret
+}
+
+// newDocument creates a document containing an empty HTML page.
+func newDocument() *node {
+	doc := newNode(documentNode, "", "")
+	doc.appendChild(newNode(doctypeNode, "html", ""))
+	root := newNode(elementNode, "html", "")
+	root.appendChild(newNode(elementNode, "head", ""))
+	root.appendChild(newNode(elementNode, "body", ""))
+	doc.appendChild(root)
+	return doc
+}
+
+func nodeValue(n *node) Value {
+	if n == nil {
+		return Null()
+	}
+	return Value{v: n}
+}
+
+// toNode returns the node contained in v, or nil if v is not a node.
+func toNode(v Value) *node {
+	n, _ := v.v.(*node)
+	return n
+}
+
+func domError(name, message string) Error {
+	e := newPlainObject()
+	e.set("name", ValueOf(name))
+	e.set("message", ValueOf(name+": "+message))
+	return Error{Value{v: e}}
+}
+
+// tree manipulation
+
+func (n *node) isAncestorOf(other *node) bool {
+	for cur := other; cur != nil; cur = cur.parent {
+		if cur == n {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *node) detach() {
+	if n.parent == nil {
+		return
+	}
+	if n.prev == nil {
+		n.parent.firstChild = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		n.parent.lastChild = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+	n.parent, n.prev, n.next = nil, nil, nil
+}
+
+// insertBefore inserts c before ref, which must be a child of n or nil.
+// If c is a DocumentFragment, its children are inserted instead.
+func (n *node) insertBefore(c, ref *node) {
+	if ref != nil && ref.parent != n {
+		panic(domError("NotFoundError",
+			"the node before which the new node is to be inserted is not a child of this node"))
+	}
+	if c.isAncestorOf(n) {
+		panic(domError("HierarchyRequestError",
+			"the new child is an ancestor of the parent"))
+	}
+	if c.nodeType == documentFragmentNode {
+		for c.firstChild != nil {
+			n.insertBefore(c.firstChild, ref)
+		}
+		return
+	}
+	if c == ref {
+		return
+	}
+	c.detach()
+	c.parent, c.next = n, ref
+	if ref == nil {
+		c.prev = n.lastChild
+		n.lastChild = c
+	} else {
+		c.prev = ref.prev
+		ref.prev = c
+	}
+	if c.prev == nil {
+		n.firstChild = c
+	} else {
+		c.prev.next = c
+	}
+}
+
+func (n *node) appendChild(c *node) {
+	n.insertBefore(c, nil)
+}
+
+func (n *node) removeChild(c *node) {
+	if c.parent != n {
+		panic(domError("NotFoundError", "the node to be removed is not a child of this node"))
+	}
+	c.detach()
+}
+
+func (n *node) clone(deep bool) *node {
+	ret := newNode(n.nodeType, n.tag, n.data)
+	ret.attrs = append([]html.Attribute(nil), n.attrs...)
+	if n.value != nil {
+		v := *n.value
+		ret.value = &v
+	}
+	if n.checked != nil {
+		c := *n.checked
+		ret.checked = &c
+	}
+	if n.selected != nil {
+		s := *n.selected
+		ret.selected = &s
+	}
+	if deep {
+		for c := n.firstChild; c != nil; c = c.next {
+			ret.appendChild(c.clone(true))
+		}
+		if n.content != nil {
+			for c := n.content.firstChild; c != nil; c = c.next {
+				ret.content.appendChild(c.clone(true))
+			}
+		}
+	}
+	return ret
+}
+
+// document returns the document n belongs to, or nil.
+func (n *node) document() *node {
+	cur := n
+	for cur.parent != nil {
+		cur = cur.parent
+	}
+	if cur.nodeType == documentNode {
+		return cur
+	}
+	return nil
+}
+
+// walk calls f for each descendant of n in document order until f returns
+// false. Returns false if f returned false.
+func (n *node) walk(f func(d *node) bool) bool {
+	for c := n.firstChild; c != nil; c = c.next {
+		if !f(c) || !c.walk(f) {
+			return false
+		}
+	}
+	return true
+}
+
+func (n *node) childElement(tag string) *node {
+	for c := n.firstChild; c != nil; c = c.next {
+		if c.nodeType == elementNode && c.tag == tag {
+			return c
+		}
+	}
+	return nil
+}
+
+// attributes
+
+func (n *node) attr(key string) (string, bool) {
+	for _, a := range n.attrs {
+		if a.Key == key {
+			return a.Val, true
+		}
+	}
+	return "", false
+}
+
+func (n *node) attrVal(key string) string {
+	ret, _ := n.attr(key)
+	return ret
+}
+
+func (n *node) setAttr(key, value string) {
+	for i := range n.attrs {
+		if n.attrs[i].Key == key {
+			n.attrs[i].Val = value
+			return
+		}
+	}
+	n.attrs = append(n.attrs, html.Attribute{Key: key, Val: value})
+}
+
+func (n *node) removeAttr(key string) {
+	for i := range n.attrs {
+		if n.attrs[i].Key == key {
+			n.attrs = append(n.attrs[:i], n.attrs[i+1:]...)
+			return
+		}
+	}
+}
+
+func (n *node) setBoolAttr(key string, value bool) {
+	if value {
+		n.setAttr(key, "")
+	} else {
+		n.removeAttr(key)
+	}
+}
+
+// text content
+
+func (n *node) textContent() string {
+	switch n.nodeType {
+	case textNode, commentNode:
+		return n.data
+	case documentNode, doctypeNode:
+		return ""
+	}
+	var b strings.Builder
+	n.walk(func(d *node) bool {
+		if d.nodeType == textNode {
+			b.WriteString(d.data)
+		}
+		return true
+	})
+	return b.String()
+}
+
+func (n *node) setTextContent(s string) {
+	switch n.nodeType {
+	case textNode, commentNode:
+		n.data = s
+	case elementNode, documentFragmentNode:
+		for n.firstChild != nil {
+			n.firstChild.detach()
+		}
+		if s != "" {
+			n.appendChild(newNode(textNode, "", s))
+		}
+	}
+}
+
+// form controls
+
+func (n *node) isElement(tags ...string) bool {
+	if n.nodeType != elementNode {
+		return false
+	}
+	for _, tag := range tags {
+		if n.tag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *node) inputType() string {
+	if !n.isElement("input") {
+		return ""
+	}
+	if t, ok := n.attr("type"); ok {
+		return strings.ToLower(t)
+	}
+	return "text"
+}
+
+func (n *node) isCheckable() bool {
+	t := n.inputType()
+	return t == "checkbox" || t == "radio"
+}
+
+func (n *node) isChecked() bool {
+	if n.checked != nil {
+		return *n.checked
+	}
+	_, ok := n.attr("checked")
+	return ok
+}
+
+// setChecked sets the checkedness of a checkbox or radio button. Other radio
+// buttons of the same group are unchecked.
+func (n *node) setChecked(value bool) {
+	n.checked = &value
+	if value && n.inputType() == "radio" {
+		name := n.attrVal("name")
+		if name == "" {
+			return
+		}
+		root := n.closest(func(c *node) bool { return c.isElement("form") })
+		if root == nil {
+			for root = n; root.parent != nil; root = root.parent {
+			}
+		}
+		root.walk(func(d *node) bool {
+			if d != n && d.inputType() == "radio" && d.attrVal("name") == name {
+				f := false
+				d.checked = &f
+			}
+			return true
+		})
+	}
+}
+
+func (n *node) options() []*node {
+	var ret []*node
+	n.walk(func(d *node) bool {
+		if d.isElement("option") {
+			ret = append(ret, d)
+		}
+		return true
+	})
+	return ret
+}
+
+func (n *node) isSelected() bool {
+	if n.selected != nil {
+		return *n.selected
+	}
+	_, ok := n.attr("selected")
+	return ok
+}
+
+func (n *node) getValue() string {
+	switch {
+	case n.isElement("input"):
+		if n.value != nil {
+			return *n.value
+		}
+		if v, ok := n.attr("value"); ok {
+			return v
+		}
+		if n.isCheckable() {
+			return "on"
+		}
+		return ""
+	case n.isElement("textarea"):
+		if n.value != nil {
+			return *n.value
+		}
+		return n.textContent()
+	case n.isElement("select"):
+		options := n.options()
+		for _, o := range options {
+			if o.isSelected() {
+				return o.getValue()
+			}
+		}
+		if _, multiple := n.attr("multiple"); !multiple && len(options) > 0 {
+			return options[0].getValue()
+		}
+		return ""
+	case n.isElement("option"):
+		if v, ok := n.attr("value"); ok {
+			return v
+		}
+		return strings.TrimSpace(n.textContent())
+	default:
+		return n.attrVal("value")
+	}
+}
+
+func (n *node) setValue(s string) {
+	switch {
+	case n.isElement("input", "textarea"):
+		n.value = &s
+	case n.isElement("select"):
+		found := false
+		for _, o := range n.options() {
+			sel := !found && o.getValue() == s
+			found = found || sel
+			o.selected = &sel
+		}
+	default:
+		n.setAttr("value", s)
+	}
+}
+
+func (n *node) closest(pred func(c *node) bool) *node {
+	for cur := n; cur != nil; cur = cur.parent {
+		if pred(cur) {
+			return cur
+		}
+	}
+	return nil
+}
+
+// reflectedAttrs maps properties to the attributes they reflect.
+var reflectedAttrs = map[string]string{
+	"id": "id", "className": "class", "title": "title", "name": "name",
+	"href": "href", "src": "src", "alt": "alt", "placeholder": "placeholder",
+	"htmlFor": "for", "lang": "lang", "action": "action", "method": "method",
+	"min": "min", "max": "max", "step": "step", "rel": "rel", "target": "target",
+	"defaultValue": "value",
+}
+
+// booleanAttrs are properties that reflect the presence of an attribute.
+var booleanAttrs = map[string]string{
+	"disabled": "disabled", "hidden": "hidden", "required": "required",
+	"readOnly": "readonly", "multiple": "multiple", "autofocus": "autofocus",
+	"defaultChecked": "checked",
+}
+
+func (n *node) get(name string) Value {
+	switch name {
+	case "nodeType":
+		return ValueOf(n.nodeType)
+	case "nodeName":
+		switch n.nodeType {
+		case elementNode:
+			return ValueOf(strings.ToUpper(n.tag))
+		case textNode:
+			return ValueOf("#text")
+		case commentNode:
+			return ValueOf("#comment")
+		case documentNode:
+			return ValueOf("#document")
+		case documentFragmentNode:
+			return ValueOf("#document-fragment")
+		default:
+			return ValueOf(n.tag)
+		}
+	case "parentNode":
+		return nodeValue(n.parent)
+	case "parentElement":
+		if n.parent != nil && n.parent.nodeType == elementNode {
+			return nodeValue(n.parent)
+		}
+		return Null()
+	case "firstChild":
+		return nodeValue(n.firstChild)
+	case "lastChild":
+		return nodeValue(n.lastChild)
+	case "nextSibling":
+		return nodeValue(n.next)
+	case "previousSibling":
+		return nodeValue(n.prev)
+	case "childNodes":
+		return Value{v: &nodeList{parent: n}}
+	case "children":
+		return Value{v: &nodeList{parent: n, elementsOnly: true}}
+	case "firstElementChild":
+		for c := n.firstChild; c != nil; c = c.next {
+			if c.nodeType == elementNode {
+				return nodeValue(c)
+			}
+		}
+		return Null()
+	case "ownerDocument":
+		return nodeValue(n.document())
+	case "isConnected":
+		return ValueOf(n.document() != nil)
+	case "textContent":
+		if n.nodeType == documentNode || n.nodeType == doctypeNode {
+			return Null()
+		}
+		return ValueOf(n.textContent())
+	}
+	switch n.nodeType {
+	case textNode, commentNode:
+		switch name {
+		case "data", "nodeValue":
+			return ValueOf(n.data)
+		case "length":
+			return ValueOf(len(n.data))
+		}
+	case documentNode:
+		root := n.childElement("html")
+		switch name {
+		case "documentElement":
+			return nodeValue(root)
+		case "head", "body":
+			if root == nil {
+				return Null()
+			}
+			return nodeValue(root.childElement(name))
+		}
+	case elementNode:
+		if v, ok := n.getElementProperty(name); ok {
+			return v
+		}
+	}
+	if v, ok := n.props[name]; ok {
+		return v
+	}
+	return Undefined()
+}
+
+func (n *node) getElementProperty(name string) (Value, bool) {
+	switch name {
+	case "tagName":
+		return ValueOf(strings.ToUpper(n.tag)), true
+	case "localName":
+		return ValueOf(n.tag), true
+	case "innerHTML":
+		return ValueOf(innerHTML(n)), true
+	case "outerHTML":
+		return ValueOf(outerHTML(n)), true
+	case "classList":
+		return Value{v: &classList{n}}, true
+	case "style":
+		return Value{v: &style{n}}, true
+	case "dataset":
+		return Value{v: &dataset{n}}, true
+	case "content":
+		if n.content != nil {
+			return nodeValue(n.content), true
+		}
+	case "value":
+		if n.isElement("input", "textarea", "select", "option", "button") {
+			return ValueOf(n.getValue()), true
+		}
+	case "checked":
+		if n.isElement("input") {
+			return ValueOf(n.isChecked()), true
+		}
+	case "selected":
+		if n.isElement("option") {
+			return ValueOf(n.isSelected()), true
+		}
+	case "type":
+		if n.isElement("input") {
+			return ValueOf(n.inputType()), true
+		}
+		if n.isElement("button") {
+			if t, ok := n.attr("type"); ok {
+				return ValueOf(strings.ToLower(t)), true
+			}
+			return ValueOf("submit"), true
+		}
+		if n.isElement("select") {
+			if _, ok := n.attr("multiple"); ok {
+				return ValueOf("select-multiple"), true
+			}
+			return ValueOf("select-one"), true
+		}
+		if n.isElement("textarea") {
+			return ValueOf("textarea"), true
+		}
+		return ValueOf(n.attrVal("type")), true
+	case "options":
+		if n.isElement("select") {
+			a := newArray()
+			for _, o := range n.options() {
+				a.items = append(a.items, nodeValue(o))
+			}
+			return Value{v: a}, true
+		}
+	case "files":
+		if n.isElement("input") && n.inputType() == "file" {
+			// files can only be given by setting the property.
+			if v, ok := n.props["files"]; ok {
+				return v, true
+			}
+			return Value{v: newArray()}, true
+		}
+	case "elements":
+		if n.isElement("form") {
+			return Value{v: &formElements{n}}, true
+		}
+	case "form":
+		return nodeValue(n.closest(func(c *node) bool { return c.isElement("form") })), true
+	}
+	if n.isElement("a") {
+		if v, ok := n.anchorProperty(name); ok {
+			return v, true
+		}
+	}
+	if attr, ok := reflectedAttrs[name]; ok {
+		return ValueOf(n.attrVal(attr)), true
+	}
+	if attr, ok := booleanAttrs[name]; ok {
+		_, present := n.attr(attr)
+		return ValueOf(present), true
+	}
+	return Value{}, false
+}
+
+func (n *node) set(name string, v Value) {
+	switch name {
+	case "textContent":
+		n.setTextContent(jsString(v))
+		return
+	}
+	switch n.nodeType {
+	case textNode, commentNode:
+		if name == "data" || name == "nodeValue" {
+			n.data = jsString(v)
+			return
+		}
+	case elementNode:
+		switch name {
+		case "innerHTML":
+			setInnerHTML(n, jsString(v))
+			return
+		case "value":
+			n.setValue(jsString(v))
+			return
+		case "checked":
+			if n.isElement("input") {
+				n.setChecked(v.Truthy())
+				return
+			}
+		case "selected":
+			if n.isElement("option") {
+				s := v.Truthy()
+				n.selected = &s
+				return
+			}
+		}
+		if attr, ok := reflectedAttrs[name]; ok {
+			n.setAttr(attr, jsString(v))
+			return
+		}
+		if attr, ok := booleanAttrs[name]; ok {
+			n.setBoolAttr(attr, v.Truthy())
+			return
+		}
+	}
+	if n.props == nil {
+		n.props = make(map[string]Value)
+	}
+	n.props[name] = v
+}
+
+func (n *node) remove(name string) {
+	delete(n.props, name)
+}
+
+func (n *node) call(name string, args []Value) (Value, bool) {
+	switch name {
+	case "appendChild":
+		c := argNode(args, 0, "appendChild")
+		n.appendChild(c)
+		return args[0], true
+	case "insertBefore":
+		c := argNode(args, 0, "insertBefore")
+		n.insertBefore(c, toNode(arg(args, 1)))
+		return args[0], true
+	case "removeChild":
+		c := argNode(args, 0, "removeChild")
+		n.removeChild(c)
+		return args[0], true
+	case "replaceChild":
+		c, old := argNode(args, 0, "replaceChild"), argNode(args, 1, "replaceChild")
+		if old.parent != n {
+			panic(domError("NotFoundError", "the node to be replaced is not a child of this node"))
+		}
+		if c != old {
+			n.insertBefore(c, old)
+			old.detach()
+		}
+		return args[1], true
+	case "remove":
+		n.detach()
+		return Undefined(), true
+	case "cloneNode":
+		return nodeValue(n.clone(arg(args, 0).Truthy())), true
+	case "contains":
+		other := toNode(arg(args, 0))
+		return ValueOf(other != nil && n.isAncestorOf(other)), true
+	case "hasChildNodes":
+		return ValueOf(n.firstChild != nil), true
+	case "getAttribute":
+		if v, ok := n.attr(strings.ToLower(jsString(arg(args, 0)))); ok {
+			return ValueOf(v), true
+		}
+		return Null(), true
+	case "setAttribute":
+		n.setAttr(strings.ToLower(jsString(arg(args, 0))), jsString(arg(args, 1)))
+		return Undefined(), true
+	case "removeAttribute":
+		n.removeAttr(strings.ToLower(jsString(arg(args, 0))))
+		return Undefined(), true
+	case "hasAttribute":
+		_, ok := n.attr(strings.ToLower(jsString(arg(args, 0))))
+		return ValueOf(ok), true
+	case "matches":
+		return ValueOf(compileSelector(jsString(arg(args, 0))).matches(n)), true
+	case "closest":
+		sel := compileSelector(jsString(arg(args, 0)))
+		return nodeValue(n.closest(func(c *node) bool {
+			return c.nodeType == elementNode && sel.matches(c)
+		})), true
+	case "querySelector":
+		return nodeValue(querySelector(n, jsString(arg(args, 0)))), true
+	case "querySelectorAll":
+		a := newArray()
+		for _, m := range querySelectorAll(n, jsString(arg(args, 0))) {
+			a.items = append(a.items, nodeValue(m))
+		}
+		return Value{v: a}, true
+	case "getElementById":
+		id := jsString(arg(args, 0))
+		var ret *node
+		n.walk(func(d *node) bool {
+			if d.nodeType == elementNode && d.attrVal("id") == id {
+				ret = d
+				return false
+			}
+			return true
+		})
+		return nodeValue(ret), true
+	case "addEventListener":
+		n.listeners.add(args)
+		return Undefined(), true
+	case "removeEventListener":
+		n.listeners.remove(args)
+		return Undefined(), true
+	case "dispatchEvent":
+		e, ok := arg(args, 0).v.(*event)
+		if !ok {
+			panic(&ValueError{"dispatchEvent", arg(args, 0).Type()})
+		}
+		return ValueOf(dispatch(n, e)), true
+	case "click":
+		dispatch(n, newEvent("click", true, true))
+		return Undefined(), true
+	case "requestSubmit":
+		if n.isElement("form") {
+			dispatch(n, newEvent("submit", true, true))
+		}
+		return Undefined(), true
+	case "submit", "focus", "blur":
+		return Undefined(), true
+	}
+	if n.nodeType == documentNode {
+		switch name {
+		case "createElement":
+			return nodeValue(newNode(elementNode,
+				strings.ToLower(jsString(arg(args, 0))), "")), true
+		case "createTextNode":
+			return nodeValue(newNode(textNode, "", jsString(arg(args, 0)))), true
+		case "createComment":
+			return nodeValue(newNode(commentNode, "", jsString(arg(args, 0)))), true
+		case "createDocumentFragment":
+			return nodeValue(newNode(documentFragmentNode, "", "")), true
+		}
+	}
+	return Undefined(), false
+}
+
+func argNode(args []Value, i int, method string) *node {
+	n := toNode(arg(args, i))
+	if n == nil {
+		panic(domError("TypeError", method+": parameter "+
+			strconv.Itoa(i+1)+" is not of type 'Node'"))
+	}
+	return n
+}
+
+// nodeList is a live list of the children of a node.
+type nodeList struct {
+	parent       *node
+	elementsOnly bool
+}
+
+func (l *nodeList) items() []*node {
+	var ret []*node
+	for c := l.parent.firstChild; c != nil; c = c.next {
+		if !l.elementsOnly || c.nodeType == elementNode {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+func (l *nodeList) get(name string) Value {
+	items := l.items()
+	if name == "length" {
+		return ValueOf(len(items))
+	}
+	if i, err := strconv.Atoi(name); err == nil && i >= 0 && i < len(items) {
+		return nodeValue(items[i])
+	}
+	return Undefined()
+}
+
+func (l *nodeList) set(name string, v Value) {}
+
+func (l *nodeList) remove(name string) {}
+
+func (l *nodeList) call(name string, args []Value) (Value, bool) {
+	if name == "item" {
+		ret := l.get(jsString(arg(args, 0)))
+		if ret.IsUndefined() {
+			return Null(), true
+		}
+		return ret, true
+	}
+	return Undefined(), false
+}
+
+// classList is the DOMTokenList of an element's classes.
+type classList struct {
+	n *node
+}
+
+func (c *classList) classes() []string {
+	return strings.Fields(c.n.attrVal("class"))
+}
+
+func (c *classList) contains(name string) bool {
+	for _, item := range c.classes() {
+		if item == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *classList) get(name string) Value {
+	classes := c.classes()
+	if name == "length" {
+		return ValueOf(len(classes))
+	}
+	if name == "value" {
+		return ValueOf(c.n.attrVal("class"))
+	}
+	if i, err := strconv.Atoi(name); err == nil && i >= 0 && i < len(classes) {
+		return ValueOf(classes[i])
+	}
+	return Undefined()
+}
+
+func (c *classList) set(name string, v Value) {
+	if name == "value" {
+		c.n.setAttr("class", jsString(v))
+	}
+}
+
+func (c *classList) remove(name string) {}
+
+func (c *classList) call(name string, args []Value) (Value, bool) {
+	switch name {
+	case "contains":
+		return ValueOf(c.contains(jsString(arg(args, 0)))), true
+	case "add":
+		classes := c.classes()
+		for _, a := range args {
+			if !c.contains(jsString(a)) {
+				classes = append(classes, jsString(a))
+				c.n.setAttr("class", strings.Join(classes, " "))
+			}
+		}
+		return Undefined(), true
+	case "remove":
+		classes := c.classes()
+		for _, a := range args {
+			for i := 0; i < len(classes); i++ {
+				if classes[i] == jsString(a) {
+					classes = append(classes[:i], classes[i+1:]...)
+					i--
+				}
+			}
+		}
+		if _, ok := c.n.attr("class"); ok {
+			c.n.setAttr("class", strings.Join(classes, " "))
+		}
+		return Undefined(), true
+	case "toggle":
+		name := jsString(arg(args, 0))
+		add := !c.contains(name)
+		if force := arg(args, 1); !force.IsUndefined() {
+			add = force.Truthy()
+		}
+		if add {
+			c.call("add", args[:1])
+		} else {
+			c.call("remove", args[:1])
+		}
+		return ValueOf(add), true
+	}
+	return Undefined(), false
+}
+
+// style is the CSSStyleDeclaration of an element. It operates on the style
+// attribute.
+type style struct {
+	n *node
+}
+
+type styleItem struct {
+	name, value string
+}
+
+func (s *style) items() []styleItem {
+	var ret []styleItem
+	for _, decl := range strings.Split(s.n.attrVal("style"), ";") {
+		colon := strings.IndexByte(decl, ':')
+		if colon == -1 {
+			continue
+		}
+		ret = append(ret, styleItem{strings.TrimSpace(decl[:colon]),
+			strings.TrimSpace(decl[colon+1:])})
+	}
+	return ret
+}
+
+func (s *style) store(items []styleItem) {
+	if len(items) == 0 {
+		s.n.removeAttr("style")
+		return
+	}
+	parts := make([]string, len(items))
+	for i, item := range items {
+		parts[i] = item.name + ": " + item.value + ";"
+	}
+	s.n.setAttr("style", strings.Join(parts, " "))
+}
+
+func (s *style) getProperty(name string) string {
+	for _, item := range s.items() {
+		if item.name == name {
+			return item.value
+		}
+	}
+	return ""
+}
+
+func (s *style) setProperty(name, value string) {
+	items := s.items()
+	for i := range items {
+		if items[i].name == name {
+			if value == "" {
+				items = append(items[:i], items[i+1:]...)
+			} else {
+				items[i].value = value
+			}
+			s.store(items)
+			return
+		}
+	}
+	if value != "" {
+		s.store(append(items, styleItem{name, value}))
+	}
+}
+
+func (s *style) get(name string) Value {
+	switch name {
+	case "cssText":
+		return ValueOf(s.n.attrVal("style"))
+	case "length":
+		return ValueOf(len(s.items()))
+	}
+	return ValueOf(s.getProperty(cssName(name)))
+}
+
+func (s *style) set(name string, v Value) {
+	if name == "cssText" {
+		s.n.setAttr("style", jsString(v))
+		return
+	}
+	value := ""
+	if !v.IsNull() && !v.IsUndefined() {
+		value = jsString(v)
+	}
+	s.setProperty(cssName(name), value)
+}
+
+func (s *style) remove(name string) {}
+
+func (s *style) call(name string, args []Value) (Value, bool) {
+	switch name {
+	case "getPropertyValue":
+		return ValueOf(s.getProperty(jsString(arg(args, 0)))), true
+	case "setProperty":
+		s.setProperty(jsString(arg(args, 0)), jsString(arg(args, 1)))
+		return Undefined(), true
+	case "removeProperty":
+		prev := s.getProperty(jsString(arg(args, 0)))
+		s.setProperty(jsString(arg(args, 0)), "")
+		return ValueOf(prev), true
+	}
+	return Undefined(), false
+}
+
+// cssName converts a camelCase property name of CSSStyleDeclaration to the
+// name of the CSS property.
+func cssName(name string) string {
+	if name == "cssFloat" {
+		return "float"
+	}
+	return kebabCase(name)
+}
+
+func kebabCase(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if r >= 'A' && r <= 'Z' {
+			b.WriteByte('-')
+			b.WriteRune(r - 'A' + 'a')
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// dataset is the DOMStringMap of an element's data attributes.
+type dataset struct {
+	n *node
+}
+
+func (d *dataset) get(name string) Value {
+	if v, ok := d.n.attr("data-" + kebabCase(name)); ok {
+		return ValueOf(v)
+	}
+	return Undefined()
+}
+
+func (d *dataset) set(name string, v Value) {
+	d.n.setAttr("data-"+kebabCase(name), jsString(v))
+}
+
+func (d *dataset) remove(name string) {
+	d.n.removeAttr("data-" + kebabCase(name))
+}
+
+// formElements is the HTMLFormControlsCollection of a form.
+type formElements struct {
+	form *node
+}
+
+func (f *formElements) controls() []*node {
+	var ret []*node
+	f.form.walk(func(d *node) bool {
+		if d.isElement("input", "select", "textarea", "button", "fieldset", "output") {
+			ret = append(ret, d)
+		}
+		return true
+	})
+	return ret
+}
+
+func (f *formElements) get(name string) Value {
+	controls := f.controls()
+	if name == "length" {
+		return ValueOf(len(controls))
+	}
+	if i, err := strconv.Atoi(name); err == nil {
+		if i >= 0 && i < len(controls) {
+			return nodeValue(controls[i])
+		}
+		return Undefined()
+	}
+	var matching []*node
+	for _, c := range controls {
+		if c.attrVal("id") == name || c.attrVal("name") == name {
+			matching = append(matching, c)
+		}
+	}
+	switch len(matching) {
+	case 0:
+		return Undefined()
+	case 1:
+		return nodeValue(matching[0])
+	default:
+		return Value{v: &radioNodeList{matching}}
+	}
+}
+
+func (f *formElements) set(name string, v Value) {}
+
+func (f *formElements) remove(name string) {}
+
+// radioNodeList is a list of form controls with the same name.
+type radioNodeList struct {
+	items []*node
+}
+
+func (l *radioNodeList) get(name string) Value {
+	switch name {
+	case "length":
+		return ValueOf(len(l.items))
+	case "value":
+		for _, item := range l.items {
+			if item.inputType() == "radio" && item.isChecked() {
+				return ValueOf(item.getValue())
+			}
+		}
+		return ValueOf("")
+	}
+	if i, err := strconv.Atoi(name); err == nil && i >= 0 && i < len(l.items) {
+		return nodeValue(l.items[i])
+	}
+	return Undefined()
+}
+
+func (l *radioNodeList) set(name string, v Value) {
+	if name == "value" {
+		for _, item := range l.items {
+			if item.inputType() == "radio" && item.getValue() == jsString(v) {
+				item.setChecked(true)
+				return
+			}
+		}
+	}
+}
+
+func (l *radioNodeList) remove(name string) {}
@@ -0,0 +1,218 @@
+//go:build !js
+// +build !js
+
+package js
+
+import "testing"
+
+func document() Value {
+	return Global().Get("document")
+}
+
+// fragment creates a <div> with the given inner HTML that is not attached to
+// the document.
+func fragment(t *testing.T, content string) Value {
+	t.Helper()
+	div := document().Call("createElement", "div")
+	div.Set("innerHTML", content)
+	return div
+}
+
+func childTags(n Value) []string {
+	var ret []string
+	for c := n.Get("firstChild"); !c.IsNull(); c = c.Get("nextSibling") {
+		ret = append(ret, c.Get("nodeName").String())
+	}
+	return ret
+}
+
+func expectTags(t *testing.T, n Value, expected ...string) {
+	t.Helper()
+	actual := childTags(n)
+	if len(actual) != len(expected) {
+		t.Fatalf("expected children %v, got %v", expected, actual)
+	}
+	for i := range actual {
+		if actual[i] != expected[i] {
+			t.Fatalf("expected children %v, got %v", expected, actual)
+		}
+	}
+	// the backwards links must be consistent with the forward links.
+	i := len(actual) - 1
+	for c := n.Get("lastChild"); !c.IsNull(); c = c.Get("previousSibling") {
+		if i < 0 || c.Get("nodeName").String() != actual[i] {
+			t.Fatalf("previousSibling chain does not match %v", actual)
+		}
+		if !c.Get("parentNode").Equal(n) {
+			t.Fatalf("parentNode of %s is wrong", actual[i])
+		}
+		i--
+	}
+	if i != -1 {
+		t.Fatalf("previousSibling chain is shorter than %v", actual)
+	}
+}
+
+func TestAppendAndInsert(t *testing.T) {
+	doc := document()
+	root := doc.Call("createElement", "div")
+	a := doc.Call("createElement", "a")
+	b := doc.Call("createElement", "b")
+	i := doc.Call("createElement", "i")
+	root.Call("appendChild", a)
+	root.Call("appendChild", b)
+	root.Call("insertBefore", i, b)
+	expectTags(t, root, "A", "I", "B")
+	root.Call("insertBefore", b, a)
+	expectTags(t, root, "B", "A", "I")
+	root.Call("insertBefore", a, nil)
+	expectTags(t, root, "B", "I", "A")
+	if root.Get("childNodes").Length() != 3 {
+		t.Fatalf("unexpected childNodes length")
+	}
+}
+
+func TestMoveBetweenParents(t *testing.T) {
+	doc := document()
+	first, second := doc.Call("createElement", "div"), doc.Call("createElement", "div")
+	span := doc.Call("createElement", "span")
+	first.Call("appendChild", span)
+	second.Call("appendChild", span)
+	expectTags(t, first)
+	expectTags(t, second, "SPAN")
+	if !span.Get("parentNode").Equal(second) {
+		t.Fatalf("span has not been moved")
+	}
+}
+
+func TestRemoveAndReplace(t *testing.T) {
+	root := fragment(t, "<a></a><b></b><i></i>")
+	b := root.Call("querySelector", "b")
+	root.Call("removeChild", b)
+	expectTags(t, root, "A", "I")
+	if !b.Get("parentNode").IsNull() || !b.Get("nextSibling").IsNull() {
+		t.Fatalf("removed node still has links")
+	}
+	root.Call("replaceChild", b, root.Call("querySelector", "a"))
+	expectTags(t, root, "B", "I")
+	root.Call("querySelector", "i").Call("remove")
+	expectTags(t, root, "B")
+}
+
+func TestDocumentFragment(t *testing.T) {
+	doc := document()
+	root := fragment(t, "<b></b>")
+	frag := doc.Call("createDocumentFragment")
+	frag.Call("appendChild", doc.Call("createElement", "a"))
+	frag.Call("appendChild", doc.Call("createTextNode", "text"))
+	root.Call("insertBefore", frag, root.Get("firstChild"))
+	expectTags(t, root, "A", "#text", "B")
+	expectTags(t, frag)
+}
+
+func TestInsertErrors(t *testing.T) {
+	expectError := func(name string, f func()) {
+		t.Helper()
+		defer func() {
+			t.Helper()
+			err, ok := recover().(Error)
+			if !ok {
+				t.Fatalf("expected %s", name)
+			}
+			if n := err.Get("name").String(); n != name {
+				t.Fatalf("expected %s, got %s", name, n)
+			}
+		}()
+		f()
+	}
+	root := fragment(t, "<div><span></span></div>")
+	inner := root.Get("firstChild")
+	expectError("HierarchyRequestError", func() {
+		inner.Call("appendChild", root)
+	})
+	expectError("NotFoundError", func() {
+		root.Call("insertBefore", document().Call("createElement", "a"),
+			inner.Get("firstChild"))
+	})
+	expectError("NotFoundError", func() {
+		root.Call("removeChild", inner.Get("firstChild"))
+	})
+}
+
+func TestCloneNode(t *testing.T) {
+	root := fragment(t, `<p class="x">a<b>b</b></p>`)
+	p := root.Get("firstChild")
+	shallow := p.Call("cloneNode", false)
+	if shallow.Call("hasChildNodes").Bool() ||
+		shallow.Call("getAttribute", "class").String() != "x" {
+		t.Fatalf("unexpected shallow clone %s", shallow.Get("outerHTML").String())
+	}
+	deep := p.Call("cloneNode", true)
+	if h := deep.Get("outerHTML").String(); h != `<p class="x">a<b>b</b></p>` {
+		t.Fatalf("unexpected deep clone %s", h)
+	}
+	deep.Get("lastChild").Set("textContent", "c")
+	if p.Get("textContent").String() != "ab" {
+		t.Fatalf("modifying the clone modified the original")
+	}
+}
+
+func TestTemplateContent(t *testing.T) {
+	tmpl := document().Call("createElement", "template")
+	tmpl.Set("innerHTML", "<a></a><b></b>")
+	expectTags(t, tmpl)
+	content := tmpl.Get("content")
+	expectTags(t, content, "A", "B")
+	clone := content.Call("cloneNode", true)
+	expectTags(t, clone, "A", "B")
+	expectTags(t, content, "A", "B")
+}
+
+func TestTextContentAndHTML(t *testing.T) {
+	root := fragment(t, "<p>a<b>b</b></p>c")
+	if s := root.Get("textContent").String(); s != "abc" {
+		t.Fatalf("unexpected textContent %q", s)
+	}
+	root.Get("firstChild").Set("textContent", "<x>")
+	if h := root.Get("innerHTML").String(); h != "<p>&lt;x&gt;</p>c" {
+		t.Fatalf("unexpected innerHTML %q", h)
+	}
+}
+
+func TestAttributes(t *testing.T) {
+	el := document().Call("createElement", "input")
+	el.Call("setAttribute", "data-foo-bar", "1")
+	if el.Get("dataset").Get("fooBar").String() != "1" {
+		t.Fatalf("dataset does not reflect the attribute")
+	}
+	el.Get("classList").Call("add", "a", "b")
+	el.Get("classList").Call("toggle", "a")
+	if c := el.Call("getAttribute", "class").String(); c != "b" {
+		t.Fatalf("unexpected class %q", c)
+	}
+	el.Get("style").Set("backgroundColor", "red")
+	if s := el.Call("getAttribute", "style").String(); s != "background-color: red;" {
+		t.Fatalf("unexpected style %q", s)
+	}
+	el.Call("removeAttribute", "data-foo-bar")
+	if el.Call("hasAttribute", "data-foo-bar").Bool() {
+		t.Fatalf("attribute has not been removed")
+	}
+}
+
+func TestFormControlState(t *testing.T) {
+	root := fragment(t, `<input type="text" value="default"><input type="checkbox" checked>`)
+	text := root.Call("querySelector", `[type="text"]`)
+	text.Set("value", "changed")
+	if text.Call("getAttribute", "value").String() != "default" {
+		t.Fatalf("setting value changed the attribute")
+	}
+	box := root.Call("querySelector", `[type="checkbox"]`)
+	if !box.Get("checked").Bool() {
+		t.Fatalf("checkbox is not checked by default")
+	}
+	box.Set("checked", false)
+	if !box.Call("hasAttribute", "checked").Bool() || box.Get("checked").Bool() {
+		t.Fatalf("checked state is not separate from the attribute")
+	}
+}
@@ -0,0 +1,245 @@
+//go:build !js
+// +build !js
+
+package js
+
+// eventClasses are the names of the event constructors in the global object.
+// They all create the same kind of event.
+var eventClasses = []string{"Event", "CustomEvent", "UIEvent", "MouseEvent",
+	"KeyboardEvent", "InputEvent", "FocusEvent", "SubmitEvent"}
+
+// event is a DOM event.
+type event struct {
+	plainObject
+	typ                       string
+	bubbles, cancelable       bool
+	defaultPrevented          bool
+	stopped, stoppedImmediate bool
+	phase                     int
+	// target and currentTarget are nodes or the window.
+	target, currentTarget Value
+}
+
+func newEvent(typ string, bubbles, cancelable bool) *event {
+	return &event{typ: typ, bubbles: bubbles, cancelable: cancelable}
+}
+
+func (e *event) get(name string) Value {
+	switch name {
+	case "type":
+		return ValueOf(e.typ)
+	case "bubbles":
+		return ValueOf(e.bubbles)
+	case "cancelable":
+		return ValueOf(e.cancelable)
+	case "defaultPrevented":
+		return ValueOf(e.defaultPrevented)
+	case "eventPhase":
+		return ValueOf(e.phase)
+	case "target":
+		return orNull(e.target)
+	case "currentTarget":
+		return orNull(e.currentTarget)
+	}
+	return e.plainObject.get(name)
+}
+
+func (e *event) call(name string, args []Value) (Value, bool) {
+	switch name {
+	case "preventDefault":
+		if e.cancelable {
+			e.defaultPrevented = true
+		}
+	case "stopPropagation":
+		e.stopped = true
+	case "stopImmediatePropagation":
+		e.stopped, e.stoppedImmediate = true, true
+	default:
+		return Undefined(), false
+	}
+	return Undefined(), true
+}
+
+// eventConstructor returns a constructor for events that takes the event type
+// and an optional dictionary with the properties of the event.
+func eventConstructor() Value {
+	return Value{v: &function{
+		construct: func(args []Value) Value {
+			e := newEvent(jsString(arg(args, 0)), false, false)
+			if init, ok := arg(args, 1).v.(*plainObject); ok {
+				for key, value := range init.props {
+					switch key {
+					case "bubbles":
+						e.bubbles = value.Truthy()
+					case "cancelable":
+						e.cancelable = value.Truthy()
+					default:
+						e.plainObject.set(key, value)
+					}
+				}
+			}
+			return Value{v: e}
+		},
+		instanceOf: func(v Value) bool {
+			_, ok := v.v.(*event)
+			return ok
+		},
+	}}
+}
+
+func listenerOptions(v Value) (capture, once bool) {
+	switch v.v.(type) {
+	case bool:
+		return v.Truthy(), false
+	case object:
+		return v.Get("capture").Truthy(), v.Get("once").Truthy()
+	}
+	return false, false
+}
+
+// orNull returns v, or null if v is undefined.
+func orNull(v Value) Value {
+	if v.IsUndefined() {
+		return Null()
+	}
+	return v
+}
+
+// listeners holds the event listeners registered on an event target.
+type listeners []listener
+
+func (ls *listeners) add(args []Value) {
+	typ, fn := jsString(arg(args, 0)), arg(args, 1)
+	if _, ok := fn.v.(*function); !ok {
+		return
+	}
+	capture, once := listenerOptions(arg(args, 2))
+	for _, l := range *ls {
+		if l.typ == typ && l.fn.Equal(fn) && l.capture == capture {
+			return
+		}
+	}
+	*ls = append(*ls, listener{typ, fn, capture, once})
+}
+
+func (ls *listeners) remove(args []Value) {
+	typ, fn := jsString(arg(args, 0)), arg(args, 1)
+	capture, _ := listenerOptions(arg(args, 2))
+	for i, l := range *ls {
+		if l.typ == typ && l.fn.Equal(fn) && l.capture == capture {
+			*ls = append((*ls)[:i:i], (*ls)[i+1:]...)
+			return
+		}
+	}
+}
+
+// invoke calls the listeners for e, with target as `this`. Depending on the
+// event phase, capturing and/or non-capturing listeners are called.
+func (ls *listeners) invoke(target Value, e *event, capture, bubble bool) {
+	e.currentTarget = target
+	current := append(listeners(nil), *ls...)
+	for _, l := range current {
+		if l.typ != e.typ || (l.capture && !capture) || (!l.capture && !bubble) {
+			continue
+		}
+		if l.once {
+			ls.remove([]Value{ValueOf(l.typ), l.fn, ValueOf(l.capture)})
+		}
+		l.fn.v.(*function).invoke(target, []Value{{v: e}})
+		if e.stoppedImmediate {
+			return
+		}
+	}
+}
+
+func (n *node) invokeListeners(e *event, capture, bubble bool) {
+	n.listeners.invoke(nodeValue(n), e, capture, bubble)
+}
+
+// dispatch dispatches e with target as target. Returns false if the event has
+// been canceled.
+//
+// click events trigger the activation behavior of checkboxes, radio buttons
+// and submit buttons.
+func dispatch(target *node, e *event) bool {
+	var activated *node
+	var prevChecked bool
+	if e.typ == "click" {
+		activated = target.closest(hasActivationBehavior)
+		if activated != nil && activated.isCheckable() {
+			prevChecked = activated.isChecked()
+			if activated.inputType() == "checkbox" {
+				activated.setChecked(!prevChecked)
+			} else {
+				activated.setChecked(true)
+			}
+		}
+	}
+
+	var path []*node
+	for cur := target; cur != nil; cur = cur.parent {
+		path = append(path, cur)
+	}
+	e.target = nodeValue(target)
+	e.stopped, e.stoppedImmediate = false, false
+	e.phase = 1
+	for i := len(path) - 1; i > 0 && !e.stopped; i-- {
+		path[i].invokeListeners(e, true, false)
+	}
+	if !e.stopped {
+		e.phase = 2
+		target.invokeListeners(e, true, true)
+	}
+	if e.bubbles {
+		e.phase = 3
+		for i := 1; i < len(path) && !e.stopped; i++ {
+			path[i].invokeListeners(e, false, true)
+		}
+	}
+	e.phase, e.currentTarget = 0, Undefined()
+
+	if activated != nil {
+		if activated.isCheckable() {
+			if e.defaultPrevented {
+				activated.checked = &prevChecked
+			} else {
+				dispatch(activated, newEvent("input", true, false))
+				dispatch(activated, newEvent("change", true, false))
+			}
+		} else if !e.defaultPrevented {
+			form := activated.closest(func(c *node) bool { return c.isElement("form") })
+			if isButton(activated, "reset") {
+				if dispatch(form, newEvent("reset", true, true)) {
+					resetForm(form)
+				}
+			} else {
+				dispatch(form, newEvent("submit", true, true))
+			}
+		}
+	}
+	return !e.defaultPrevented
+}
+
+func isButton(n *node, typ string) bool {
+	return (n.isElement("button") && n.get("type").String() == typ) ||
+		n.inputType() == typ
+}
+
+// hasActivationBehavior returns true for elements that do something when
+// clicked: checkboxes, radio buttons and submit and reset buttons inside a
+// form.
+func hasActivationBehavior(n *node) bool {
+	if n.isCheckable() {
+		return true
+	}
+	button := isButton(n, "submit") || isButton(n, "reset") || n.inputType() == "image"
+	return button && n.closest(func(c *node) bool { return c.isElement("form") }) != nil
+}
+
+// resetForm restores the initial state of all controls in the given form.
+func resetForm(form *node) {
+	form.walk(func(d *node) bool {
+		d.value, d.checked, d.selected = nil, nil, nil
+		return true
+	})
+}
@@ -0,0 +1,171 @@
+//go:build !js
+// +build !js
+
+package js
+
+import (
+	"strings"
+	"testing"
+)
+
+// recorder registers listeners that log the order in which they are called.
+type recorder struct {
+	log []string
+}
+
+func (r *recorder) listen(t *testing.T, target Value, name, typ string,
+	capture bool, action func(e Value)) Func {
+	t.Helper()
+	fn := FuncOf(func(this Value, args []Value) interface{} {
+		r.log = append(r.log, name)
+		if action != nil {
+			action(args[0])
+		}
+		return nil
+	})
+	target.Call("addEventListener", typ, fn, capture)
+	return fn
+}
+
+func (r *recorder) expect(t *testing.T, expected ...string) {
+	t.Helper()
+	if strings.Join(r.log, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected listener calls %v, got %v", expected, r.log)
+	}
+	r.log = nil
+}
+
+func newEventOf(typ string, bubbles bool) Value {
+	return Global().Get("Event").New(typ, map[string]interface{}{
+		"bubbles": bubbles, "cancelable": true})
+}
+
+func TestEventPropagation(t *testing.T) {
+	root := fragment(t, "<div><span></span></div>")
+	div := root.Get("firstChild")
+	span := div.Get("firstChild")
+	var r recorder
+	r.listen(t, root, "root-capture", "foo", true, nil)
+	r.listen(t, root, "root", "foo", false, nil)
+	r.listen(t, div, "div", "foo", false, func(e Value) {
+		if !e.Get("target").Equal(span) || !e.Get("currentTarget").Equal(div) {
+			t.Errorf("wrong target or currentTarget")
+		}
+	})
+	r.listen(t, span, "span", "foo", false, nil)
+
+	span.Call("dispatchEvent", newEventOf("foo", true))
+	r.expect(t, "root-capture", "span", "div", "root")
+
+	span.Call("dispatchEvent", newEventOf("foo", false))
+	r.expect(t, "root-capture", "span")
+
+	span.Call("dispatchEvent", newEventOf("bar", true))
+	r.expect(t)
+}
+
+func TestStopPropagation(t *testing.T) {
+	root := fragment(t, "<span></span>")
+	span := root.Get("firstChild")
+	var r recorder
+	r.listen(t, root, "root", "foo", false, nil)
+	r.listen(t, span, "first", "foo", false, func(e Value) {
+		e.Call("stopPropagation")
+	})
+	r.listen(t, span, "second", "foo", false, nil)
+	span.Call("dispatchEvent", newEventOf("foo", true))
+	r.expect(t, "first", "second")
+
+	r.listen(t, span, "immediate", "bar", false, func(e Value) {
+		e.Call("stopImmediatePropagation")
+	})
+	r.listen(t, span, "skipped", "bar", false, nil)
+	span.Call("dispatchEvent", newEventOf("bar", true))
+	r.expect(t, "immediate")
+}
+
+func TestRemoveEventListener(t *testing.T) {
+	root := fragment(t, "<span></span>")
+	var r recorder
+	fn := r.listen(t, root, "root", "foo", false, nil)
+	// adding the same listener twice has no effect.
+	root.Call("addEventListener", "foo", fn, false)
+	root.Call("dispatchEvent", newEventOf("foo", false))
+	r.expect(t, "root")
+	// removing with a different capture flag has no effect.
+	root.Call("removeEventListener", "foo", fn, true)
+	root.Call("dispatchEvent", newEventOf("foo", false))
+	r.expect(t, "root")
+	root.Call("removeEventListener", "foo", fn, false)
+	root.Call("dispatchEvent", newEventOf("foo", false))
+	r.expect(t)
+}
+
+func TestOnceListener(t *testing.T) {
+	root := fragment(t, "")
+	var r recorder
+	fn := FuncOf(func(this Value, args []Value) interface{} {
+		r.log = append(r.log, "once")
+		return nil
+	})
+	root.Call("addEventListener", "foo", fn, map[string]interface{}{"once": true})
+	root.Call("dispatchEvent", newEventOf("foo", false))
+	root.Call("dispatchEvent", newEventOf("foo", false))
+	r.expect(t, "once")
+}
+
+func TestPreventDefault(t *testing.T) {
+	root := fragment(t, "")
+	var r recorder
+	r.listen(t, root, "root", "foo", false, func(e Value) {
+		e.Call("preventDefault")
+	})
+	if root.Call("dispatchEvent", newEventOf("foo", false)).Bool() {
+		t.Fatalf("dispatchEvent did not report the canceled event")
+	}
+	notCancelable := Global().Get("Event").New("foo")
+	if !root.Call("dispatchEvent", notCancelable).Bool() ||
+		notCancelable.Get("defaultPrevented").Bool() {
+		t.Fatalf("non-cancelable event has been canceled")
+	}
+	r.expect(t, "root", "root")
+}
+
+func TestClickActivation(t *testing.T) {
+	root := fragment(t, `<form><input type="checkbox"><button>submit</button></form>`)
+	box := root.Call("querySelector", "input")
+	form := root.Get("firstChild")
+	var r recorder
+	r.listen(t, box, "change", "change", false, nil)
+	r.listen(t, form, "submit", "submit", false, func(e Value) {
+		e.Call("preventDefault")
+	})
+
+	box.Call("click")
+	if !box.Get("checked").Bool() {
+		t.Fatalf("click did not check the checkbox")
+	}
+	r.expect(t, "change")
+
+	cancel := r.listen(t, box, "cancel", "click", false, func(e Value) {
+		e.Call("preventDefault")
+	})
+	box.Call("click")
+	if !box.Get("checked").Bool() {
+		t.Fatalf("canceled click toggled the checkbox")
+	}
+	r.expect(t, "cancel")
+	box.Call("removeEventListener", "click", cancel, false)
+
+	root.Call("querySelector", "button").Call("click")
+	r.expect(t, "submit")
+}
+
+func TestWindowEvents(t *testing.T) {
+	var r recorder
+	win := Global()
+	fn := r.listen(t, win, "window", "popstate", false, nil)
+	defer win.Call("removeEventListener", "popstate", fn, false)
+	win.Call("dispatchEvent", newEventOf("popstate", false))
+	r.expect(t, "window")
+}
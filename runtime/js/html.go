@@ -0,0 +1,125 @@
+//go:build !js
+// +build !js
+
+package js
+
+import (
+	"strings"
+
+	"github.com/flyx/net/html"
+	"github.com/flyx/net/html/atom"
+)
+
+// fromHTML converts a node of the HTML parser to a DOM node.
+func fromHTML(h *html.Node) *node {
+	var ret *node
+	switch h.Type {
+	case html.TextNode:
+		return newNode(textNode, "", h.Data)
+	case html.CommentNode:
+		return newNode(commentNode, "", h.Data)
+	case html.DoctypeNode:
+		return newNode(doctypeNode, h.Data, "")
+	case html.DocumentNode:
+		ret = newNode(documentNode, "", "")
+	default:
+		ret = newNode(elementNode, h.Data, "")
+		ret.attrs = append([]html.Attribute(nil), h.Attr...)
+	}
+	parent := ret
+	if ret.content != nil {
+		parent = ret.content
+	}
+	for c := h.FirstChild; c != nil; c = c.NextSibling {
+		parent.appendChild(fromHTML(c))
+	}
+	return ret
+}
+
+// toHTML converts a DOM node to a node of the HTML parser for rendering.
+// Elements reflect the current state of form controls in their attributes.
+func toHTML(n *node) *html.Node {
+	var ret *html.Node
+	switch n.nodeType {
+	case textNode:
+		return &html.Node{Type: html.TextNode, Data: n.data}
+	case commentNode:
+		return &html.Node{Type: html.CommentNode, Data: n.data}
+	case doctypeNode:
+		return &html.Node{Type: html.DoctypeNode, Data: n.tag}
+	case documentNode, documentFragmentNode:
+		ret = &html.Node{Type: html.DocumentNode}
+	default:
+		ret = &html.Node{Type: html.ElementNode, Data: n.tag,
+			DataAtom: atom.Lookup([]byte(n.tag)), Attr: n.stateAttrs()}
+	}
+	children := n
+	if n.content != nil {
+		children = n.content
+	}
+	for c := children.firstChild; c != nil; c = c.next {
+		ret.AppendChild(toHTML(c))
+	}
+	return ret
+}
+
+// stateAttrs returns the attributes of n, with the state of form controls
+// written to the value, checked and selected attributes.
+func (n *node) stateAttrs() []html.Attribute {
+	if n.value == nil && n.checked == nil && n.selected == nil {
+		return n.attrs
+	}
+	tmp := &node{attrs: append([]html.Attribute(nil), n.attrs...)}
+	if n.value != nil && n.tag != "textarea" {
+		tmp.setAttr("value", *n.value)
+	}
+	if n.checked != nil {
+		tmp.setBoolAttr("checked", *n.checked)
+	}
+	if n.selected != nil {
+		tmp.setBoolAttr("selected", *n.selected)
+	}
+	return tmp.attrs
+}
+
+func render(h *html.Node) string {
+	var b strings.Builder
+	if err := html.Render(&b, h); err != nil {
+		panic(err)
+	}
+	return b.String()
+}
+
+func innerHTML(n *node) string {
+	var b strings.Builder
+	h := toHTML(n)
+	for c := h.FirstChild; c != nil; c = c.NextSibling {
+		b.WriteString(render(c))
+	}
+	return b.String()
+}
+
+func outerHTML(n *node) string {
+	return render(toHTML(n))
+}
+
+// setInnerHTML replaces the children of n with the result of parsing s in the
+// context of n.
+func setInnerHTML(n *node, s string) {
+	context := &html.Node{Type: html.ElementNode, Data: n.tag,
+		DataAtom: atom.Lookup([]byte(n.tag))}
+	nodes, err := html.ParseFragment(strings.NewReader(s), context)
+	if err != nil {
+		panic(domError("SyntaxError", err.Error()))
+	}
+	parent := n
+	if n.content != nil {
+		parent = n.content
+	}
+	for parent.firstChild != nil {
+		parent.firstChild.detach()
+	}
+	for _, h := range nodes {
+		parent.appendChild(fromHTML(h))
+	}
+}
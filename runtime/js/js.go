@@ -0,0 +1,58 @@
+//go:build js
+// +build js
+
+package js
+
+import "syscall/js"
+
+// Value is syscall/js.Value.
+type Value = js.Value
+
+// Func is syscall/js.Func.
+type Func = js.Func
+
+// Type is syscall/js.Type.
+type Type = js.Type
+
+// Error is syscall/js.Error.
+type Error = js.Error
+
+// ValueError is syscall/js.ValueError.
+type ValueError = js.ValueError
+
+// The types of JavaScript values.
+const (
+	TypeUndefined = js.TypeUndefined
+	TypeNull      = js.TypeNull
+	TypeBoolean   = js.TypeBoolean
+	TypeNumber    = js.TypeNumber
+	TypeString    = js.TypeString
+	TypeSymbol    = js.TypeSymbol
+	TypeObject    = js.TypeObject
+	TypeFunction  = js.TypeFunction
+)
+
+// Global returns the JavaScript global object.
+func Global() Value {
+	return js.Global()
+}
+
+// Undefined returns the JavaScript value "undefined".
+func Undefined() Value {
+	return js.Undefined()
+}
+
+// Null returns the JavaScript value "null".
+func Null() Value {
+	return js.Null()
+}
+
+// ValueOf returns x as a JavaScript value.
+func ValueOf(x interface{}) Value {
+	return js.ValueOf(x)
+}
+
+// FuncOf returns a function to be used by JavaScript.
+func FuncOf(fn func(this Value, args []Value) interface{}) Func {
+	return js.FuncOf(fn)
+}
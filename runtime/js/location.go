@@ -0,0 +1,224 @@
+//go:build !js
+// +build !js
+
+package js
+
+import "net/url"
+
+// initialURL is the URL of the document when the program starts.
+const initialURL = "http://localhost/"
+
+// window is the global object. Other than a plain object, it is an event
+// target and provides the location and the session history.
+type window struct {
+	plainObject
+	listeners listeners
+	history   *history
+}
+
+func (w *window) get(name string) Value {
+	switch name {
+	case "location":
+		return Value{v: &location{w.history}}
+	case "history":
+		return Value{v: w.history}
+	}
+	return w.plainObject.get(name)
+}
+
+func (w *window) call(name string, args []Value) (Value, bool) {
+	switch name {
+	case "addEventListener":
+		w.listeners.add(args)
+	case "removeEventListener":
+		w.listeners.remove(args)
+	case "dispatchEvent":
+		e, ok := arg(args, 0).v.(*event)
+		if !ok {
+			panic(&ValueError{"dispatchEvent", arg(args, 0).Type()})
+		}
+		return ValueOf(w.dispatch(e)), true
+	default:
+		return Undefined(), false
+	}
+	return Undefined(), true
+}
+
+// dispatch dispatches e with the window as target. Returns false if the event
+// has been canceled.
+func (w *window) dispatch(e *event) bool {
+	e.target = Value{v: w}
+	e.stopped, e.stoppedImmediate = false, false
+	e.phase = 2
+	w.listeners.invoke(e.target, e, true, true)
+	e.phase, e.currentTarget = 0, Undefined()
+	return !e.defaultPrevented
+}
+
+// historyEntry is an entry of the session history.
+type historyEntry struct {
+	url   *url.URL
+	state Value
+}
+
+// history is the session history. Unlike in the browser, traversing the
+// history with back, forward and go dispatches the popstate event
+// synchronously.
+type history struct {
+	w       *window
+	entries []historyEntry
+	index   int
+}
+
+func (h *history) current() *url.URL {
+	return h.entries[h.index].url
+}
+
+// resolve returns the given URL resolved against the current URL.
+func (h *history) resolve(ref string) (*url.URL, bool) {
+	u, err := url.Parse(ref)
+	if err != nil {
+		return nil, false
+	}
+	return h.current().ResolveReference(u), true
+}
+
+func (h *history) get(name string) Value {
+	switch name {
+	case "length":
+		return ValueOf(len(h.entries))
+	case "state":
+		return h.entries[h.index].state
+	}
+	return Undefined()
+}
+
+func (h *history) set(name string, v Value) {}
+
+func (h *history) remove(name string) {}
+
+func (h *history) call(name string, args []Value) (Value, bool) {
+	switch name {
+	case "pushState", "replaceState":
+		entry := historyEntry{url: h.current(), state: arg(args, 0)}
+		if ref := arg(args, 2); !ref.IsUndefined() && !ref.IsNull() {
+			u, ok := h.resolve(jsString(ref))
+			if !ok || u.Scheme != entry.url.Scheme || u.Host != entry.url.Host {
+				panic(domError("SecurityError", name+": URL `"+jsString(ref)+
+					"` cannot be used in a document with origin `"+origin(entry.url)+"`"))
+			}
+			entry.url = u
+		}
+		if name == "pushState" {
+			h.entries = append(h.entries[:h.index+1], entry)
+			h.index++
+		} else {
+			h.entries[h.index] = entry
+		}
+	case "back":
+		h.traverse(-1)
+	case "forward":
+		h.traverse(1)
+	case "go":
+		h.traverse(int(jsNumber(arg(args, 0))))
+	default:
+		return Undefined(), false
+	}
+	return Undefined(), true
+}
+
+// traverse moves delta entries through the history and dispatches popstate.
+// Does nothing if there is no such entry.
+func (h *history) traverse(delta int) {
+	target := h.index + delta
+	if delta == 0 || target < 0 || target >= len(h.entries) {
+		return
+	}
+	h.index = target
+	e := newEvent("popstate", false, false)
+	e.plainObject.set("state", h.entries[target].state)
+	h.w.dispatch(e)
+}
+
+// origin returns the origin of the given URL in its serialized form.
+func origin(u *url.URL) string {
+	return u.Scheme + "://" + u.Host
+}
+
+// urlProperty returns the given property of a Location or an <a> element
+// referring to u.
+func urlProperty(u *url.URL, name string) (Value, bool) {
+	switch name {
+	case "href":
+		return ValueOf(u.String()), true
+	case "origin":
+		return ValueOf(origin(u)), true
+	case "protocol":
+		return ValueOf(u.Scheme + ":"), true
+	case "host":
+		return ValueOf(u.Host), true
+	case "hostname":
+		return ValueOf(u.Hostname()), true
+	case "port":
+		return ValueOf(u.Port()), true
+	case "pathname":
+		if p := u.EscapedPath(); p != "" {
+			return ValueOf(p), true
+		}
+		return ValueOf("/"), true
+	case "search":
+		if u.RawQuery == "" {
+			return ValueOf(""), true
+		}
+		return ValueOf("?" + u.RawQuery), true
+	case "hash":
+		if u.Fragment == "" {
+			return ValueOf(""), true
+		}
+		return ValueOf("#" + u.EscapedFragment()), true
+	}
+	return Value{}, false
+}
+
+// location gives the current URL of the session history.
+type location struct {
+	h *history
+}
+
+func (l *location) get(name string) Value {
+	v, _ := urlProperty(l.h.current(), name)
+	return v
+}
+
+func (l *location) set(name string, v Value) {}
+
+func (l *location) remove(name string) {}
+
+// anchorProperty returns the URL properties of <a> elements. The `href`
+// attribute is resolved against the current URL.
+func (n *node) anchorProperty(name string) (Value, bool) {
+	if _, ok := urlProperty(globalWindow.history.current(), name); !ok {
+		return Value{}, false
+	}
+	ref, ok := n.attr("href")
+	if !ok {
+		return ValueOf(""), true
+	}
+	u, ok := globalWindow.history.resolve(ref)
+	if !ok {
+		if name == "href" {
+			return ValueOf(ref), true
+		}
+		return ValueOf(""), true
+	}
+	return urlProperty(u, name)
+}
+
+// newWindow creates the window with a session history containing the initial
+// URL.
+func newWindow() *window {
+	u, _ := url.Parse(initialURL)
+	w := &window{}
+	w.history = &history{w: w, entries: []historyEntry{{url: u, state: Null()}}}
+	return w
+}
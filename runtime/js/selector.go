@@ -0,0 +1,351 @@
+//go:build !js
+// +build !js
+
+package js
+
+import (
+	"strings"
+)
+
+// selector is a compiled list of CSS selectors. It supports type, universal,
+// id, class and attribute selectors, the pseudo-classes :checked, :disabled,
+// :first-child, :last-child and :not(), and all four combinators.
+type selector []complexSelector
+
+// complexSelector is a sequence of compound selectors joined by combinators.
+// The last compound selector matches the subject.
+type complexSelector struct {
+	compounds []compoundSelector
+	// combinators[i] joins compounds[i] and compounds[i+1].
+	combinators []byte
+}
+
+type compoundSelector struct {
+	tag     string
+	filters []func(n *node) bool
+}
+
+func selectorError(s string) Error {
+	return domError("SyntaxError", "'"+s+"' is not a valid selector")
+}
+
+func compileSelector(s string) selector {
+	p := selectorParser{input: s}
+	ret := p.list()
+	if p.pos != len(p.input) {
+		panic(selectorError(s))
+	}
+	return ret
+}
+
+type selectorParser struct {
+	input string
+	pos   int
+}
+
+func (p *selectorParser) peek() byte {
+	if p.pos < len(p.input) {
+		return p.input[p.pos]
+	}
+	return 0
+}
+
+func (p *selectorParser) skipSpace() bool {
+	start := p.pos
+	for p.pos < len(p.input) && strings.IndexByte(" \t\n\r\f", p.input[p.pos]) != -1 {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+func (p *selectorParser) fail() {
+	panic(selectorError(p.input))
+}
+
+func isIdentByte(c byte) bool {
+	return c == '-' || c == '_' || c >= 0x80 || (c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func (p *selectorParser) ident() string {
+	start := p.pos
+	for p.pos < len(p.input) && isIdentByte(p.input[p.pos]) {
+		p.pos++
+	}
+	if p.pos == start {
+		p.fail()
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *selectorParser) list() selector {
+	var ret selector
+	for {
+		p.skipSpace()
+		ret = append(ret, p.complex())
+		if p.peek() != ',' {
+			return ret
+		}
+		p.pos++
+	}
+}
+
+func (p *selectorParser) complex() complexSelector {
+	ret := complexSelector{compounds: []compoundSelector{p.compound()}}
+	for {
+		space := p.skipSpace()
+		c := p.peek()
+		switch c {
+		case '>', '+', '~':
+			p.pos++
+			p.skipSpace()
+		case 0, ',', ')':
+			return ret
+		default:
+			if !space {
+				p.fail()
+			}
+			c = ' '
+		}
+		ret.combinators = append(ret.combinators, c)
+		ret.compounds = append(ret.compounds, p.compound())
+	}
+}
+
+func (p *selectorParser) compound() compoundSelector {
+	var ret compoundSelector
+	universal := false
+	switch c := p.peek(); {
+	case c == '*':
+		p.pos++
+		universal = true
+	case isIdentByte(c):
+		ret.tag = strings.ToLower(p.ident())
+	}
+	for {
+		switch p.peek() {
+		case '#':
+			p.pos++
+			id := p.ident()
+			ret.filters = append(ret.filters, func(n *node) bool {
+				return n.attrVal("id") == id
+			})
+		case '.':
+			p.pos++
+			class := p.ident()
+			ret.filters = append(ret.filters, func(n *node) bool {
+				return (&classList{n}).contains(class)
+			})
+		case '[':
+			p.pos++
+			ret.filters = append(ret.filters, p.attribute())
+		case ':':
+			p.pos++
+			ret.filters = append(ret.filters, p.pseudoClass())
+		default:
+			if ret.tag == "" && len(ret.filters) == 0 && !universal {
+				p.fail()
+			}
+			return ret
+		}
+	}
+}
+
+func (p *selectorParser) attribute() func(n *node) bool {
+	p.skipSpace()
+	name := strings.ToLower(p.ident())
+	p.skipSpace()
+	if p.peek() == ']' {
+		p.pos++
+		return func(n *node) bool {
+			_, ok := n.attr(name)
+			return ok
+		}
+	}
+	var op byte
+	if c := p.peek(); strings.IndexByte("~^$*|", c) != -1 && c != 0 {
+		op = c
+		p.pos++
+	}
+	if p.peek() != '=' {
+		p.fail()
+	}
+	p.pos++
+	p.skipSpace()
+	var value string
+	if c := p.peek(); c == '"' || c == '\'' {
+		end := strings.IndexByte(p.input[p.pos+1:], c)
+		if end == -1 {
+			p.fail()
+		}
+		value = p.input[p.pos+1 : p.pos+1+end]
+		p.pos += end + 2
+	} else {
+		value = p.ident()
+	}
+	p.skipSpace()
+	if p.peek() != ']' {
+		p.fail()
+	}
+	p.pos++
+	return func(n *node) bool {
+		actual, ok := n.attr(name)
+		if !ok {
+			return false
+		}
+		switch op {
+		case '~':
+			for _, item := range strings.Fields(actual) {
+				if item == value {
+					return true
+				}
+			}
+			return false
+		case '^':
+			return value != "" && strings.HasPrefix(actual, value)
+		case '$':
+			return value != "" && strings.HasSuffix(actual, value)
+		case '*':
+			return value != "" && strings.Contains(actual, value)
+		case '|':
+			return actual == value || strings.HasPrefix(actual, value+"-")
+		default:
+			return actual == value
+		}
+	}
+}
+
+func (p *selectorParser) pseudoClass() func(n *node) bool {
+	switch strings.ToLower(p.ident()) {
+	case "checked":
+		return func(n *node) bool {
+			return (n.isCheckable() && n.isChecked()) ||
+				(n.isElement("option") && n.isSelected())
+		}
+	case "disabled":
+		return func(n *node) bool {
+			_, ok := n.attr("disabled")
+			return ok
+		}
+	case "first-child":
+		return func(n *node) bool {
+			for c := n.prev; c != nil; c = c.prev {
+				if c.nodeType == elementNode {
+					return false
+				}
+			}
+			return true
+		}
+	case "last-child":
+		return func(n *node) bool {
+			for c := n.next; c != nil; c = c.next {
+				if c.nodeType == elementNode {
+					return false
+				}
+			}
+			return true
+		}
+	case "not":
+		if p.peek() != '(' {
+			p.fail()
+		}
+		p.pos++
+		inner := p.list()
+		p.skipSpace()
+		if p.peek() != ')' {
+			p.fail()
+		}
+		p.pos++
+		return func(n *node) bool {
+			return !inner.matches(n)
+		}
+	default:
+		p.fail()
+		return nil
+	}
+}
+
+func (c *compoundSelector) matches(n *node) bool {
+	if n == nil || n.nodeType != elementNode || (c.tag != "" && c.tag != n.tag) {
+		return false
+	}
+	for _, f := range c.filters {
+		if !f(n) {
+			return false
+		}
+	}
+	return true
+}
+
+func previousElement(n *node) *node {
+	for c := n.prev; c != nil; c = c.prev {
+		if c.nodeType == elementNode {
+			return c
+		}
+	}
+	return nil
+}
+
+// matchesAt checks whether n matches the compound selectors up to and
+// including index i.
+func (c *complexSelector) matchesAt(n *node, i int) bool {
+	if !c.compounds[i].matches(n) {
+		return false
+	}
+	if i == 0 {
+		return true
+	}
+	switch c.combinators[i-1] {
+	case '>':
+		return c.matchesAt(n.parent, i-1)
+	case '+':
+		return c.matchesAt(previousElement(n), i-1)
+	case '~':
+		for s := previousElement(n); s != nil; s = previousElement(s) {
+			if c.matchesAt(s, i-1) {
+				return true
+			}
+		}
+	default:
+		for a := n.parent; a != nil; a = a.parent {
+			if c.matchesAt(a, i-1) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s selector) matches(n *node) bool {
+	for i := range s {
+		if s[i].matchesAt(n, len(s[i].compounds)-1) {
+			return true
+		}
+	}
+	return false
+}
+
+func querySelectorAll(root *node, s string) []*node {
+	sel := compileSelector(s)
+	var ret []*node
+	root.walk(func(d *node) bool {
+		if sel.matches(d) {
+			ret = append(ret, d)
+		}
+		return true
+	})
+	return ret
+}
+
+func querySelector(root *node, s string) *node {
+	sel := compileSelector(s)
+	var ret *node
+	root.walk(func(d *node) bool {
+		if sel.matches(d) {
+			ret = d
+			return false
+		}
+		return true
+	})
+	return ret
+}
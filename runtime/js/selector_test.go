@@ -0,0 +1,126 @@
+//go:build !js
+// +build !js
+
+package js
+
+import (
+	"strings"
+	"testing"
+)
+
+const selectorDoc = `<section id="s" class="outer">
+<ul id="u" class="list">
+<li id="a" class="item first" data-k="x-1">A</li>
+<li id="b" class="item" lang="en-US">B <em id="em">!</em></li>
+<li id="c" class="item last" data-k="y">C</li>
+</ul>
+<form id="f"><input id="on" type="checkbox" checked><input id="off" type="checkbox" disabled>
+<select id="sel"><option id="o1">1</option><option id="o2" selected>2</option></select></form>
+</section>`
+
+func ids(list Value) string {
+	ret := make([]string, list.Length())
+	for i := range ret {
+		ret[i] = list.Index(i).Get("id").String()
+	}
+	return strings.Join(ret, " ")
+}
+
+func TestSelectorMatching(t *testing.T) {
+	root := fragment(t, selectorDoc)
+	for _, tc := range []struct {
+		selector, expected string
+	}{
+		{"li", "a b c"},
+		{"LI", "a b c"},
+		{"*", "s u a b em c f on off sel o1 o2"},
+		{"#b", "b"},
+		{".item.first", "a"},
+		{"li.item:not(.first)", "b c"},
+		{"section > ul > li", "a b c"},
+		{"section > li", ""},
+		{"section li em", "em"},
+		{"#a + li", "b"},
+		{"#a ~ li", "b c"},
+		{"#c ~ li", ""},
+		{"#a, #c", "a c"},
+		{"#c, #a", "a c"},
+		{"[data-k]", "a c"},
+		{`[data-k="y"]`, "c"},
+		{"[data-k=y]", "c"},
+		{`[data-k^="x"]`, "a"},
+		{`[data-k$='1']`, "a"},
+		{`[data-k*="-"]`, "a"},
+		{`[class~="last"]`, "c"},
+		{`[lang|="en"]`, "b"},
+		{`[data-k^=""]`, ""},
+		{":first-child", "s u a em on o1"},
+		{"li:last-child", "c"},
+		{":checked", "on o2"},
+		{"input:disabled", "off"},
+		{"input:not(:checked):not(:disabled)", ""},
+		{"li:not(#a, #c)", "b"},
+		{"ul  >  li#b  em", "em"},
+	} {
+		t.Run(tc.selector, func(t *testing.T) {
+			if actual := ids(root.Call("querySelectorAll", tc.selector)); actual != tc.expected {
+				t.Fatalf("expected `%s`, got `%s`", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestQuerySelectorScope(t *testing.T) {
+	root := fragment(t, selectorDoc)
+	ul := root.Call("querySelector", "ul")
+	if first := ul.Call("querySelector", "li"); first.Get("id").String() != "a" {
+		t.Fatalf("querySelector did not return the first match")
+	}
+	// the subject must be a descendant, but ancestors outside of the node
+	// queried on may match the selector's other compounds.
+	if actual := ids(ul.Call("querySelectorAll", "section li")); actual != "a b c" {
+		t.Fatalf("unexpected result `%s`", actual)
+	}
+	if actual := ids(ul.Call("querySelectorAll", "ul")); actual != "" {
+		t.Fatalf("querySelectorAll matched the node itself")
+	}
+	if !ul.Call("querySelector", "table").IsNull() {
+		t.Fatalf("querySelector without match did not return null")
+	}
+}
+
+func TestMatchesAndClosest(t *testing.T) {
+	root := fragment(t, selectorDoc)
+	em := root.Call("querySelector", "em")
+	if !em.Call("matches", "li > em").Bool() || em.Call("matches", "ul > em").Bool() {
+		t.Fatalf("unexpected result of matches")
+	}
+	if c := em.Call("closest", ".item"); c.Get("id").String() != "b" {
+		t.Fatalf("closest returned %s", c.Get("id").String())
+	}
+	if c := em.Call("closest", "em"); !c.Equal(em) {
+		t.Fatalf("closest did not consider the node itself")
+	}
+	if !em.Call("closest", "table").IsNull() {
+		t.Fatalf("closest without match did not return null")
+	}
+	if !root.Call("getElementById", "em").Equal(em) {
+		t.Fatalf("getElementById did not find the node")
+	}
+}
+
+func TestInvalidSelectors(t *testing.T) {
+	root := fragment(t, selectorDoc)
+	for _, s := range []string{"", "li >", "[data-k", `[data-k="x]`, "li:hover",
+		":not(li", "a..b", "#"} {
+		t.Run(s, func(t *testing.T) {
+			defer func() {
+				err, ok := recover().(Error)
+				if !ok || err.Get("name").String() != "SyntaxError" {
+					t.Fatalf("expected SyntaxError, got %v", err)
+				}
+			}()
+			root.Call("querySelector", s)
+		})
+	}
+}
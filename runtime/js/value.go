@@ -0,0 +1,674 @@
+//go:build !js
+// +build !js
+
+package js
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Type represents the JavaScript type of a Value.
+type Type int
+
+// The types of JavaScript values.
+const (
+	TypeUndefined Type = iota
+	TypeNull
+	TypeBoolean
+	TypeNumber
+	TypeString
+	TypeSymbol
+	TypeObject
+	TypeFunction
+)
+
+func (t Type) String() string {
+	switch t {
+	case TypeUndefined:
+		return "undefined"
+	case TypeNull:
+		return "null"
+	case TypeBoolean:
+		return "boolean"
+	case TypeNumber:
+		return "number"
+	case TypeString:
+		return "string"
+	case TypeSymbol:
+		return "symbol"
+	case TypeObject:
+		return "object"
+	case TypeFunction:
+		return "function"
+	default:
+		panic("bad type")
+	}
+}
+
+// object is implemented by all values that have properties.
+type object interface {
+	get(name string) Value
+	set(name string, v Value)
+	remove(name string)
+}
+
+// caller is implemented by objects that provide methods without having them
+// as properties.
+type caller interface {
+	call(name string, args []Value) (Value, bool)
+}
+
+// null is the type of the JavaScript value "null".
+type null struct{}
+
+// Value represents a JavaScript value. The zero value is the JavaScript value
+// "undefined". Values can be checked for equality with the Equal method.
+type Value struct {
+	_ [0]func() // uncomparable; to make == not compile
+	// v is nil, null, bool, float64, string or an object.
+	v interface{}
+}
+
+// Error wraps a JavaScript error.
+type Error struct {
+	// Value is the underlying JavaScript error value.
+	Value
+}
+
+// Error implements the error interface.
+func (e Error) Error() string {
+	return "JavaScript error: " + e.Get("message").String()
+}
+
+// A ValueError occurs when a Value method is invoked on a Value that does not
+// support it.
+type ValueError struct {
+	Method string
+	Type   Type
+}
+
+func (e *ValueError) Error() string {
+	return "syscall/js: call of " + e.Method + " on " + e.Type.String()
+}
+
+// Func is a wrapped Go function to be called by JavaScript.
+type Func struct {
+	// Value is the JavaScript function that invokes the Go function.
+	Value
+}
+
+// Release frees up resources allocated for the function. The function must
+// not be invoked after calling Release.
+func (c Func) Release() {
+	c.v.(*function).fn = nil
+}
+
+// FuncOf returns a function to be used by JavaScript.
+func FuncOf(fn func(this Value, args []Value) interface{}) Func {
+	return Func{Value{v: &function{fn: fn}}}
+}
+
+// Undefined returns the JavaScript value "undefined".
+func Undefined() Value {
+	return Value{}
+}
+
+// Null returns the JavaScript value "null".
+func Null() Value {
+	return Value{v: null{}}
+}
+
+// Global returns the JavaScript global object, which holds the document.
+func Global() Value {
+	return global
+}
+
+// ValueOf returns x as a JavaScript value:
+//
+//	| Go                     | JavaScript             |
+//	| ---------------------- | ---------------------- |
+//	| js.Value               | [its value]            |
+//	| js.Func                | function               |
+//	| nil                    | null                   |
+//	| bool                   | boolean                |
+//	| integers and floats    | number                 |
+//	| string                 | string                 |
+//	| []interface{}          | new array              |
+//	| map[string]interface{} | new object             |
+//
+// Panics if x is not one of the expected types.
+func ValueOf(x interface{}) Value {
+	switch x := x.(type) {
+	case Value:
+		return x
+	case Func:
+		return x.Value
+	case nil:
+		return Null()
+	case bool:
+		return Value{v: x}
+	case int:
+		return Value{v: float64(x)}
+	case int8:
+		return Value{v: float64(x)}
+	case int16:
+		return Value{v: float64(x)}
+	case int32:
+		return Value{v: float64(x)}
+	case int64:
+		return Value{v: float64(x)}
+	case uint:
+		return Value{v: float64(x)}
+	case uint8:
+		return Value{v: float64(x)}
+	case uint16:
+		return Value{v: float64(x)}
+	case uint32:
+		return Value{v: float64(x)}
+	case uint64:
+		return Value{v: float64(x)}
+	case uintptr:
+		return Value{v: float64(x)}
+	case float32:
+		return Value{v: float64(x)}
+	case float64:
+		return Value{v: x}
+	case string:
+		return Value{v: x}
+	case []interface{}:
+		a := newArray()
+		for _, item := range x {
+			a.items = append(a.items, ValueOf(item))
+		}
+		return Value{v: a}
+	case map[string]interface{}:
+		o := newPlainObject()
+		for key, item := range x {
+			o.set(key, ValueOf(item))
+		}
+		return Value{v: o}
+	default:
+		panic("ValueOf: invalid value")
+	}
+}
+
+func valuesOf(args []interface{}) []Value {
+	ret := make([]Value, len(args))
+	for i, arg := range args {
+		ret[i] = ValueOf(arg)
+	}
+	return ret
+}
+
+// Type returns the JavaScript type of the value v.
+func (v Value) Type() Type {
+	switch v.v.(type) {
+	case nil:
+		return TypeUndefined
+	case null:
+		return TypeNull
+	case bool:
+		return TypeBoolean
+	case float64:
+		return TypeNumber
+	case string:
+		return TypeString
+	case *function:
+		return TypeFunction
+	default:
+		return TypeObject
+	}
+}
+
+func (v Value) object(method string) object {
+	if o, ok := v.v.(object); ok {
+		return o
+	}
+	panic(&ValueError{method, v.Type()})
+}
+
+// Get returns the JavaScript property p of value v.
+// It panics if v is not a JavaScript object.
+func (v Value) Get(p string) Value {
+	return v.object("Value.Get").get(p)
+}
+
+// Set sets the JavaScript property p of value v to ValueOf(x).
+// It panics if v is not a JavaScript object.
+func (v Value) Set(p string, x interface{}) {
+	v.object("Value.Set").set(p, ValueOf(x))
+}
+
+// Delete deletes the JavaScript property p of value v.
+// It panics if v is not a JavaScript object.
+func (v Value) Delete(p string) {
+	v.object("Value.Delete").remove(p)
+}
+
+// Index returns JavaScript index i of value v.
+// It panics if v is not a JavaScript object.
+func (v Value) Index(i int) Value {
+	return v.object("Value.Index").get(strconv.Itoa(i))
+}
+
+// SetIndex sets the JavaScript index i of value v to ValueOf(x).
+// It panics if v is not a JavaScript object.
+func (v Value) SetIndex(i int, x interface{}) {
+	v.object("Value.SetIndex").set(strconv.Itoa(i), ValueOf(x))
+}
+
+// Length returns the JavaScript property "length" of v.
+// It panics if v is not a JavaScript object.
+func (v Value) Length() int {
+	return v.object("Value.Length").get("length").Int()
+}
+
+// Call does a JavaScript call to the method m of value v with the given
+// arguments. It panics if v has no method m.
+func (v Value) Call(m string, args ...interface{}) Value {
+	o := v.object("Value.Call")
+	values := valuesOf(args)
+	if c, ok := o.(caller); ok {
+		if ret, ok := c.call(m, values); ok {
+			return ret
+		}
+	}
+	fn, ok := o.get(m).v.(*function)
+	if !ok {
+		panic(&ValueError{"Value.Call", TypeUndefined})
+	}
+	return fn.invoke(v, values)
+}
+
+// Invoke does a JavaScript call of the value v with the given arguments.
+// It panics if v is not a JavaScript function.
+func (v Value) Invoke(args ...interface{}) Value {
+	fn, ok := v.v.(*function)
+	if !ok {
+		panic(&ValueError{"Value.Invoke", v.Type()})
+	}
+	return fn.invoke(Undefined(), valuesOf(args))
+}
+
+// New uses JavaScript's "new" operator with value v as constructor and the
+// given arguments. It panics if v is not a JavaScript function.
+func (v Value) New(args ...interface{}) Value {
+	fn, ok := v.v.(*function)
+	if !ok || fn.construct == nil {
+		panic(&ValueError{"Value.New", v.Type()})
+	}
+	return fn.construct(valuesOf(args))
+}
+
+// InstanceOf reports whether v is an instance of type t according to
+// JavaScript's instanceof operator.
+func (v Value) InstanceOf(t Value) bool {
+	fn, ok := t.v.(*function)
+	return ok && fn.instanceOf != nil && fn.instanceOf(v)
+}
+
+// Equal reports whether v and w are equal according to JavaScript's ===
+// operator.
+func (v Value) Equal(w Value) bool {
+	if f, ok := v.v.(float64); ok && math.IsNaN(f) {
+		return false
+	}
+	return v.v == w.v
+}
+
+// IsUndefined reports whether v is the JavaScript value "undefined".
+func (v Value) IsUndefined() bool {
+	return v.v == nil
+}
+
+// IsNull reports whether v is the JavaScript value "null".
+func (v Value) IsNull() bool {
+	_, ok := v.v.(null)
+	return ok
+}
+
+// IsNaN reports whether v is the JavaScript value "NaN".
+func (v Value) IsNaN() bool {
+	f, ok := v.v.(float64)
+	return ok && math.IsNaN(f)
+}
+
+// Float returns the value v as a float64.
+// It panics if v is not a JavaScript number.
+func (v Value) Float() float64 {
+	f, ok := v.v.(float64)
+	if !ok {
+		panic(&ValueError{"Value.Float", v.Type()})
+	}
+	return f
+}
+
+// Int returns the value v truncated to an int.
+// It panics if v is not a JavaScript number.
+func (v Value) Int() int {
+	f, ok := v.v.(float64)
+	if !ok {
+		panic(&ValueError{"Value.Int", v.Type()})
+	}
+	return int(f)
+}
+
+// Bool returns the value v as a bool.
+// It panics if v is not a JavaScript boolean.
+func (v Value) Bool() bool {
+	b, ok := v.v.(bool)
+	if !ok {
+		panic(&ValueError{"Value.Bool", v.Type()})
+	}
+	return b
+}
+
+// Truthy returns the JavaScript "truthiness" of the value v.
+func (v Value) Truthy() bool {
+	switch x := v.v.(type) {
+	case nil, null:
+		return false
+	case bool:
+		return x
+	case float64:
+		return x != 0 && !math.IsNaN(x)
+	case string:
+		return x != ""
+	default:
+		return true
+	}
+}
+
+// String returns the value v as a string. Unlike the other getters, String
+// does not panic if v's type is not TypeString. Instead, it returns a string
+// of the form "<T>" or "<T: V>" where T is v's type and V is a string
+// representation of v's value.
+func (v Value) String() string {
+	switch x := v.v.(type) {
+	case string:
+		return x
+	case nil:
+		return "<undefined>"
+	case null:
+		return "<null>"
+	case bool:
+		return "<boolean: " + strconv.FormatBool(x) + ">"
+	case float64:
+		return "<number: " + jsString(v) + ">"
+	case *function:
+		return "<function>"
+	default:
+		return "<object>"
+	}
+}
+
+// jsString converts v to a string like JavaScript's String() does.
+func jsString(v Value) string {
+	switch x := v.v.(type) {
+	case string:
+		return x
+	case nil:
+		return "undefined"
+	case null:
+		return "null"
+	case bool:
+		return strconv.FormatBool(x)
+	case float64:
+		switch {
+		case math.IsNaN(x):
+			return "NaN"
+		case math.IsInf(x, 1):
+			return "Infinity"
+		case math.IsInf(x, -1):
+			return "-Infinity"
+		}
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	case *function:
+		return "function"
+	default:
+		return "[object Object]"
+	}
+}
+
+// jsNumber converts v to a number like JavaScript's Number() does.
+func jsNumber(v Value) float64 {
+	switch x := v.v.(type) {
+	case float64:
+		return x
+	case bool:
+		if x {
+			return 1
+		}
+		return 0
+	case null:
+		return 0
+	case string:
+		if x == "" {
+			return 0
+		}
+		f, err := strconv.ParseFloat(x, 64)
+		if err != nil {
+			return math.NaN()
+		}
+		return f
+	default:
+		return math.NaN()
+	}
+}
+
+// function is a JavaScript function implemented in Go.
+type function struct {
+	plainObject
+	fn         func(this Value, args []Value) interface{}
+	construct  func(args []Value) Value
+	instanceOf func(v Value) bool
+}
+
+func (f *function) invoke(this Value, args []Value) Value {
+	if f.fn == nil {
+		panic("call to released function")
+	}
+	return ValueOf(f.fn(this, args))
+}
+
+// plainObject is an object that only has properties.
+type plainObject struct {
+	props map[string]Value
+}
+
+func newPlainObject() *plainObject {
+	return &plainObject{props: make(map[string]Value)}
+}
+
+func (o *plainObject) get(name string) Value {
+	return o.props[name]
+}
+
+func (o *plainObject) set(name string, v Value) {
+	if o.props == nil {
+		o.props = make(map[string]Value)
+	}
+	o.props[name] = v
+}
+
+func (o *plainObject) remove(name string) {
+	delete(o.props, name)
+}
+
+// array is a JavaScript array.
+type array struct {
+	items []Value
+}
+
+func newArray() *array {
+	return &array{}
+}
+
+func (a *array) get(name string) Value {
+	if name == "length" {
+		return ValueOf(len(a.items))
+	}
+	if i, err := strconv.Atoi(name); err == nil && i >= 0 && i < len(a.items) {
+		return a.items[i]
+	}
+	return Undefined()
+}
+
+func (a *array) set(name string, v Value) {
+	if i, err := strconv.Atoi(name); err == nil && i >= 0 {
+		for len(a.items) <= i {
+			a.items = append(a.items, Undefined())
+		}
+		a.items[i] = v
+	}
+}
+
+func (a *array) remove(name string) {
+	if i, err := strconv.Atoi(name); err == nil && i >= 0 && i < len(a.items) {
+		a.items[i] = Undefined()
+	}
+}
+
+func (a *array) call(name string, args []Value) (Value, bool) {
+	if name == "push" {
+		a.items = append(a.items, args...)
+		return ValueOf(len(a.items)), true
+	}
+	return Undefined(), false
+}
+
+func newFunction(fn func(this Value, args []Value) interface{}) Value {
+	return Value{v: &function{fn: fn}}
+}
+
+func arg(args []Value, i int) Value {
+	if i < len(args) {
+		return args[i]
+	}
+	return Undefined()
+}
+
+// globalWindow is the window, which is the global object.
+var globalWindow = newWindow()
+
+// global is the global object. It holds the document and the builtin
+// functions used by askew.
+var global = func() Value {
+	o := &globalWindow.plainObject
+	o.set("document", Value{v: newDocument()})
+	o.set("Number", newFunction(func(this Value, args []Value) interface{} {
+		return jsNumber(arg(args, 0))
+	}))
+	o.set("String", newFunction(func(this Value, args []Value) interface{} {
+		return jsString(arg(args, 0))
+	}))
+	o.set("parseInt", newFunction(func(this Value, args []Value) interface{} {
+		radix := 10
+		if r := arg(args, 1); r.Type() == TypeNumber && r.Int() != 0 {
+			radix = r.Int()
+		}
+		return parseInt(jsString(arg(args, 0)), radix)
+	}))
+	o.set("parseFloat", newFunction(func(this Value, args []Value) interface{} {
+		return parseFloat(jsString(arg(args, 0)))
+	}))
+	for _, name := range eventClasses {
+		o.set(name, eventConstructor())
+	}
+	o.set("Object", Value{v: &function{construct: func(args []Value) Value {
+		return Value{v: newPlainObject()}
+	}}})
+	o.set("Array", Value{v: &function{construct: func(args []Value) Value {
+		return Value{v: newArray()}
+	}}})
+	o.set("window", Value{v: globalWindow})
+	return Value{v: globalWindow}
+}()
+
+// parseInt implements JavaScript's parseInt, which parses the longest prefix
+// of s that is an integer.
+func parseInt(s string, radix int) float64 {
+	i := 0
+	for i < len(s) && (s[i] == ' ' || s[i] == '\t' || s[i] == '\n') {
+		i++
+	}
+	start := i
+	if i < len(s) && (s[i] == '-' || s[i] == '+') {
+		i++
+	}
+	digits := i
+	for i < len(s) {
+		c := s[i]
+		var d int
+		switch {
+		case c >= '0' && c <= '9':
+			d = int(c - '0')
+		case c >= 'a' && c <= 'z':
+			d = int(c-'a') + 10
+		case c >= 'A' && c <= 'Z':
+			d = int(c-'A') + 10
+		default:
+			d = radix
+		}
+		if d >= radix {
+			break
+		}
+		i++
+	}
+	if i == digits {
+		return math.NaN()
+	}
+	ret, err := strconv.ParseInt(s[start:i], radix, 64)
+	if err != nil {
+		return math.NaN()
+	}
+	return float64(ret)
+}
+
+// parseFloat implements JavaScript's parseFloat, which parses the longest
+// prefix of s that is a decimal number.
+func parseFloat(s string) float64 {
+	s = strings.TrimLeft(s, " \t\n")
+	for _, inf := range []string{"Infinity", "+Infinity"} {
+		if strings.HasPrefix(s, inf) {
+			return math.Inf(1)
+		}
+	}
+	if strings.HasPrefix(s, "-Infinity") {
+		return math.Inf(-1)
+	}
+	i := 0
+	if i < len(s) && (s[i] == '-' || s[i] == '+') {
+		i++
+	}
+	digits, dot := 0, false
+	for ; i < len(s); i++ {
+		if s[i] >= '0' && s[i] <= '9' {
+			digits++
+		} else if s[i] == '.' && !dot {
+			dot = true
+		} else {
+			break
+		}
+	}
+	if digits == 0 {
+		return math.NaN()
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '-' || s[j] == '+') {
+			j++
+		}
+		if j < len(s) && s[j] >= '0' && s[j] <= '9' {
+			for i = j; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+			}
+		}
+	}
+	ret, err := strconv.ParseFloat(s[:i], 64)
+	if err != nil && ret == 0 {
+		return math.NaN()
+	}
+	return ret
+}
@@ -0,0 +1,72 @@
+package askew
+
+import "github.com/flyx/askew/runtime/js"
+
+// Mountable is implemented by generated components, lists and optionals. It
+// is used to propagate lifecycle hooks to components that enter or leave the
+// document together with their parent.
+type Mountable interface {
+	// DoMount is called after the component has been inserted into the
+	// document.
+	DoMount()
+	// DoUnmount is called after the component has been removed from the
+	// document.
+	DoUnmount()
+}
+
+// moving is non-zero while a list moves its items to another position. The
+// items stay in the document, so their lifecycle hooks are not called.
+var moving int
+
+// IsConnected returns true iff the given node is part of the document.
+func IsConnected(node js.Value) bool {
+	return node.Get("isConnected").Bool()
+}
+
+// DoMount marks the component as being part of the document and ensures
+// that its delegated handlers are dispatched.
+// Returns false if it already has been marked.
+//
+// This is the backend for a Component's DoMount, which calls the mounted
+// hooks if this returns true.
+func (cd *ComponentData) DoMount() bool {
+	if cd.mounted {
+		return false
+	}
+	cd.mounted = true
+	cd.dispatchFallback()
+	return true
+}
+
+// DoUnmount marks the component as not being part of the document.
+// Returns false if the component was not marked as mounted, or if it is
+// being moved by a list.
+//
+// This is the backend for a Component's DoUnmount, which calls the unmounted
+// hooks if this returns true.
+func (cd *ComponentData) DoUnmount() bool {
+	if !cd.mounted || moving > 0 {
+		return false
+	}
+	cd.mounted = false
+	return true
+}
+
+// Mounted returns true iff the component is currently part of the document.
+func (cd *ComponentData) Mounted() bool {
+	return cd.mounted
+}
+
+// mount calls DoMount on the given component if it is Mountable.
+func mount(c Component) {
+	if m, ok := c.(Mountable); ok {
+		m.DoMount()
+	}
+}
+
+// unmount calls DoUnmount on the given component if it is Mountable.
+func unmount(c Component) {
+	if m, ok := c.(Mountable); ok {
+		m.DoUnmount()
+	}
+}
@@ -0,0 +1,45 @@
+package askew
+
+import "github.com/flyx/askew/runtime/js"
+
+// listener is an event listener registered by a component.
+type listener struct {
+	target js.Value
+	event  string
+	fn     js.Func
+}
+
+// liveFuncs is the number of listener wrappers that have been created by
+// AddEventListener and not yet been released.
+var liveFuncs int
+
+// LiveFuncs returns the number of event listener wrappers created by
+// components that have not been released yet. Wrappers are released when
+// their component is destroyed, so a growing number indicates that components
+// are discarded without calling Destroy.
+func LiveFuncs() int {
+	return liveFuncs
+}
+
+// AddEventListener wraps the given handler with js.FuncOf and registers it
+// as listener for the given event on target, which must be a node of the
+// component. The listener is removed and the wrapper released when the
+// component is destroyed.
+func (cd *ComponentData) AddEventListener(target js.Value, event string,
+	handler func(this js.Value, arguments []js.Value) interface{}) {
+	fn := js.FuncOf(handler)
+	target.Call("addEventListener", event, fn)
+	cd.listeners = append(cd.listeners, listener{target, event, fn})
+	liveFuncs++
+}
+
+// releaseListeners removes all listeners registered via AddEventListener and
+// releases their wrappers.
+func (cd *ComponentData) releaseListeners() {
+	for _, l := range cd.listeners {
+		l.target.Call("removeEventListener", l.event, l.fn)
+		l.fn.Release()
+	}
+	liveFuncs -= len(cd.listeners)
+	cd.listeners = nil
+}
@@ -0,0 +1,79 @@
+package askew
+
+import (
+	"testing"
+
+	"github.com/flyx/askew/runtime/js"
+)
+
+// listenCount registers a listener for the given event on the <li> of it
+// that increments *count.
+func listenCount(it *item, event string, count *int) js.Value {
+	li := it.FirstNode()
+	it.cd.AddEventListener(li, event, func(this js.Value, arguments []js.Value) interface{} {
+		*count++
+		return nil
+	})
+	return li
+}
+
+func TestDestroyReleasesListeners(t *testing.T) {
+	before := LiveFuncs()
+	it := newItem("a")
+	var clicks, inputs int
+	li := listenCount(it, "click", &clicks)
+	listenCount(it, "input", &inputs)
+	if n := LiveFuncs() - before; n != 2 {
+		t.Fatalf("expected 2 live funcs, got %d", n)
+	}
+	it.InsertInto(newContainer(), js.Null())
+	dispatch(li, "click")
+	if clicks != 1 {
+		t.Fatalf("listener has not been called")
+	}
+
+	it.Destroy()
+	if n := LiveFuncs() - before; n != 0 {
+		t.Errorf("expected all funcs to be released, %d are live", n)
+	}
+	// the node may still be referenced elsewhere, but must not call the
+	// released funcs anymore.
+	dispatch(li, "click")
+	dispatch(li, "input")
+	if clicks != 1 || inputs != 0 {
+		t.Errorf("listeners have been called after Destroy: clicks=%d inputs=%d",
+			clicks, inputs)
+	}
+}
+
+func TestInitReleasesListeners(t *testing.T) {
+	before := LiveFuncs()
+	it := newItem("a")
+	var clicks int
+	li := listenCount(it, "click", &clicks)
+	it.cd.Init(js.Global().Get("document").Call("createDocumentFragment"))
+	if n := LiveFuncs() - before; n != 0 {
+		t.Errorf("expected all funcs to be released, %d are live", n)
+	}
+	dispatch(li, "click")
+	if clicks != 0 {
+		t.Errorf("listener has been called after Init")
+	}
+}
+
+func TestListDestroyReleasesListeners(t *testing.T) {
+	before := LiveFuncs()
+	l, _ := filledList("a", "b", "c")
+	var clicks int
+	for i := 0; i < l.Len(); i++ {
+		listenCount(l.Item(i), "click", &clicks)
+	}
+	l.Destroy(1)
+	if n := LiveFuncs() - before; n != 2 {
+		t.Errorf("expected 2 live funcs after destroying an item, got %d", n)
+	}
+	l.DestroyAll()
+	if n := LiveFuncs() - before; n != 0 {
+		t.Errorf("expected all funcs to be released, %d are live", n)
+	}
+}
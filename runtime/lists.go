@@ -1,17 +1,25 @@
 package askew
 
-import "syscall/js"
+import (
+	"sort"
+
+	"github.com/flyx/askew/runtime/js"
+)
 
 // ListManager is the backend for component lists.
 type ListManager struct {
 	parent, end js.Value
+	// delegated holds the event types for which a listener dispatching to
+	// delegated handlers of the items has been registered on parent.
+	delegated map[string]struct{}
 }
 
 // CreateListManager creates a list manager that inserts list objects at the given
 // index between the children of the given parent.
 func CreateListManager(parent js.Value, insertAt int) ListManager {
 	return ListManager{
-		parent: parent, end: parent.Get("childNodes").Index(insertAt)}
+		parent: parent, end: parent.Get("childNodes").Index(insertAt),
+		delegated: make(map[string]struct{})}
 }
 
 // UpdateParent sets a new parent node for the manager.
@@ -29,6 +37,9 @@ func (lm *ListManager) UpdateParent(
 	oldParent, newParent, newEnd js.Value) {
 	if oldParent.Equal(lm.parent) {
 		lm.parent = newParent
+		for event := range lm.delegated {
+			listenDelegated(newParent, event)
+		}
 		if lm.end.IsUndefined() {
 			lm.end = newEnd
 		} else if newEnd.IsUndefined() {
@@ -42,9 +53,190 @@ func (lm *ListManager) UpdateParent(
 // Append appends the given object to the container.
 func (lm ListManager) Append(c Component) {
 	c.InsertInto(lm.parent, lm.end)
+	lm.listen(c)
 }
 
 // Insert inserts the given object in front of the object `before`.
 func (lm ListManager) Insert(c Component, before js.Value) {
 	c.InsertInto(lm.parent, before)
+	lm.listen(c)
+}
+
+// Reconcile rearranges the given items, which have the given keys, so that
+// the list contains one item for each of the given new keys, in that order.
+// Items whose key is contained in the new keys are kept and given to update,
+// which may be nil. An item with a nil key is never kept. For all other new
+// keys, an item is created by factory, which must return a component that is
+// not inserted anywhere. Items that are not kept are destroyed. If a key is
+// given multiple times, the items with that key are kept in their order.
+//
+// Only items that are new or whose position relative to the other kept items
+// has changed are inserted into the DOM. Returns the new list of items.
+func (lm ListManager) Reconcile(items []Component, itemKeys, keys []interface{},
+	factory func(key interface{}) Component,
+	update func(item Component, key interface{})) []Component {
+	// oldIndex holds the indexes of the items with each key that have not
+	// been kept yet.
+	oldIndex := make(map[interface{}][]int, len(items))
+	for i, key := range itemKeys {
+		if key != nil {
+			oldIndex[key] = append(oldIndex[key], i)
+		}
+	}
+	ret := make([]Component, len(keys))
+	// source[i] is the index of the item in items that is kept for keys[i],
+	// or -1 if the item has been created.
+	source := make([]int, len(keys))
+	kept := make([]bool, len(items))
+	for i, key := range keys {
+		if indexes := oldIndex[key]; len(indexes) > 0 {
+			j := indexes[0]
+			oldIndex[key] = indexes[1:]
+			kept[j], source[i], ret[i] = true, j, items[j]
+			if update != nil {
+				update(items[j], key)
+			}
+		} else {
+			source[i], ret[i] = -1, factory(key)
+		}
+	}
+	for j, item := range items {
+		if !kept[j] {
+			item.Destroy()
+		}
+	}
+
+	lm.Rearrange(ret, source)
+	return ret
+}
+
+// Rearrange moves the given items so that they appear in the given order.
+// source[i] is the previous index of items[i] within the list, or -1 if the
+// item has not been inserted yet.
+//
+// Only items that are not inserted yet or whose position relative to the
+// other items has changed are inserted into the DOM.
+func (lm ListManager) Rearrange(items []Component, source []int) {
+	stable := stableItems(source)
+	next := lm.end
+	for i := len(items) - 1; i >= 0; i-- {
+		if !stable[i] {
+			if source[i] != -1 {
+				lm.move(items[i], next)
+			} else {
+				items[i].InsertInto(lm.parent, next)
+				lm.listen(items[i])
+			}
+		}
+		next = items[i].FirstNode()
+	}
+}
+
+// Sort sorts the given items, which must be the list's items in their current
+// order, with the given less func and updates the DOM accordingly. The sort is
+// stable. Returns the previous index of each item.
+func (lm ListManager) Sort(items []Component, less func(a, b Component) bool) []int {
+	source := make([]int, len(items))
+	for i := range source {
+		source[i] = i
+	}
+	sort.SliceStable(source, func(i, j int) bool {
+		return less(items[source[i]], items[source[j]])
+	})
+	sorted := make([]Component, len(items))
+	for i, j := range source {
+		sorted[i] = items[j]
+	}
+	copy(items, sorted)
+	lm.Rearrange(items, source)
+	return source
+}
+
+// Move moves the given object, which must be part of the list, in front of
+// the object `before`, or to the end of the list if before is undefined.
+func (lm ListManager) Move(c Component, before js.Value) {
+	if before.IsUndefined() {
+		before = lm.end
+	}
+	lm.move(c, before)
+}
+
+// move extracts and re-inserts the given item without calling its lifecycle
+// hooks.
+func (lm ListManager) move(c Component, before js.Value) {
+	moving++
+	defer func() { moving-- }()
+	c.Extract()
+	c.InsertInto(lm.parent, before)
+}
+
+// parentUpdater is implemented by generated components. It is used to update
+// the list and optional embeds of a component after its nodes have been moved
+// from a DocumentFragment to another parent.
+type parentUpdater interface {
+	DoUpdateParent(oldParent, newParent, newEnd js.Value)
+}
+
+// AppendAll appends the given objects to the container. The objects are
+// collected in a DocumentFragment first so that the container is modified
+// only once.
+func (lm ListManager) AppendAll(items []Component) {
+	if len(items) == 0 {
+		return
+	}
+	frag := js.Global().Get("document").Call("createDocumentFragment")
+	for _, c := range items {
+		c.InsertInto(frag, js.Undefined())
+	}
+	lm.parent.Call("insertBefore", frag, lm.end)
+	for i, c := range items {
+		lm.listen(c)
+		if u, ok := c.(parentUpdater); ok {
+			next := lm.end
+			if i+1 < len(items) {
+				next = items[i+1].FirstNode()
+			}
+			u.DoUpdateParent(frag, lm.parent, next)
+		}
+	}
+	if IsConnected(lm.parent) {
+		for _, c := range items {
+			mount(c)
+		}
+	}
+}
+
+// stableItems returns, for each index of source, whether the inserted item
+// at that index can stay at its position in the DOM. Those are the items that
+// form the longest increasing subsequence of the old indexes in source, so
+// that all other items can be moved around them.
+func stableItems(source []int) []bool {
+	// tails[k] is the index in source of the smallest last item of all
+	// increasing subsequences of length k+1 found so far.
+	tails := make([]int, 0, len(source))
+	prev := make([]int, len(source))
+	for i, s := range source {
+		if s == -1 {
+			continue
+		}
+		k := sort.Search(len(tails), func(k int) bool {
+			return source[tails[k]] >= s
+		})
+		prev[i] = -1
+		if k > 0 {
+			prev[i] = tails[k-1]
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+	ret := make([]bool, len(source))
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i != -1; i = prev[i] {
+			ret[i] = true
+		}
+	}
+	return ret
 }
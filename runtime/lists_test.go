@@ -0,0 +1,284 @@
+package askew
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/flyx/askew/runtime/js"
+)
+
+func TestStableItems(t *testing.T) {
+	for _, tc := range []struct {
+		source   []int
+		expected []bool
+	}{
+		{nil, []bool{}},
+		{[]int{0, 1, 2}, []bool{true, true, true}},
+		{[]int{-1, -1}, []bool{false, false}},
+		{[]int{2, 0, 1}, []bool{false, true, true}},
+		{[]int{1, 2, 0}, []bool{true, true, false}},
+		{[]int{2, 1, 0}, []bool{false, false, true}},
+		{[]int{0, -1, 1, -1}, []bool{true, false, true, false}},
+		{[]int{3, 0, 4, 1, 2}, []bool{false, true, false, true, true}},
+	} {
+		if stable := stableItems(tc.source); !reflect.DeepEqual(stable, tc.expected) {
+			t.Errorf("stableItems(%v): expected %v, got %v", tc.source, tc.expected, stable)
+		}
+	}
+}
+
+// reconciler records the calls of the factory and update funcs of
+// List.Reconcile.
+type reconciler struct {
+	created, updated []interface{}
+}
+
+func (r *reconciler) factory(key interface{}) *item {
+	r.created = append(r.created, key)
+	return newItem(key.(string))
+}
+
+func (r *reconciler) update(it *item, key interface{}) {
+	r.updated = append(r.updated, key)
+}
+
+func (r *reconciler) expect(t *testing.T, created, updated []interface{}) {
+	t.Helper()
+	if !reflect.DeepEqual(r.created, created) {
+		t.Errorf("expected created items %v, got %v", created, r.created)
+	}
+	if !reflect.DeepEqual(r.updated, updated) {
+		t.Errorf("expected updated items %v, got %v", updated, r.updated)
+	}
+	r.created, r.updated = nil, nil
+}
+
+func keys(values ...string) []interface{} {
+	ret := make([]interface{}, len(values))
+	for i, v := range values {
+		ret[i] = v
+	}
+	return ret
+}
+
+func TestReconcile(t *testing.T) {
+	container := newContainer()
+	var l List[*item, interface{}]
+	l.Init(container, 0)
+	var r reconciler
+
+	l.Reconcile(keys("a", "b", "c"), r.factory, r.update)
+	r.expect(t, keys("a", "b", "c"), nil)
+	expectChildren(t, container, "a", "b", "c")
+	a, b, c := l.Item(0), l.Item(1), l.Item(2)
+	resetInserts(a, b, c)
+
+	// a and b keep their relative position, only c is moved.
+	l.Reconcile(keys("c", "a", "b", "d"), r.factory, r.update)
+	r.expect(t, keys("d"), keys("c", "a", "b"))
+	expectChildren(t, container, "c", "a", "b", "d")
+	if l.Item(0) != c || l.Item(1) != a || l.Item(2) != b {
+		t.Fatalf("items have not been kept")
+	}
+	if a.inserts != 0 || b.inserts != 0 || c.inserts != 1 || l.Item(3).inserts != 1 {
+		t.Errorf("unexpected inserts: a=%d b=%d c=%d d=%d", a.inserts, b.inserts,
+			c.inserts, l.Item(3).inserts)
+	}
+	d := l.Item(3)
+	resetInserts(a, b, c, d)
+
+	l.Reconcile(keys("a", "d"), r.factory, nil)
+	r.expect(t, nil, nil)
+	expectChildren(t, container, "a", "d")
+	if !b.destroyed || !c.destroyed || a.destroyed || d.destroyed {
+		t.Errorf("unexpected destroyed items: a=%v b=%v c=%v d=%v", a.destroyed,
+			b.destroyed, c.destroyed, d.destroyed)
+	}
+	if a.inserts != 0 || d.inserts != 0 {
+		t.Errorf("kept items have been moved: a=%d d=%d", a.inserts, d.inserts)
+	}
+}
+
+func TestReconcileDuplicateKeys(t *testing.T) {
+	container := newContainer()
+	var l List[*item, interface{}]
+	l.Init(container, 0)
+	var r reconciler
+
+	l.Reconcile(keys("a", "a"), r.factory, r.update)
+	r.expect(t, keys("a", "a"), nil)
+	first, second := l.Item(0), l.Item(1)
+
+	// the first item with a key is kept for the first occurrence of the key.
+	l.Reconcile(keys("a", "b", "a"), r.factory, r.update)
+	r.expect(t, keys("b"), keys("a", "a"))
+	expectChildren(t, container, "a", "b", "a")
+	if l.Item(0) != first || l.Item(2) != second {
+		t.Errorf("items with duplicate keys have not been kept in order")
+	}
+}
+
+func TestReconcileDestroysUnkeyedItems(t *testing.T) {
+	container := newContainer()
+	var l List[*item, interface{}]
+	l.Init(container, 0)
+	var r reconciler
+
+	unkeyed := newItem("a")
+	l.Append(unkeyed)
+	l.Reconcile(keys("a"), r.factory, r.update)
+	r.expect(t, keys("a"), nil)
+	expectChildren(t, container, "a")
+	if !unkeyed.destroyed || l.Item(0) == unkeyed {
+		t.Errorf("item appended without key has been kept")
+	}
+
+	l.Reconcile(nil, r.factory, r.update)
+	r.expect(t, nil, nil)
+	expectChildren(t, container)
+	if l.Len() != 0 {
+		t.Errorf("expected empty list, got %d items", l.Len())
+	}
+}
+
+// filledList returns a list of items with the given labels.
+func filledList(labels ...string) (*List[*item, interface{}], js.Value) {
+	container := newContainer()
+	l := &List[*item, interface{}]{}
+	l.Init(container, 0)
+	for _, label := range labels {
+		l.Append(newItem(label))
+	}
+	for i := 0; i < l.Len(); i++ {
+		l.Item(i).inserts = 0
+	}
+	return l, container
+}
+
+func expectItems(t *testing.T, l *List[*item, interface{}], expected ...string) {
+	t.Helper()
+	var labels []string
+	for i := 0; i < l.Len(); i++ {
+		labels = append(labels, l.Item(i).label)
+	}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Fatalf("expected items %v, got %v", expected, labels)
+	}
+}
+
+func TestMove(t *testing.T) {
+	for _, tc := range []struct {
+		from, to int
+		expected []string
+	}{
+		{0, 2, []string{"b", "c", "a", "d"}},
+		{3, 0, []string{"d", "a", "b", "c"}},
+		{1, 3, []string{"a", "c", "d", "b"}},
+		{2, 2, []string{"a", "b", "c", "d"}},
+	} {
+		l, container := filledList("a", "b", "c", "d")
+		moved := l.Item(tc.from)
+		l.Move(tc.from, tc.to)
+		expectItems(t, l, tc.expected...)
+		expectChildren(t, container, tc.expected...)
+		if tc.from != tc.to && moved.inserts != 1 {
+			t.Errorf("Move(%d, %d): moved item has been inserted %d times",
+				tc.from, tc.to, moved.inserts)
+		}
+	}
+}
+
+func TestSwap(t *testing.T) {
+	for _, tc := range []struct {
+		i, j     int
+		expected []string
+	}{
+		{0, 3, []string{"d", "b", "c", "a"}},
+		{2, 1, []string{"a", "c", "b", "d"}},
+		{3, 2, []string{"a", "b", "d", "c"}},
+		{1, 1, []string{"a", "b", "c", "d"}},
+	} {
+		l, container := filledList("a", "b", "c", "d")
+		l.Swap(tc.i, tc.j)
+		expectItems(t, l, tc.expected...)
+		expectChildren(t, container, tc.expected...)
+	}
+}
+
+func TestSort(t *testing.T) {
+	l, container := filledList("b1", "a1", "c1", "a2", "b2")
+	items := make(map[string]*item)
+	for i := 0; i < l.Len(); i++ {
+		items[l.Item(i).label] = l.Item(i)
+	}
+	l.Sort(func(x, y *item) bool { return x.label[0] < y.label[0] })
+	// the sort is stable.
+	expectItems(t, l, "a1", "a2", "b1", "b2", "c1")
+	expectChildren(t, container, "a1", "a2", "b1", "b2", "c1")
+	// a1, a2, b2 is the longest run of items keeping their relative order,
+	// the other items are moved around them.
+	for label, it := range items {
+		expected := 0
+		if label == "b1" || label == "c1" {
+			expected = 1
+		}
+		if it.inserts != expected {
+			t.Errorf("%s has been inserted %d times, expected %d", label, it.inserts, expected)
+		}
+	}
+}
+
+func TestSortKeepsKeys(t *testing.T) {
+	container := newContainer()
+	var l List[*item, interface{}]
+	l.Init(container, 0)
+	var r reconciler
+	l.Reconcile(keys("b", "c", "a"), r.factory, r.update)
+	r.expect(t, keys("b", "c", "a"), nil)
+	l.Sort(func(x, y *item) bool { return x.label < y.label })
+	expectChildren(t, container, "a", "b", "c")
+
+	l.Reconcile(keys("c", "a"), r.factory, r.update)
+	r.expect(t, nil, keys("c", "a"))
+	expectChildren(t, container, "c", "a")
+}
+
+func TestAppendAll(t *testing.T) {
+	l, container := filledList("a")
+	b, c := newItem("b"), newItem("c")
+	l.AppendAll(b, c)
+	expectItems(t, l, "a", "b", "c")
+	expectChildren(t, container, "a", "b", "c")
+	l.AppendAll()
+	expectItems(t, l, "a", "b", "c")
+	l.Insert(1, newItem("x"))
+	expectChildren(t, container, "a", "x", "b", "c")
+}
+
+func TestClear(t *testing.T) {
+	l, container := filledList("a", "b")
+	items := l.Clear()
+	if l.Len() != 0 || len(items) != 2 || items[0].label != "a" || items[1].label != "b" {
+		t.Fatalf("unexpected result of Clear")
+	}
+	expectChildren(t, container)
+	for _, it := range items {
+		if it.destroyed {
+			t.Errorf("%s has been destroyed by Clear", it.label)
+		}
+	}
+	// cleared items can be inserted again.
+	l.AppendAll(items[1], items[0])
+	expectChildren(t, container, "b", "a")
+
+	removed := l.Remove(0)
+	expectChildren(t, container, "a")
+	if removed.label != "b" || removed.destroyed {
+		t.Errorf("unexpected result of Remove")
+	}
+	l.DestroyAll()
+	expectChildren(t, container)
+	if !items[0].destroyed {
+		t.Errorf("DestroyAll did not destroy the items")
+	}
+}
@@ -0,0 +1,50 @@
+package askew
+
+import "github.com/flyx/askew/runtime/js"
+
+// Markup is a component with static content given as HTML. Generated code
+// uses it for content of slots that is given as HTML.
+type Markup struct {
+	cd ComponentData
+}
+
+// markupTemplates caches the <template> elements created for each HTML
+// string given to NewMarkup.
+var markupTemplates = make(map[string]js.Value)
+
+// NewMarkup creates a component whose content is the given HTML.
+func NewMarkup(html string) *Markup {
+	t, ok := markupTemplates[html]
+	if !ok {
+		t = js.Global().Get("document").Call("createElement", "template")
+		t.Set("innerHTML", html)
+		markupTemplates[html] = t
+	}
+	ret := new(Markup)
+	ret.cd.Init(t.Get("content").Call("cloneNode", true))
+	return ret
+}
+
+// FirstNode returns the first DOM node of the content.
+// It implements the Component interface.
+func (m *Markup) FirstNode() js.Value {
+	return m.cd.First()
+}
+
+// InsertInto inserts the content into the given parent in front of before.
+// It implements the Component interface.
+func (m *Markup) InsertInto(parent js.Value, before js.Value) {
+	m.cd.DoInsert(parent, before)
+}
+
+// Extract removes the content from its current parent.
+// It implements the Component interface.
+func (m *Markup) Extract() {
+	m.cd.DoExtract()
+}
+
+// Destroy removes the content from the document if it is inserted.
+// It implements the Component interface.
+func (m *Markup) Destroy() {
+	m.cd.DoDestroy()
+}
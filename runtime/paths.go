@@ -1,6 +1,6 @@
 package askew
 
-import "syscall/js"
+import "github.com/flyx/askew/runtime/js"
 
 // WalkPath starts at root, which is assumed to be an HTML node, and for each
 // path item, selects the child node with the index corresponding to that path
@@ -12,3 +12,16 @@ func WalkPath(root js.Value, path ...int) js.Value {
 	}
 	return cur
 }
+
+// ChildIndex returns the index of node in the child nodes of its parent.
+// It is used for embeds inside a:if blocks, whose placeholders are resolved
+// before the block's content is rendered, which may shift their index.
+func ChildIndex(node js.Value) int {
+	siblings := node.Get("parentNode").Get("childNodes")
+	for i := 0; i < siblings.Length(); i++ {
+		if siblings.Index(i).Equal(node) {
+			return i
+		}
+	}
+	panic("node not found in its parent")
+}
@@ -0,0 +1,298 @@
+package askew
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/flyx/askew/runtime/js"
+)
+
+// RouteParams holds the values of the parameters in the pattern of a matched
+// route, indexed by their name. Values are unescaped.
+type RouteParams map[string]string
+
+// Route maps all paths matching Pattern to Handler.
+//
+// A pattern is a path starting with `/`. Each of its segments is either
+// literal text, which must match exactly, or a parameter `{name}`, which
+// matches any non-empty segment. The last segment may be a parameter
+// `{name...}`, which matches the rest of the path including slashes. The rest
+// may be empty, but the `/` in front of it must be present. A pattern ending
+// with `/` only matches paths that end with `/`.
+//
+// The handler is called with the values of the parameters whenever the
+// location changes to a path matching the pattern, unless the route and the
+// parameters did not change.
+type Route struct {
+	Pattern string
+	Handler func(params RouteParams)
+}
+
+// routeSegment is a segment of a parsed pattern.
+type routeSegment struct {
+	// text is the literal text of the segment, or the name of the parameter.
+	text string
+	// param is true if the segment is a parameter.
+	param bool
+	// rest is true if the parameter matches the rest of the path.
+	rest bool
+}
+
+// parsePattern splits the given pattern into its segments. Panics if the
+// pattern is malformed.
+func parsePattern(pattern string) []routeSegment {
+	if !strings.HasPrefix(pattern, "/") {
+		panic("route pattern must start with `/`: " + pattern)
+	}
+	parts := strings.Split(pattern[1:], "/")
+	ret := make([]routeSegment, len(parts))
+	for i, part := range parts {
+		if !strings.HasPrefix(part, "{") || !strings.HasSuffix(part, "}") {
+			if strings.ContainsAny(part, "{}") {
+				panic("invalid segment in route pattern: " + pattern)
+			}
+			ret[i].text = part
+			continue
+		}
+		ret[i].param = true
+		ret[i].text = part[1 : len(part)-1]
+		if strings.HasSuffix(ret[i].text, "...") {
+			if i != len(parts)-1 {
+				panic("`...` only allowed in last segment of route pattern: " + pattern)
+			}
+			ret[i].text = strings.TrimSuffix(ret[i].text, "...")
+			ret[i].rest = true
+		}
+		if ret[i].text == "" {
+			panic("missing parameter name in route pattern: " + pattern)
+		}
+	}
+	return ret
+}
+
+// matchPath returns the parameters if path matches the given segments.
+func matchPath(segments []routeSegment, path string) (RouteParams, bool) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, false
+	}
+	parts := strings.Split(path[1:], "/")
+	params := make(RouteParams)
+	for i, s := range segments {
+		if i >= len(parts) {
+			return nil, false
+		}
+		if s.rest {
+			value, err := url.PathUnescape(strings.Join(parts[i:], "/"))
+			if err != nil {
+				return nil, false
+			}
+			params[s.text] = value
+			return params, true
+		}
+		if !s.param {
+			if parts[i] != s.text {
+				return nil, false
+			}
+			continue
+		}
+		value, err := url.PathUnescape(parts[i])
+		if err != nil || value == "" {
+			return nil, false
+		}
+		params[s.text] = value
+	}
+	return params, len(parts) == len(segments)
+}
+
+// routeTable is a list of routes registered via AddRoutes.
+type routeTable struct {
+	routes   [][]routeSegment
+	handlers []func(params RouteParams)
+	clear    func()
+	// current is the index of the route that currently matches, -1 if none.
+	current int
+	params  RouteParams
+}
+
+// match returns the index and the parameters of the first route matching the
+// given path. Returns -1 if no route matches.
+func (t *routeTable) match(path string) (int, RouteParams) {
+	for i, segments := range t.routes {
+		if params, ok := matchPath(segments, path); ok {
+			return i, params
+		}
+	}
+	return -1, nil
+}
+
+// apply calls the handler of the route matching the given path if the route
+// or its parameters changed. If no route matches, clear is called instead.
+func (t *routeTable) apply(path string) {
+	index, params := t.match(path)
+	if index == t.current && sameParams(params, t.params) {
+		return
+	}
+	t.current, t.params = index, params
+	if index == -1 {
+		t.clear()
+	} else {
+		t.handlers[index](params)
+	}
+}
+
+func sameParams(a, b RouteParams) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, value := range a {
+		if other, ok := b[name]; !ok || other != value {
+			return false
+		}
+	}
+	return true
+}
+
+var (
+	// routeTables holds all registered route tables, indexed by their ID.
+	routeTables = make(map[int]*routeTable)
+	// nextRouteTable is the next free ID in routeTables.
+	nextRouteTable = 1
+	// routing is true once the listeners for popstate and for clicks on links
+	// have been registered, which happens with the first route table.
+	routing bool
+)
+
+// AddRoutes registers a table of routes and immediately calls the handler of
+// the route matching the current location. Afterwards, the table is applied
+// whenever the location changes, be it through Navigate, the browser's back
+// and forward buttons or clicks on links. If the location matches none of the
+// routes, clear is called. Only the first matching route of a table is used.
+//
+// Clicks on links that point to a path of the current origin matched by any
+// registered table are handled without loading a new document, unless the link
+// has a `target` or `download` attribute or a modifier key is pressed.
+//
+// The returned function removes the table.
+func AddRoutes(clear func(), routes ...Route) (remove func()) {
+	t := &routeTable{clear: clear, current: -1}
+	for _, r := range routes {
+		t.routes = append(t.routes, parsePattern(r.Pattern))
+		t.handlers = append(t.handlers, r.Handler)
+	}
+	id := nextRouteTable
+	nextRouteTable++
+	routeTables[id] = t
+	if !routing {
+		js.Global().Call("addEventListener", "popstate", js.FuncOf(handlePopState))
+		js.Global().Get("document").Call("addEventListener", "click",
+			js.FuncOf(handleLinkClick))
+		routing = true
+	}
+	t.apply(CurrentPath())
+	return func() {
+		delete(routeTables, id)
+	}
+}
+
+// AddRoutes registers the given routes like the global AddRoutes does. The
+// routes are removed when the component is destroyed.
+func (cd *ComponentData) AddRoutes(clear func(), routes ...Route) {
+	cd.routes = append(cd.routes, AddRoutes(clear, routes...))
+}
+
+// releaseRoutes removes all routes registered via AddRoutes.
+func (cd *ComponentData) releaseRoutes() {
+	for _, remove := range cd.routes {
+		remove()
+	}
+	cd.routes = nil
+}
+
+// CurrentPath returns the path of the current location.
+func CurrentPath() string {
+	return js.Global().Get("location").Get("pathname").String()
+}
+
+// Navigate adds the given path to the session history and applies all route
+// tables to it. The path may have a query and a fragment. It may also be a URL,
+// which must be of the current origin.
+func Navigate(path string) {
+	js.Global().Get("history").Call("pushState", nil, "", path)
+	applyRoutes()
+}
+
+// Redirect is like Navigate, but replaces the current entry of the session
+// history instead of adding a new one.
+func Redirect(path string) {
+	js.Global().Get("history").Call("replaceState", nil, "", path)
+	applyRoutes()
+}
+
+// applyRoutes applies all route tables to the current path in the order in
+// which they have been registered.
+func applyRoutes() {
+	ids := make([]int, 0, len(routeTables))
+	for id := range routeTables {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	path := CurrentPath()
+	for _, id := range ids {
+		// a handler may have removed the table by destroying its component.
+		if t, ok := routeTables[id]; ok {
+			t.apply(path)
+		}
+	}
+}
+
+func handlePopState(this js.Value, arguments []js.Value) interface{} {
+	applyRoutes()
+	return nil
+}
+
+// handleLinkClick navigates to the target of a clicked link if its path is
+// matched by a route and it does not only change the current location's hash.
+func handleLinkClick(this js.Value, arguments []js.Value) interface{} {
+	event := arguments[0]
+	if event.Get("defaultPrevented").Truthy() || event.Get("button").Truthy() {
+		return nil
+	}
+	for _, key := range []string{"ctrlKey", "metaKey", "shiftKey", "altKey"} {
+		if event.Get(key).Truthy() {
+			return nil
+		}
+	}
+	target := event.Get("target")
+	if t := target.Get("nodeType"); t.Type() != js.TypeNumber || t.Int() != 1 {
+		return nil
+	}
+	link := target.Call("closest", "a[href]")
+	if link.IsNull() || link.Call("hasAttribute", "download").Bool() {
+		return nil
+	}
+	if t := link.Call("getAttribute", "target"); !t.IsNull() && t.String() != "" &&
+		t.String() != "_self" {
+		return nil
+	}
+	location := js.Global().Get("location")
+	if link.Get("origin").String() != location.Get("origin").String() {
+		return nil
+	}
+	path, search := link.Get("pathname").String(), link.Get("search").String()
+	if hash := link.Get("hash").String(); hash != "" &&
+		path == location.Get("pathname").String() &&
+		search == location.Get("search").String() {
+		// links to a fragment of the current page are left to the browser,
+		// which scrolls to the fragment without a navigation.
+		return nil
+	}
+	for _, t := range routeTables {
+		if index, _ := t.match(path); index != -1 {
+			event.Call("preventDefault")
+			Navigate(path + search + link.Get("hash").String())
+			return nil
+		}
+	}
+	return nil
+}
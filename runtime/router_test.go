@@ -0,0 +1,222 @@
+package askew
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/flyx/askew/runtime/js"
+)
+
+func TestMatchPath(t *testing.T) {
+	for _, tc := range []struct {
+		pattern, path string
+		expected      RouteParams
+	}{
+		{"/", "/", RouteParams{}},
+		{"/", "/items", nil},
+		{"/items", "/items", RouteParams{}},
+		{"/items", "/items/", nil},
+		{"/items/", "/items/", RouteParams{}},
+		{"/items/", "/items", nil},
+		{"/items", "/other", nil},
+		{"/items", "items", nil},
+		{"/items/{id}", "/items/42", RouteParams{"id": "42"}},
+		{"/items/{id}", "/items/", nil},
+		{"/items/{id}", "/items/42/edit", nil},
+		{"/items/{id}", "/items/a%20b", RouteParams{"id": "a b"}},
+		{"/items/{id}", "/items/a%2Fb", RouteParams{"id": "a/b"}},
+		{"/items/{id}", "/items/%zz", nil},
+		{"/{a}/{b}", "/x/y", RouteParams{"a": "x", "b": "y"}},
+		{"/files/{path...}", "/files/a/b%20c", RouteParams{"path": "a/b c"}},
+		{"/files/{path...}", "/files/", RouteParams{"path": ""}},
+		{"/files/{path...}", "/files", nil},
+	} {
+		params, ok := matchPath(parsePattern(tc.pattern), tc.path)
+		if ok != (tc.expected != nil) || !reflect.DeepEqual(params, tc.expected) && ok {
+			t.Errorf("pattern %s, path %s: expected %v, got %v (match: %v)",
+				tc.pattern, tc.path, tc.expected, params, ok)
+		}
+	}
+}
+
+func TestParsePatternPanics(t *testing.T) {
+	for _, pattern := range []string{
+		"items", "/items/{}", "/items/{id", "/items/x{id}", "/{path...}/edit", "/{...}",
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("pattern %s: expected panic", pattern)
+				}
+			}()
+			parsePattern(pattern)
+		}()
+	}
+}
+
+// router records the calls of the handlers of a route table.
+type router struct {
+	calls []string
+}
+
+func (r *router) route(pattern, name string) Route {
+	return Route{Pattern: pattern, Handler: func(params RouteParams) {
+		call := name
+		if id, ok := params["id"]; ok {
+			call += ":" + id
+		}
+		r.calls = append(r.calls, call)
+	}}
+}
+
+func (r *router) clear() {
+	r.calls = append(r.calls, "clear")
+}
+
+func (r *router) expect(t *testing.T, calls ...string) {
+	t.Helper()
+	if len(calls) == 0 {
+		calls = nil
+	}
+	if !reflect.DeepEqual(r.calls, calls) {
+		t.Errorf("expected calls %v, got %v", calls, r.calls)
+	}
+	r.calls = nil
+}
+
+func (r *router) add() func() {
+	return AddRoutes(r.clear, r.route("/items", "list"), r.route("/items/{id}", "item"),
+		r.route("/{any...}", "fallback"))
+}
+
+func TestAddRoutes(t *testing.T) {
+	Redirect("/items")
+	var r router
+	remove := r.add()
+	defer remove()
+	r.expect(t, "list")
+
+	Navigate("/items/5")
+	r.expect(t, "item:5")
+	if p := CurrentPath(); p != "/items/5" {
+		t.Errorf("expected current path /items/5, got %s", p)
+	}
+	// neither the route nor its parameters changed.
+	Navigate("/items/5?tab=details#top")
+	r.expect(t)
+	Navigate("/items/6")
+	r.expect(t, "item:6")
+	// the first matching route is used.
+	Redirect("/other")
+	r.expect(t, "fallback")
+
+	js.Global().Get("history").Call("back")
+	r.expect(t, "item:5")
+	js.Global().Get("history").Call("forward")
+	r.expect(t, "fallback")
+
+	remove()
+	Navigate("/items")
+	r.expect(t)
+}
+
+func TestAddRoutesClear(t *testing.T) {
+	Redirect("/")
+	var r router
+	remove := AddRoutes(r.clear, r.route("/items/{id}", "item"))
+	defer remove()
+	// nothing has been shown yet, so there is nothing to clear.
+	r.expect(t)
+	Navigate("/items/1")
+	r.expect(t, "item:1")
+	Navigate("/elsewhere")
+	r.expect(t, "clear")
+	Navigate("/nowhere")
+	r.expect(t)
+}
+
+func TestDestroyReleasesRoutes(t *testing.T) {
+	Redirect("/")
+	before := len(routeTables)
+	it := newItem("a")
+	var r router
+	it.cd.AddRoutes(r.clear, r.route("/items/{id}", "item"))
+	if n := len(routeTables) - before; n != 1 {
+		t.Fatalf("expected 1 new route table, got %d", n)
+	}
+	Navigate("/items/1")
+	r.expect(t, "item:1")
+	it.Destroy()
+	if n := len(routeTables) - before; n != 0 {
+		t.Errorf("expected route table to be removed, %d remain", n)
+	}
+	Navigate("/items/2")
+	r.expect(t)
+}
+
+// click dispatches a click at the given node and returns true if the default
+// action has not been prevented.
+func click(node js.Value, init map[string]interface{}) bool {
+	if init == nil {
+		init = make(map[string]interface{})
+	}
+	init["bubbles"], init["cancelable"] = true, true
+	return node.Call("dispatchEvent",
+		js.Global().Get("MouseEvent").New("click", init)).Bool()
+}
+
+func TestLinkClicks(t *testing.T) {
+	Redirect("/items")
+	var r router
+	remove := AddRoutes(r.clear, r.route("/items", "list"), r.route("/items/{id}", "item"))
+	defer remove()
+	r.expect(t, "list")
+
+	doc := js.Global().Get("document")
+	body := doc.Get("body")
+	link := func(attrs ...string) js.Value {
+		a := doc.Call("createElement", "a")
+		for i := 0; i < len(attrs); i += 2 {
+			a.Call("setAttribute", attrs[i], attrs[i+1])
+		}
+		a.Call("appendChild", doc.Call("createElement", "span"))
+		body.Call("appendChild", a)
+		return a
+	}
+
+	for _, tc := range []struct {
+		name  string
+		attrs []string
+		init  map[string]interface{}
+	}{
+		{"unmatched", []string{"href", "/other"}, nil},
+		{"foreign origin", []string{"href", "http://example.com/items/1"}, nil},
+		{"target", []string{"href", "/items/1", "target", "_blank"}, nil},
+		{"download", []string{"href", "/items/1", "download", ""}, nil},
+		{"modifier", []string{"href", "/items/1"}, map[string]interface{}{"ctrlKey": true}},
+		{"middle button", []string{"href", "/items/1"}, map[string]interface{}{"button": 1}},
+		{"hash only", []string{"href", "#top"}, nil},
+	} {
+		a := link(tc.attrs...)
+		if !click(a, tc.init) {
+			t.Errorf("%s: click has been prevented", tc.name)
+		}
+		r.expect(t)
+		if p := CurrentPath(); p != "/items" {
+			t.Errorf("%s: path changed to %s", tc.name, p)
+		}
+		a.Call("remove")
+	}
+
+	a := link("href", "/items/3?x=1#y", "target", "_self")
+	// clicks on children of the link are handled, too.
+	if click(a.Get("firstChild"), nil) {
+		t.Errorf("click on link has not been prevented")
+	}
+	r.expect(t, "item:3")
+	location := js.Global().Get("location")
+	if href := location.Get("href").String(); href != "http://localhost/items/3?x=1#y" {
+		t.Errorf("unexpected location: %s", href)
+	}
+	a.Call("remove")
+}
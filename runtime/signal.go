@@ -0,0 +1,96 @@
+package askew
+
+import "sort"
+
+// Source is a value that notifies observers when it changes. It is
+// implemented by *Signal.
+type Source interface {
+	// observe calls f with the current value and afterwards each time the
+	// value changes. The returned func stops the observation.
+	observe(f func(value interface{})) (stop func())
+}
+
+// Signal holds a value and notifies its subscribers whenever the value
+// changes. Bind a signal to a node of a component with `signal(expr)` in
+// `a:assign`, where expr evaluates to the signal. Each time the signal's
+// value changes, the bound property, style, dataset entry or classes are
+// updated without touching the rest of the component.
+//
+// The zero value holds the zero value of T and has no subscribers.
+type Signal[T comparable] struct {
+	value T
+	subs  map[int]func(T)
+	next  int
+}
+
+// NewSignal creates a signal holding the given value.
+func NewSignal[T comparable](value T) *Signal[T] {
+	return &Signal[T]{value: value}
+}
+
+// Get returns the current value.
+func (s *Signal[T]) Get() T {
+	return s.value
+}
+
+// Set sets the current value. If it differs from the previous value, all
+// subscribers are called with the new value in the order they subscribed.
+func (s *Signal[T]) Set(value T) {
+	if value == s.value {
+		return
+	}
+	s.value = value
+	ids := make([]int, 0, len(s.subs))
+	for id := range s.subs {
+		ids = append(ids, id)
+	}
+	// subscription IDs are increasing, so this is the subscription order.
+	sort.Ints(ids)
+	for _, id := range ids {
+		// a subscriber may have unsubscribed another one.
+		if f, ok := s.subs[id]; ok {
+			f(value)
+		}
+	}
+}
+
+// Update sets the value to the result of calling f with the current value.
+func (s *Signal[T]) Update(f func(value T) T) {
+	s.Set(f(s.value))
+}
+
+// Subscribe registers f to be called whenever the value changes. f is not
+// called with the current value. The returned func removes the subscription.
+func (s *Signal[T]) Subscribe(f func(value T)) (unsubscribe func()) {
+	if s.subs == nil {
+		s.subs = make(map[int]func(T))
+	}
+	id := s.next
+	s.next++
+	s.subs[id] = f
+	return func() {
+		delete(s.subs, id)
+	}
+}
+
+func (s *Signal[T]) observe(f func(value interface{})) (stop func()) {
+	f(s.value)
+	return s.Subscribe(func(value T) {
+		f(value)
+	})
+}
+
+// BindSignal assigns the current value of the given source to bv and
+// assigns each new value as long as the component lives. This is used by
+// generated code for `signal(expr)` in `a:assign`.
+func (cd *ComponentData) BindSignal(bv BoundValue, src Source) {
+	cd.signals = append(cd.signals, src.observe(bv.set))
+}
+
+// releaseSignals stops all observations started by BindSignal.
+func (cd *ComponentData) releaseSignals() {
+	for _, stop := range cd.signals {
+		stop()
+	}
+	cd.signals = nil
+}
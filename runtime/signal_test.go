@@ -0,0 +1,76 @@
+package askew
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSignalNotifiesSubscribers(t *testing.T) {
+	s := NewSignal(1)
+	var calls []string
+	s.Subscribe(func(value int) { calls = append(calls, "first") })
+	unsubscribe := s.Subscribe(func(value int) { calls = append(calls, "second") })
+	s.Subscribe(func(value int) { calls = append(calls, "third") })
+
+	s.Set(1)
+	if calls != nil {
+		t.Errorf("setting the current value notified subscribers: %v", calls)
+	}
+	s.Set(2)
+	if expected := []string{"first", "second", "third"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("expected calls %v, got %v", expected, calls)
+	}
+	if s.Get() != 2 {
+		t.Errorf("expected value 2, got %d", s.Get())
+	}
+
+	calls = nil
+	unsubscribe()
+	s.Update(func(value int) int { return value * 3 })
+	if expected := []string{"first", "third"}; !reflect.DeepEqual(calls, expected) {
+		t.Errorf("expected calls %v, got %v", expected, calls)
+	}
+	if s.Get() != 6 {
+		t.Errorf("expected value 6, got %d", s.Get())
+	}
+}
+
+func TestSignalUnsubscribeDuringSet(t *testing.T) {
+	var s Signal[string]
+	var second func()
+	var calls int
+	s.Subscribe(func(value string) {
+		calls++
+		second()
+	})
+	second = s.Subscribe(func(value string) {
+		t.Errorf("unsubscribed func has been called with %q", value)
+	})
+	s.Set("a")
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestBindSignal(t *testing.T) {
+	it := newItem("initial")
+	label := NewSignal("first")
+	it.cd.BindSignal(BoundPropertyAt(it.FirstNode(), "textContent"), label)
+	li := it.FirstNode()
+	if text := li.Get("textContent").String(); text != "first" {
+		t.Errorf("current value has not been assigned, got %q", text)
+	}
+	label.Set("second")
+	if text := li.Get("textContent").String(); text != "second" {
+		t.Errorf("new value has not been assigned, got %q", text)
+	}
+
+	it.Destroy()
+	if n := len(label.subs); n != 0 {
+		t.Errorf("expected observation to be stopped, %d subscriptions remain", n)
+	}
+	label.Set("third")
+	if text := li.Get("textContent").String(); text != "second" {
+		t.Errorf("value has been assigned after Destroy, got %q", text)
+	}
+}
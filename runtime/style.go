@@ -0,0 +1,19 @@
+package askew
+
+import "github.com/flyx/askew/runtime/js"
+
+// AddStyle appends a <style> element with the given id and content to the
+// document's head, unless the document already contains an element with that
+// id, e.g. because the site's HTML file already contains the style.
+//
+// This is used by generated code to inject the scoped style of a component.
+func AddStyle(id, content string) {
+	doc := js.Global().Get("document")
+	if !doc.Call("getElementById", id).IsNull() {
+		return
+	}
+	style := doc.Call("createElement", "style")
+	style.Set("id", id)
+	style.Set("textContent", content)
+	doc.Get("head").Call("appendChild", style)
+}
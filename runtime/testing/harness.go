@@ -0,0 +1,185 @@
+// Package testing provides helpers for testing askew components with
+// `go test`.
+//
+// A Harness mounts components into the document and fires events on their
+// nodes. Nodes are addressed with CSS selectors relative to the harness' root
+// node. When not compiling for the browser, the document is the in-memory DOM
+// of github.com/flyx/askew/runtime/js.
+//
+// Since this package has the same name as the standard library's testing
+// package, import it with an alias, e.g. askewtest.
+package testing
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	gotesting "testing"
+
+	askew "github.com/flyx/askew/runtime"
+	"github.com/flyx/askew/runtime/js"
+)
+
+// UpdateEnv is the environment variable that, if set to a non-empty value,
+// makes Snapshot write the current HTML to the snapshot files instead of
+// comparing against them.
+const UpdateEnv = "ASKEW_UPDATE_SNAPSHOTS"
+
+// Harness mounts components into a node of the document and interacts with
+// them. Every action that fires an event waits until all handlers it
+// triggered have finished.
+//
+// Failures, e.g. selectors that do not match any node, are reported via the
+// test's Fatalf.
+type Harness struct {
+	t       gotesting.TB
+	root    js.Value
+	mounted []askew.Component
+}
+
+// New creates a Harness with an empty <div> as root node, which is appended
+// to the document's body. The node is removed and all mounted components are
+// destroyed when the test finishes.
+func New(t gotesting.TB) *Harness {
+	doc := js.Global().Get("document")
+	h := &Harness{t: t, root: doc.Call("createElement", "div")}
+	doc.Get("body").Call("appendChild", h.root)
+	t.Cleanup(func() {
+		for i := len(h.mounted) - 1; i >= 0; i-- {
+			h.mounted[i].Destroy()
+		}
+		h.root.Call("remove")
+	})
+	return h
+}
+
+// Root returns the root node of the harness.
+func (h *Harness) Root() js.Value {
+	return h.root
+}
+
+// Mount inserts the given component at the end of the root node.
+func (h *Harness) Mount(c askew.Component) {
+	c.InsertInto(h.root, js.Null())
+	h.mounted = append(h.mounted, c)
+}
+
+// Query returns the first node matching the given selector.
+// Fails the test if there is no such node.
+func (h *Harness) Query(selector string) js.Value {
+	h.t.Helper()
+	ret := h.root.Call("querySelector", selector)
+	if ret.IsNull() {
+		h.t.Fatalf("no node matches `%s`", selector)
+	}
+	return ret
+}
+
+// QueryAll returns all nodes matching the given selector.
+func (h *Harness) QueryAll(selector string) []js.Value {
+	list := h.root.Call("querySelectorAll", selector)
+	ret := make([]js.Value, list.Length())
+	for i := range ret {
+		ret[i] = list.Index(i)
+	}
+	return ret
+}
+
+// Exists returns true iff there is a node matching the given selector.
+func (h *Harness) Exists(selector string) bool {
+	return !h.root.Call("querySelector", selector).IsNull()
+}
+
+// Dispatch fires an event of the given type on the node matching the given
+// selector. The event bubbles and is cancelable. Returns true iff a handler
+// prevented the event's default action.
+func (h *Harness) Dispatch(selector, event string) (prevented bool) {
+	h.t.Helper()
+	return h.dispatch(h.Query(selector), "Event", event)
+}
+
+func (h *Harness) dispatch(target js.Value, class, event string) bool {
+	e := js.Global().Get(class).New(event, map[string]interface{}{
+		"bubbles": true, "cancelable": true})
+	ret := !target.Call("dispatchEvent", e).Bool()
+	askew.WaitAsync()
+	return ret
+}
+
+// Click fires a click event on the node matching the given selector.
+// Clicking toggles checkboxes and radio buttons, and clicking a submit
+// button submits its form, unless a handler prevents the default action.
+// Returns true iff a handler prevented the default action of the click.
+func (h *Harness) Click(selector string) (prevented bool) {
+	h.t.Helper()
+	return h.dispatch(h.Query(selector), "MouseEvent", "click")
+}
+
+// Input sets the value of the form control matching the given selector and
+// fires an input and a change event on it, like a user editing the control
+// would.
+func (h *Harness) Input(selector, value string) {
+	h.t.Helper()
+	target := h.Query(selector)
+	target.Set("value", value)
+	h.dispatch(target, "InputEvent", "input")
+	h.dispatch(target, "Event", "change")
+}
+
+// Submit fires a submit event on the form matching the given selector.
+// Returns true iff a handler prevented the default action, i.e. sending the
+// form to the server.
+func (h *Harness) Submit(selector string) (prevented bool) {
+	h.t.Helper()
+	form := h.Query(selector)
+	if form.Get("nodeName").String() != "FORM" {
+		h.t.Fatalf("`%s` does not match a form", selector)
+	}
+	return h.dispatch(form, "SubmitEvent", "submit")
+}
+
+// Text returns the text content of the node matching the given selector, with
+// leading and trailing whitespace removed.
+func (h *Harness) Text(selector string) string {
+	h.t.Helper()
+	return strings.TrimSpace(h.Query(selector).Get("textContent").String())
+}
+
+// Value returns the value of the form control matching the given selector.
+func (h *Harness) Value(selector string) string {
+	h.t.Helper()
+	return h.Query(selector).Get("value").String()
+}
+
+// HTML returns the HTML rendering of the content of the root node.
+func (h *Harness) HTML() string {
+	return h.root.Get("innerHTML").String()
+}
+
+// Snapshot compares the HTML rendering of the content of the root node to
+// the file testdata/<name>.html. The file is created if it does not exist,
+// and overwritten if the environment variable named by UpdateEnv is set.
+// Fails the test if the rendering differs from the file's content.
+func (h *Harness) Snapshot(name string) {
+	h.t.Helper()
+	path := filepath.Join("testdata", name+".html")
+	actual := h.HTML()
+	expected, err := os.ReadFile(path)
+	if os.Getenv(UpdateEnv) != "" || os.IsNotExist(err) {
+		if err := os.MkdirAll("testdata", 0755); err != nil {
+			h.t.Fatalf("cannot create testdata: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(actual), 0644); err != nil {
+			h.t.Fatalf("cannot write snapshot: %v", err)
+		}
+		h.t.Logf("wrote snapshot %s", path)
+		return
+	}
+	if err != nil {
+		h.t.Fatalf("cannot read snapshot: %v", err)
+	}
+	if string(expected) != actual {
+		h.t.Fatalf("HTML differs from snapshot %s (set %s=1 to update)\n"+
+			"expected:\n%s\nactual:\n%s", path, UpdateEnv, expected, actual)
+	}
+}
@@ -0,0 +1,248 @@
+package testing
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	gotesting "testing"
+
+	askew "github.com/flyx/askew/runtime"
+	"github.com/flyx/askew/runtime/js"
+)
+
+// form is a hand-written component that records the events it receives.
+type form struct {
+	cd        askew.ComponentData
+	log       []string
+	destroyed bool
+}
+
+func newForm(content string) *form {
+	tmpl := js.Global().Get("document").Call("createElement", "template")
+	tmpl.Set("innerHTML", content)
+	ret := &form{}
+	ret.cd.Init(tmpl.Get("content").Call("cloneNode", true))
+	return ret
+}
+
+// listen records events of the given type on the node matching selector.
+func (f *form) listen(selector, event string, prevent bool) {
+	node := f.cd.DocumentFragment().Call("querySelector", selector)
+	f.cd.AddEventListener(node, event, func(this js.Value, arguments []js.Value) interface{} {
+		f.log = append(f.log, selector+":"+event)
+		if prevent {
+			arguments[0].Call("preventDefault")
+		}
+		return nil
+	})
+}
+
+func (f *form) FirstNode() js.Value {
+	return f.cd.First()
+}
+
+func (f *form) InsertInto(parent js.Value, before js.Value) {
+	f.cd.DoInsert(parent, before)
+}
+
+func (f *form) Extract() {
+	f.cd.DoExtract()
+}
+
+func (f *form) Destroy() {
+	f.cd.DoDestroy()
+	f.destroyed = true
+}
+
+func (f *form) expectLog(t *gotesting.T, expected ...string) {
+	t.Helper()
+	if fmt.Sprint(f.log) != fmt.Sprint(expected) {
+		t.Fatalf("expected events %v, got %v", expected, f.log)
+	}
+	f.log = nil
+}
+
+const formHTML = `<form><input type="text" name="n" value="initial">` +
+	`<input type="checkbox"><button type="submit">Send</button></form>` +
+	`<p class="out"> Hello  </p>`
+
+// fatalTB records the first call of Fatalf and aborts the calling function.
+type fatalTB struct {
+	gotesting.TB
+	message string
+}
+
+type fatalAbort struct{}
+
+func (f *fatalTB) Helper() {}
+
+func (f *fatalTB) Fatalf(format string, args ...interface{}) {
+	f.message = fmt.Sprintf(format, args...)
+	panic(fatalAbort{})
+}
+
+// expectFatal returns the message given to Fatalf by action.
+func expectFatal(t *gotesting.T, action func(h *Harness)) string {
+	t.Helper()
+	tb := &fatalTB{TB: t}
+	h := New(tb)
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				if _, ok := r.(fatalAbort); !ok {
+					panic(r)
+				}
+			}
+		}()
+		action(h)
+	}()
+	if tb.message == "" {
+		t.Fatalf("expected the action to fail")
+	}
+	return tb.message
+}
+
+func TestMount(t *gotesting.T) {
+	var first, second *form
+	var root js.Value
+	t.Run("mount", func(t *gotesting.T) {
+		h := New(t)
+		root = h.Root()
+		if !root.Get("isConnected").Bool() {
+			t.Fatalf("root is not part of the document")
+		}
+		first, second = newForm(`<p id="a">a</p>`), newForm(`<p id="b">b</p>`)
+		h.Mount(first)
+		h.Mount(second)
+		if html := h.HTML(); html != `<p id="a">a</p><p id="b">b</p>` {
+			t.Fatalf("unexpected HTML: %s", html)
+		}
+	})
+	if !first.destroyed || !second.destroyed {
+		t.Fatalf("mounted components have not been destroyed")
+	}
+	if root.Get("isConnected").Bool() {
+		t.Fatalf("root has not been removed from the document")
+	}
+}
+
+func TestQueries(t *gotesting.T) {
+	h := New(t)
+	h.Mount(newForm(formHTML))
+	if n := h.Query("input").Get("name").String(); n != "n" {
+		t.Fatalf("Query returned the wrong node: %s", n)
+	}
+	if l := len(h.QueryAll("input")); l != 2 {
+		t.Fatalf("QueryAll returned %d nodes", l)
+	}
+	if len(h.QueryAll("table")) != 0 {
+		t.Fatalf("QueryAll returned nodes that do not match")
+	}
+	if !h.Exists("button") || h.Exists("table") {
+		t.Fatalf("unexpected result of Exists")
+	}
+	if s := h.Text(".out"); s != "Hello" {
+		t.Fatalf("unexpected text %q", s)
+	}
+	if v := h.Value("input"); v != "initial" {
+		t.Fatalf("unexpected value %q", v)
+	}
+	if msg := expectFatal(t, func(h *Harness) { h.Query("table") }); msg != "no node matches `table`" {
+		t.Fatalf("unexpected failure: %s", msg)
+	}
+}
+
+func TestEvents(t *gotesting.T) {
+	h := New(t)
+	f := newForm(formHTML)
+	f.listen("form", "submit", true)
+	f.listen("[type=text]", "input", false)
+	f.listen("[type=text]", "change", false)
+	f.listen("[type=checkbox]", "change", false)
+	f.listen(".out", "custom", true)
+	h.Mount(f)
+
+	h.Input("[type=text]", "changed")
+	f.expectLog(t, "[type=text]:input", "[type=text]:change")
+	if v := h.Value("[type=text]"); v != "changed" {
+		t.Fatalf("Input did not set the value: %q", v)
+	}
+
+	if h.Click("[type=checkbox]") {
+		t.Fatalf("click on checkbox has been prevented")
+	}
+	f.expectLog(t, "[type=checkbox]:change")
+	if !h.Query("[type=checkbox]").Get("checked").Bool() {
+		t.Fatalf("click did not check the checkbox")
+	}
+
+	h.Click("button")
+	f.expectLog(t, "form:submit")
+	if !h.Submit("form") {
+		t.Fatalf("Submit did not report the prevented submission")
+	}
+	f.expectLog(t, "form:submit")
+
+	if !h.Dispatch(".out", "custom") {
+		t.Fatalf("Dispatch did not report the prevented event")
+	}
+	f.expectLog(t, ".out:custom")
+
+	if msg := expectFatal(t, func(h *Harness) {
+		h.Mount(newForm(formHTML))
+		h.Submit(".out")
+	}); msg != "`.out` does not match a form" {
+		t.Fatalf("unexpected failure: %s", msg)
+	}
+}
+
+func TestWaitsForAsyncHandlers(t *gotesting.T) {
+	h := New(t)
+	f := newForm(`<button>go</button>`)
+	done := false
+	f.cd.AddEventListener(f.cd.DocumentFragment().Get("firstChild"), "click",
+		func(this js.Value, arguments []js.Value) interface{} {
+			finish := askew.BeginAsync()
+			go func() {
+				defer finish()
+				done = true
+			}()
+			return nil
+		})
+	h.Mount(f)
+	h.Click("button")
+	if !done {
+		t.Fatalf("Click returned before the async handler finished")
+	}
+}
+
+func TestSnapshot(t *gotesting.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	h := New(t)
+	h.Mount(newForm(`<p>a</p>`))
+	h.Snapshot("p")
+	content, err := os.ReadFile(filepath.Join("testdata", "p.html"))
+	if err != nil || string(content) != "<p>a</p>" {
+		t.Fatalf("snapshot has not been written: %q, %v", content, err)
+	}
+	// comparing against the written file succeeds.
+	h.Snapshot("p")
+
+	if err := os.WriteFile(filepath.Join("testdata", "q.html"), []byte("<p>b</p>"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if msg := expectFatal(t, func(h *Harness) {
+		h.Mount(newForm(`<p>a</p>`))
+		h.Snapshot("q")
+	}); msg == "" {
+		t.Fatalf("differing snapshot has not been reported")
+	}
+}
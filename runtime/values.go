@@ -1,6 +1,11 @@
 package askew
 
-import "syscall/js"
+import (
+	"strings"
+	"time"
+
+	"github.com/flyx/askew/runtime/js"
+)
 
 // StringValue provides access to a dynamic value of string type.
 type StringValue struct {
@@ -23,20 +28,40 @@ type IntValue struct {
 }
 
 // Get returns the current value of the linked node.
+// Panics if the value is not a number, see Valid.
 func (iv *IntValue) Get() int {
 	raw := iv.get()
 	switch raw.Type() {
 	case js.TypeNumber:
-		return raw.Int()
+		if !raw.IsNaN() {
+			return raw.Int()
+		}
 	case js.TypeString:
-		return js.Global().Call("parseInt", raw, 10).Int()
+		if n := js.Global().Call("parseInt", raw, 10); !n.IsNaN() {
+			return n.Int()
+		}
 	case js.TypeBoolean:
 		if raw.Bool() {
 			return 1
 		}
 		return 0
 	}
-	panic("Cannot retrieve int value from " + raw.String())
+	panic("Cannot retrieve int value from `" + raw.String() + "`")
+}
+
+// Valid returns false iff the current value of the linked node cannot be
+// converted to an int, e.g. because it is an empty string.
+func (iv *IntValue) Valid() bool {
+	raw := iv.get()
+	switch raw.Type() {
+	case js.TypeNumber:
+		return !raw.IsNaN()
+	case js.TypeString:
+		return !js.Global().Call("parseInt", raw, 10).IsNaN()
+	case js.TypeBoolean:
+		return true
+	}
+	return false
 }
 
 // Set updates the underlying node with the given value.
@@ -69,6 +94,189 @@ func (bv *BoolValue) Set(value bool) {
 	bv.set(value)
 }
 
+// StringSliceValue provides access to a dynamic value of []string type,
+// which is represented in the DOM as an array of strings.
+type StringSliceValue struct {
+	BoundValue
+}
+
+// Get returns the current value of the linked node.
+func (sv *StringSliceValue) Get() []string {
+	raw := sv.get()
+	switch raw.Type() {
+	case js.TypeObject:
+		ret := make([]string, raw.Length())
+		for i := range ret {
+			ret[i] = raw.Index(i).String()
+		}
+		return ret
+	case js.TypeUndefined, js.TypeNull:
+		return nil
+	}
+	panic("Cannot retrieve []string value from " + raw.String())
+}
+
+// Set updates the underlying node with the given value.
+func (sv *StringSliceValue) Set(value []string) {
+	items := make([]interface{}, len(value))
+	for i := range value {
+		items[i] = value[i]
+	}
+	sv.set(items)
+}
+
+// FloatValue provides access to a dynamic value of float64 type.
+type FloatValue struct {
+	BoundValue
+}
+
+// Get returns the current value of the linked node.
+// Panics if the value is not a number, see Valid.
+func (fv *FloatValue) Get() float64 {
+	raw := fv.get()
+	switch raw.Type() {
+	case js.TypeNumber:
+		if !raw.IsNaN() {
+			return raw.Float()
+		}
+	case js.TypeString:
+		if n := js.Global().Call("parseFloat", raw); !n.IsNaN() {
+			return n.Float()
+		}
+	case js.TypeBoolean:
+		if raw.Bool() {
+			return 1
+		}
+		return 0
+	}
+	panic("Cannot retrieve float64 value from `" + raw.String() + "`")
+}
+
+// Valid returns false iff the current value of the linked node cannot be
+// converted to a float64, e.g. because it is an empty string.
+func (fv *FloatValue) Valid() bool {
+	raw := fv.get()
+	switch raw.Type() {
+	case js.TypeNumber:
+		return !raw.IsNaN()
+	case js.TypeString:
+		return !js.Global().Call("parseFloat", raw).IsNaN()
+	case js.TypeBoolean:
+		return true
+	}
+	return false
+}
+
+// Valid returns false iff v has a Valid method that returns false, i.e. the
+// value of the node v is linked to cannot be converted to v's type. Models
+// use this to leave their field untouched while the user's input is not a
+// valid value, e.g. when a number input is empty.
+func Valid(v interface{}) bool {
+	if vv, ok := v.(interface{ Valid() bool }); ok {
+		return vv.Valid()
+	}
+	return true
+}
+
+// Set updates the underlying node with the given value.
+func (fv *FloatValue) Set(value float64) {
+	fv.set(value)
+}
+
+// Layouts of the values of <input> elements with type date, time and
+// datetime-local, as used by TimeValue.
+const (
+	DateLayout     = "2006-01-02"
+	TimeLayout     = "15:04"
+	DateTimeLayout = "2006-01-02T15:04"
+)
+
+// TimeValue provides access to a dynamic value of time.Time type, which is
+// represented in the DOM as a string in local time.
+type TimeValue struct {
+	BoundValue
+	// Layout is used for formatting values given to Set. It defaults to
+	// DateTimeLayout.
+	Layout string
+}
+
+// Get returns the current value of the linked node. Values in any of the
+// layouts DateLayout, TimeLayout and DateTimeLayout, the latter two
+// optionally with seconds, are accepted. Returns the zero time if the value
+// is empty.
+func (tv *TimeValue) Get() time.Time {
+	raw := tv.get()
+	if raw.Type() != js.TypeString {
+		panic("Cannot retrieve time value from " + raw.String())
+	}
+	str := raw.String()
+	if str == "" {
+		return time.Time{}
+	}
+	var layout string
+	switch {
+	case strings.ContainsRune(str, 'T'):
+		layout = DateTimeLayout
+	case strings.ContainsRune(str, ':'):
+		layout = TimeLayout
+	default:
+		layout = DateLayout
+	}
+	if strings.Count(str, ":") > 1 {
+		layout += ":05"
+	}
+	ret, err := time.ParseInLocation(layout, str, time.Local)
+	if err != nil {
+		panic("Cannot retrieve time value from " + str + ": " + err.Error())
+	}
+	return ret
+}
+
+// Set updates the underlying node with the given value. The zero time is
+// represented by an empty string.
+func (tv *TimeValue) Set(value time.Time) {
+	if value.IsZero() {
+		tv.set("")
+		return
+	}
+	layout := tv.Layout
+	if layout == "" {
+		layout = DateTimeLayout
+	}
+	tv.set(value.In(time.Local).Format(layout))
+}
+
+// Converter is implemented by pointers to custom types that can be bound to
+// values in the DOM. If the bound variable has a named type T other than the
+// types with a predefined wrapper, a CustomValue[T, *T] is generated, so *T
+// must implement Converter[T].
+type Converter[T any] interface {
+	*T
+	// FromJS sets the value from the given value in the DOM.
+	FromJS(value js.Value)
+	// ToJS returns the value to be set in the DOM. It may be any value
+	// accepted by js.ValueOf.
+	ToJS() interface{}
+}
+
+// CustomValue provides access to a dynamic value of a custom type T, which is
+// converted from and to the DOM by *T.
+type CustomValue[T any, PT Converter[T]] struct {
+	BoundValue
+}
+
+// Get returns the current value of the linked node.
+func (cv *CustomValue[T, PT]) Get() T {
+	var ret T
+	PT(&ret).FromJS(cv.get())
+	return ret
+}
+
+// Set updates the underlying node with the given value.
+func (cv *CustomValue[T, PT]) Set(value T) {
+	cv.set(PT(&value).ToJS())
+}
+
 // RawValue provides acces to the raw underlying value.
 type RawValue struct {
 	BoundValue
@@ -0,0 +1,143 @@
+package askew
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flyx/askew/runtime/js"
+)
+
+// input returns a BoundValue for the value of a new <input> element of the
+// given type.
+func input(typ string) BoundValue {
+	node := js.Global().Get("document").Call("createElement", "input")
+	node.Set("type", typ)
+	return BoundPropertyAt(node, "value")
+}
+
+func TestFloatValue(t *testing.T) {
+	fv := FloatValue{input("number")}
+	for _, tc := range []struct {
+		raw      interface{}
+		expected float64
+	}{
+		{"1.25", 1.25},
+		{"-3", -3},
+		{"2e3", 2000},
+	} {
+		fv.set(tc.raw)
+		if v := fv.Get(); v != tc.expected {
+			t.Errorf("%v: expected %v, got %v", tc.raw, tc.expected, v)
+		}
+	}
+	fv.Set(0.5)
+	if v := fv.Get(); v != 0.5 {
+		t.Errorf("expected 0.5 after Set, got %v", v)
+	}
+
+	// properties of other nodes may hold numbers and booleans.
+	for _, tc := range []struct {
+		raw      interface{}
+		expected float64
+	}{
+		{4.5, 4.5},
+		{true, 1},
+		{false, 0},
+	} {
+		raw := FloatValue{&constant{js.ValueOf(tc.raw)}}
+		if v := raw.Get(); v != tc.expected {
+			t.Errorf("%v: expected %v, got %v", tc.raw, tc.expected, v)
+		}
+	}
+}
+
+// constant is a BoundValue that always returns the same value.
+type constant struct {
+	value js.Value
+}
+
+func (c *constant) get() js.Value {
+	return c.value
+}
+
+func (c *constant) set(value interface{}) {}
+
+func TestTimeValue(t *testing.T) {
+	for _, tc := range []struct {
+		raw      string
+		expected time.Time
+	}{
+		{"2026-03-04", time.Date(2026, 3, 4, 0, 0, 0, 0, time.Local)},
+		{"07:05", time.Date(0, 1, 1, 7, 5, 0, 0, time.Local)},
+		{"07:05:09", time.Date(0, 1, 1, 7, 5, 9, 0, time.Local)},
+		{"2026-03-04T07:05", time.Date(2026, 3, 4, 7, 5, 0, 0, time.Local)},
+		{"2026-03-04T07:05:09", time.Date(2026, 3, 4, 7, 5, 9, 0, time.Local)},
+		{"", time.Time{}},
+	} {
+		tv := TimeValue{BoundValue: &constant{js.ValueOf(tc.raw)}}
+		if v := tv.Get(); !v.Equal(tc.expected) {
+			t.Errorf("%q: expected %v, got %v", tc.raw, tc.expected, v)
+		}
+	}
+
+	value := time.Date(2026, 3, 4, 7, 5, 9, 0, time.Local)
+	for _, tc := range []struct {
+		layout, expected string
+	}{
+		{"", "2026-03-04T07:05"},
+		{DateLayout, "2026-03-04"},
+		{TimeLayout, "07:05"},
+		{DateTimeLayout, "2026-03-04T07:05"},
+	} {
+		tv := TimeValue{BoundValue: input("text"), Layout: tc.layout}
+		tv.Set(value)
+		if v := tv.get().String(); v != tc.expected {
+			t.Errorf("layout %q: expected %q, got %q", tc.layout, tc.expected, v)
+		}
+	}
+	tv := TimeValue{BoundValue: input("date")}
+	tv.Set(time.Time{})
+	if v := tv.get().String(); v != "" {
+		t.Errorf("zero time has been set as %q", v)
+	}
+}
+
+func TestTimeValuePanicsOnMalformedValue(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic")
+		}
+	}()
+	tv := TimeValue{BoundValue: &constant{js.ValueOf("2026-13-01")}}
+	tv.Get()
+}
+
+// level is a custom type converted from and to its name.
+type level int
+
+func (l *level) FromJS(value js.Value) {
+	switch value.String() {
+	case "high":
+		*l = 2
+	case "low":
+		*l = 1
+	default:
+		*l = 0
+	}
+}
+
+func (l *level) ToJS() interface{} {
+	return [...]string{"none", "low", "high"}[*l]
+}
+
+func TestCustomValue(t *testing.T) {
+	cv := CustomValue[level, *level]{input("text")}
+	cv.set("high")
+	if v := cv.Get(); v != 2 {
+		t.Errorf("expected 2, got %d", v)
+	}
+	cv.Set(1)
+	if v := cv.get().String(); v != "low" {
+		t.Errorf("expected %q, got %q", "low", v)
+	}
+}
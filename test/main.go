@@ -7,7 +7,7 @@ import (
 
 	"github.com/flyx/askew/test/ui"
 
-	"syscall/js"
+	"github.com/flyx/askew/runtime/js"
 )
 
 type handler struct{}
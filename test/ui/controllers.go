@@ -3,8 +3,9 @@ package ui
 import (
 	"fmt"
 	"strconv"
+	"time"
 
-	"syscall/js"
+	"github.com/flyx/askew/runtime/js"
 )
 
 func (o *row) foo() {}
@@ -57,3 +58,61 @@ func (o *AutoFieldTest) click() {
 		js.Global().Call("alert", o.content)
 	}()
 }
+
+func (o *ModelTest) show() {
+	go func() {
+		js.Global().Call("alert", "name="+o.name+", age="+strconv.Itoa(o.age)+
+			", subscribed="+strconv.FormatBool(o.subscribed))
+	}()
+}
+
+func (o *Clock) mounted() {
+	o.ticker = time.NewTicker(time.Second)
+	go func(c <-chan time.Time) {
+		seconds := 0
+		for range c {
+			seconds++
+			o.Seconds.Set(strconv.Itoa(seconds))
+		}
+	}(o.ticker.C)
+}
+
+func (o *Clock) unmounted() {
+	o.ticker.Stop()
+}
+
+func (o *SignalTest) click() {
+	o.Clicks.Update(func(value int) int { return value + 1 })
+	o.Label.Set(fmt.Sprintf("clicked %d times", o.Clicks.Get()))
+	o.Odd.Set(o.Clicks.Get()%2 == 1)
+}
+
+// Priority is bound to an input of OrderForm via a custom converter.
+type Priority int
+
+// FromJS sets the priority from the input's text.
+func (p *Priority) FromJS(value js.Value) {
+	switch value.String() {
+	case "high":
+		*p = 2
+	case "normal":
+		*p = 1
+	default:
+		*p = 0
+	}
+}
+
+// ToJS returns the text of the priority.
+func (p *Priority) ToJS() interface{} {
+	return [...]string{"low", "normal", "high"}[*p]
+}
+
+func (o *OrderForm) submit(price float64, due time.Time) {
+	priority := o.Priority.Get()
+	o.Summary.Set(fmt.Sprintf("%.2f until %s (%s)", price,
+		due.Format("Jan 2"), priority.ToJS()))
+}
+
+func (o *SurveyForm) submit(agree bool, topics []string) {
+	o.Result.Set(fmt.Sprintf("agree: %v, topics: %v", agree, topics))
+}
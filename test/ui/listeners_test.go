@@ -0,0 +1,27 @@
+package ui
+
+import (
+	"testing"
+
+	askew "github.com/flyx/askew/runtime"
+	askewtest "github.com/flyx/askew/runtime/testing"
+)
+
+func TestDestroyReleasesListeners(t *testing.T) {
+	before := askew.LiveFuncs()
+	h := askewtest.New(t)
+	c := NewNameForms(true, "after")
+	h.Mount(c)
+	c.Forms.Append(NewNameForm(1))
+	c.Forms.Append(NewNameForm(2))
+	if askew.LiveFuncs() == before {
+		t.Fatalf("component did not register any listeners")
+	}
+	c.Destroy()
+	if n := askew.LiveFuncs() - before; n != 0 {
+		t.Errorf("%d listener funcs are still live after Destroy", n)
+	}
+	if h.Exists("form") {
+		t.Errorf("destroyed component is still in the document")
+	}
+}
@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"testing"
+
+	askewtest "github.com/flyx/askew/runtime/testing"
+)
+
+func TestModelUpdatesFields(t *testing.T) {
+	h := askewtest.New(t)
+	c := NewModelTest("Ada")
+	h.Mount(c)
+	if v := h.Value(`input[type="text"]`); v != "Ada" {
+		t.Fatalf("text input has value %q, want %q", v, "Ada")
+	}
+	if v := h.Value(`input[type="number"]`); v != "30" {
+		t.Fatalf("number input has value %q, want %q", v, "30")
+	}
+
+	h.Input(`input[type="text"]`, "Grace")
+	h.Input(`input[type="number"]`, "42")
+	h.Click(`input[type="checkbox"]`)
+	if c.name != "Grace" || c.age != 42 || !c.subscribed {
+		t.Fatalf("unexpected model state: name=%q age=%d subscribed=%v",
+			c.name, c.age, c.subscribed)
+	}
+}
+
+func TestModelKeepsFieldOnInvalidNumber(t *testing.T) {
+	for _, input := range []string{"", "abc", " "} {
+		t.Run(input, func(t *testing.T) {
+			h := askewtest.New(t)
+			c := NewModelTest("")
+			h.Mount(c)
+			h.Input(`input[type="number"]`, "42")
+			h.Input(`input[type="number"]`, input)
+			if c.age != 42 {
+				t.Fatalf("age is %d after input %q, want 42", c.age, input)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	askewtest "github.com/flyx/askew/runtime/testing"
+)
+
+func TestOrderFormConvertsValues(t *testing.T) {
+	h := askewtest.New(t)
+	c := NewOrderForm()
+	h.Mount(c)
+
+	h.Input(`input[name="price"]`, "12.5")
+	h.Input(`input[name="due"]`, "2026-03-04")
+	h.Input(`input[name="priority"]`, "high")
+	if !h.Submit("form") {
+		t.Fatalf("submit has not been prevented")
+	}
+	if text := h.Text("p"); text != "12.50 until Mar 4 (high)" {
+		t.Errorf("unexpected summary %q", text)
+	}
+	if v := c.Price.Get(); v != 12.5 {
+		t.Errorf("expected price 12.5, got %v", v)
+	}
+
+	c.Due.Set(time.Date(2026, 12, 24, 18, 30, 0, 0, time.Local))
+	if v := h.Value(`input[name="due"]`); v != "2026-12-24" {
+		t.Errorf("date input has value %q", v)
+	}
+	c.At.Set(time.Date(2026, 1, 1, 7, 5, 0, 0, time.Local))
+	if v := h.Value(`input[name="at"]`); v != "07:05" {
+		t.Errorf("time input has value %q", v)
+	}
+	c.Priority.Set(Priority(1))
+	if v := h.Value(`input[name="priority"]`); v != "normal" {
+		t.Errorf("priority input has value %q", v)
+	}
+	c.Due.Set(time.Time{})
+	if v := h.Value(`input[name="due"]`); v != "" {
+		t.Errorf("zero time has been set as %q", v)
+	}
+	if !c.Due.Get().IsZero() {
+		t.Errorf("empty input did not give the zero time")
+	}
+}
@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	askewtest "github.com/flyx/askew/runtime/testing"
+)
+
+// texts returns the text content of all nodes matching the given selector,
+// without whitespace.
+func texts(h *askewtest.Harness, selector string) []string {
+	nodes := h.QueryAll(selector)
+	ret := make([]string, len(nodes))
+	for i, n := range nodes {
+		ret[i] = strings.Join(strings.Fields(n.Get("textContent").String()), "")
+	}
+	return ret
+}
+
+func expectTexts(t *testing.T, h *askewtest.Harness, selector string, expected ...string) {
+	t.Helper()
+	actual := texts(h, selector)
+	if len(actual) != len(expected) {
+		t.Fatalf("`%s`: expected %v, got %v", selector, expected, actual)
+	}
+	for i := range actual {
+		if actual[i] != expected[i] {
+			t.Fatalf("`%s`: expected %v, got %v", selector, expected, actual)
+		}
+	}
+}
+
+func TestRefreshReusesNodes(t *testing.T) {
+	h := askewtest.New(t)
+	c := NewTodoList("")
+	h.Mount(c)
+	expectTexts(t, h, ".items li")
+	if !h.Exists("p") {
+		t.Fatalf("a:if block has not been rendered")
+	}
+
+	c.items = []string{"a", "b"}
+	c.Refresh()
+	expectTexts(t, h, ".items li", "a", "b")
+	if h.Exists("p") {
+		t.Fatalf("a:if block has not been removed")
+	}
+	first := h.Query(".items li")
+	first.Set("title", "state")
+
+	c.items = []string{"c", "d", "e"}
+	c.Refresh()
+	expectTexts(t, h, ".items li", "c", "d", "e")
+	if !h.Query(".items li").Equal(first) || first.Get("title").String() != "state" {
+		t.Fatalf("node of a block without nested blocks has not been reused")
+	}
+
+	c.items = nil
+	c.Refresh()
+	expectTexts(t, h, ".items li")
+	if !h.Exists("p") {
+		t.Fatalf("a:if block has not been rendered again")
+	}
+}
+
+func TestRefreshReplacesNodesWithNestedBlocks(t *testing.T) {
+	h := askewtest.New(t)
+	c := NewTodoList("")
+	h.Mount(c)
+	c.groups = [][]string{{"a", "b"}, {"c"}}
+	c.Refresh()
+	expectTexts(t, h, ".groups ul", "ab", "c")
+	expectTexts(t, h, ".groups li", "a", "b", "c")
+	first := h.Query(".groups ul")
+
+	c.groups = [][]string{{"d"}}
+	c.Refresh()
+	expectTexts(t, h, ".groups ul", "d")
+	if h.Query(".groups ul").Equal(first) {
+		t.Fatalf("node of a block with nested blocks has been reused")
+	}
+}
+
+func TestRefreshKeepsParameters(t *testing.T) {
+	h := askewtest.New(t)
+	c := NewTodoList("Todo")
+	h.Mount(c)
+	expectTexts(t, h, "h4", "Todo")
+	// the expression references the parameter, not the field.
+	c.title = ""
+	c.Refresh()
+	expectTexts(t, h, "h4", "Todo")
+}
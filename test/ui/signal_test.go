@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"testing"
+
+	askewtest "github.com/flyx/askew/runtime/testing"
+)
+
+func TestSignalUpdatesBoundValues(t *testing.T) {
+	h := askewtest.New(t)
+	c := NewSignalTest()
+	h.Mount(c)
+	if text := h.Text("p"); text != "not clicked" {
+		t.Fatalf("expected initial text %q, got %q", "not clicked", text)
+	}
+	if h.Exists("p.red") {
+		t.Fatalf("class has been set initially")
+	}
+
+	h.Click("button")
+	if text := h.Text("p"); text != "clicked 1 times" {
+		t.Errorf("unexpected text %q", text)
+	}
+	if !h.Exists("p.red") {
+		t.Errorf("class has not been set")
+	}
+	h.Click("button")
+	if text := h.Text("p"); text != "clicked 2 times" {
+		t.Errorf("unexpected text %q", text)
+	}
+	if h.Exists("p.red") {
+		t.Errorf("class has not been removed")
+	}
+
+	// the signals outlive the component, but must not update it anymore.
+	p := h.Query("p")
+	c.Destroy()
+	c.Label.Set("changed")
+	if text := p.Get("textContent").String(); text != "clicked 2 times" {
+		t.Errorf("destroyed component has been updated: %q", text)
+	}
+}
@@ -0,0 +1,86 @@
+// Package typecheck type-checks generated Go code and reports errors at the
+// position in the askew sources where the erroneous code originates from.
+package typecheck
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/flyx/askew/data"
+	"github.com/flyx/askew/output"
+	"golang.org/x/tools/go/packages"
+)
+
+// Check loads and type-checks the packages at the given relative paths, which
+// must have been generated before. Errors located in generated files are
+// recorded in diag. Errors in other files are ignored since they are not
+// caused by askew sources and will be reported by the Go compiler.
+//
+// The returned error is non-nil if the packages could not be loaded.
+func Check(relPaths []string, diag *data.Diagnostics) error {
+	if len(relPaths) == 0 {
+		return nil
+	}
+	patterns := make([]string, len(relPaths))
+	for i, relPath := range relPaths {
+		patterns[i] = "./" + filepath.ToSlash(relPath)
+	}
+	cfg := &packages.Config{
+		// dependencies are type-checked from source since export data is
+		// specific to the toolchain version.
+		Mode: packages.NeedName | packages.NeedFiles |
+			packages.NeedCompiledGoFiles | packages.NeedImports | packages.NeedDeps |
+			packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesSizes,
+		// the package is checked for the browser since the user's code may
+		// import syscall/js or be restricted to GOOS=js by build constraints.
+		Env: append(os.Environ(), "GOOS=js", "GOARCH=wasm"),
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return err
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	maps := make(map[string]output.SourceMap)
+	for _, pkg := range pkgs {
+		for _, e := range pkg.TypeErrors {
+			pos := e.Fset.PositionFor(e.Pos, false)
+			if !isGenerated(pos.Filename) {
+				continue
+			}
+			sm, ok := maps[pos.Filename]
+			if !ok {
+				content, err := ioutil.ReadFile(pos.Filename)
+				if err != nil {
+					return err
+				}
+				sm = output.ReadSourceMap(content)
+				maps[pos.Filename] = sm
+			}
+			if origin, ok := sm[pos.Line]; ok && origin.Pos.File != "" {
+				msg := e.Msg
+				if origin.Attr != "" {
+					msg = "in `" + origin.Attr + "`: " + msg
+				}
+				diag.Add(&data.Error{Pos: origin.Pos, Offset: -1, Message: msg})
+			} else {
+				path, err := filepath.Rel(wd, pos.Filename)
+				if err != nil {
+					path = pos.Filename
+				}
+				diag.Add(&data.Error{Pos: data.Position{File: path, Line: pos.Line,
+					Column: pos.Column}, Offset: -1, Message: e.Msg})
+			}
+		}
+	}
+	return nil
+}
+
+func isGenerated(path string) bool {
+	return strings.HasSuffix(path, ".askew.go") ||
+		strings.HasSuffix(path, ".asite.go")
+}
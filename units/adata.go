@@ -23,9 +23,9 @@ func (dp *aDataProcessor) Process(n *html.Node) (descend bool,
 	if def.Type != html.TextNode || def.NextSibling != nil {
 		return false, nil, errors.New(": must have plain text as content and nothing else")
 	}
-	fields, err := parsers.ParseFields(def.Data)
+	fields, offsets, err := parsers.ParseFields(def.Data)
 	if err != nil {
-		return false, nil, errors.New(": unable to parse fields: " + err.Error())
+		return false, nil, parsers.WrapError("unable to parse fields", err)
 	}
 	if dp.cmp.Fields != nil {
 		names := make(map[string]struct{})
@@ -38,8 +38,31 @@ func (dp *aDataProcessor) Process(n *html.Node) (descend bool,
 			}
 		}
 	}
+	start := data.PositionOf(n)
+	// the content starts directly after the start tag, which is assumed to
+	// have no attributes.
+	start.Column += len("<" + n.Data + ">")
+	text := []rune(def.Data)
+	for i, f := range fields {
+		f.Origin.Pos = offsetPosition(start, text[:offsets[i]])
+	}
 	dp.cmp.Fields = append(dp.cmp.Fields, fields...)
 
 	replacement = &html.Node{Type: html.CommentNode, Data: "data"}
 	return
 }
+
+// offsetPosition returns the position after the given text that starts at
+// the given position.
+func offsetPosition(start data.Position, text []rune) data.Position {
+	ret := start
+	for _, r := range text {
+		if r == '\n' {
+			ret.Line++
+			ret.Column = 1
+		} else {
+			ret.Column++
+		}
+	}
+	return ret
+}
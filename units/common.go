@@ -2,6 +2,9 @@ package units
 
 import (
 	"errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/flyx/askew/attributes"
 	"github.com/flyx/askew/data"
@@ -23,13 +26,14 @@ func (p *unitProcessor) processUnitContent(
 	var indexList []int
 	w := walker.Walker{
 		Text:      &aTextProcessor{&unit.Block, &indexList},
-		Embed:     &embedProcessor{p.syms, &indexList, nil},
+		Embed:     &embedProcessor{p.syms, &indexList, nil, false},
 		IndexList: &indexList}
 	if component != nil {
 		w.Data = &aDataProcessor{component, &indexList}
 		w.Controller = &controllerProcessor{p.syms, component, &indexList}
-		w.StdElements = &elementHandler{stdElementHandler{p.syms, &indexList, &unit.Block, -1, nil}, component}
+		w.StdElements = &elementHandler{stdElementHandler{p.syms, &indexList, &unit.Block, -1, nil, false}, component}
 		w.Handlers = &handlersProcessor{p.syms, component, &indexList}
+		w.Slot = &slotProcessor{p.syms, &indexList}
 	} else {
 		w.StdElements = walker.Allow{}
 	}
@@ -80,6 +84,29 @@ func (atp *aTextProcessor) Process(n *html.Node) (descend bool, replacement *htm
 		return false, nil, errors.New(": node may not have child nodes")
 	}
 	atp.b.Assignments = append(atp.b.Assignments, data.Assignment{
-		Expression: expr, Path: append([]int(nil), *atp.indexList...), Target: data.BoundValue{Kind: data.BoundSelf}})
+		Expression: expr, Path: append([]int(nil), *atp.indexList...), Target: data.BoundValue{Kind: data.BoundSelf},
+		Origin: data.Origin{Pos: data.PositionOf(n), Attr: "expr"}})
 	return false, &html.Node{Type: html.CommentNode, Data: "a:text"}, nil
 }
+
+// handlerPosition returns the position of the declaration of the handler with
+// the given name inside content, which is the text content of the element at
+// pos. The position of the element is returned if the declaration is not
+// found or is located in the line of the element's start tag, since the
+// column in that line is not known.
+func handlerPosition(pos data.Position, content, name string) data.Position {
+	loc := regexp.MustCompile(`(^|[^\w])` + regexp.QuoteMeta(name) + `\s*\(`).
+		FindStringSubmatchIndex(content)
+	if loc == nil {
+		return pos
+	}
+	// the name starts where the first group ends.
+	start := loc[3]
+	lineStart := strings.LastIndexByte(content[:start], '\n')
+	if lineStart == -1 {
+		return pos
+	}
+	pos.Line += strings.Count(content[:start], "\n")
+	pos.Column = utf8.RuneCountInString(content[lineStart:start])
+	return pos
+}
@@ -2,6 +2,7 @@ package units
 
 import (
 	"errors"
+	"sort"
 	"strings"
 
 	"github.com/flyx/askew/attributes"
@@ -28,7 +29,8 @@ func (p *componentProcessor) Process(n *html.Node) (descend bool,
 	replacement = &html.Node{Type: html.DocumentNode}
 	cmp := &data.Component{Unit: data.Unit{}, Template: replacement,
 		Name: cmpAttrs.Name, Parameters: cmpAttrs.Params,
-		GenNewInit: cmpAttrs.GenNewInit}
+		GenNewInit: cmpAttrs.GenNewInit, Pos: data.PositionOf(n),
+		ID: componentID(p.syms.Packages[p.syms.CurPkg].ImportPath, cmpAttrs.Name)}
 	if cmpAttrs.Usage == nil {
 		cmp.GenList, cmp.GenOpt = true, true
 	} else {
@@ -48,11 +50,26 @@ func (p *componentProcessor) Process(n *html.Node) (descend bool,
 			t := param.Type
 			v := param.Name
 			cmp.Fields = append(cmp.Fields, &data.Field{Name: param.Name, Type: &t,
-				DefaultValue: &v})
+				DefaultValue: &v, Origin: data.Origin{Pos: data.PositionOf(n), Attr: "params"}})
 		}
 	}
 
 	err = p.processUnitContent(n, &cmp.Unit, cmp, replacement, true)
+	if err == nil && cmp.Style != "" {
+		markComponentScope(cmp)
+	}
+	if err == nil {
+		err = resolveModels(cmp)
+	}
+	if err == nil {
+		err = checkSlotNames(cmp)
+	}
+	if err == nil {
+		p.warnHookNames(cmp)
+		if !p.syms.IgnoreUnused {
+			p.warnUnusedHandlers(cmp, data.PositionOf(n))
+		}
+	}
 
 	curFile := p.syms.CurAskewFile()
 	if curFile.Components == nil {
@@ -62,3 +79,75 @@ func (p *componentProcessor) Process(n *html.Node) (descend bool,
 
 	return
 }
+
+// resolveModels sets the type of each model of the given component to the
+// type of the field it references.
+func resolveModels(cmp *data.Component) error {
+	for i := range cmp.Models {
+		m := &cmp.Models[i]
+		for _, f := range cmp.Fields {
+			if f.Name == m.Field {
+				m.Type = f.Type
+				break
+			}
+		}
+		if m.Type == nil {
+			return data.ErrorAt(m.Origin.Pos,
+				errors.New("a:model references unknown field: `"+m.Field+"`"))
+		}
+		switch m.Type.Kind {
+		case data.StringType, data.IntType, data.BoolType:
+		default:
+			return data.ErrorAt(m.Origin.Pos, errors.New(
+				"a:model requires a field of type string, int or bool, `"+
+					m.Field+"` has type "+m.Type.String()))
+		}
+	}
+	return nil
+}
+
+// warnHookNames emits a warning for each handler that has the name of a
+// lifecycle hook, but is not used as such because it has parameters, a
+// return value or is captured.
+func (p *componentProcessor) warnHookNames(cmp *data.Component) {
+	for _, name := range data.LifecycleHooks {
+		h, ok := cmp.Handlers[name]
+		if !ok || cmp.HasHook(name) {
+			continue
+		}
+		reason := "is captured"
+		if len(h.Params) != 0 || h.Returns != nil {
+			reason = "has parameters or a return value"
+		}
+		p.syms.Diagnostics.Warn(h.Pos, "handler `"+name+"` "+reason+
+			" and is therefore not called as lifecycle hook")
+	}
+}
+
+// warnUnusedHandlers emits a warning for each handler declared in
+// <a:handlers> that is not referenced by any capture and is not used as
+// lifecycle hook.
+func (p *componentProcessor) warnUnusedHandlers(cmp *data.Component, pos data.Position) {
+	used := make(map[string]struct{})
+	for _, c := range cmp.Captures {
+		for _, m := range c.Mappings {
+			used[m.Handler] = struct{}{}
+		}
+	}
+	names := make([]string, 0, len(cmp.Handlers))
+	for _, name := range data.LifecycleHooks {
+		if cmp.HasHook(name) {
+			used[name] = struct{}{}
+		}
+	}
+	for name := range cmp.Handlers {
+		if _, ok := used[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		p.syms.Diagnostics.Warn(pos, "handler `"+name+"` of component `"+
+			cmp.Name+"` is never captured")
+	}
+}
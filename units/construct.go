@@ -20,32 +20,49 @@ type constructProcessor struct {
 	syms       *data.Symbols
 	e          *data.Embed
 	parentType constructParent
+	// target is the embedded component if it is declared in the current
+	// module.
+	target *data.Component
+}
+
+// constructorName returns the name of the func that creates a component of
+// the given type. The component is returned if it is declared in the current
+// module.
+func constructorName(syms *data.Symbols, typeAttr string) (string, *data.Component, error) {
+	c, symName, aliasName, err := syms.ResolveComponent(typeAttr)
+	if err != nil {
+		if _, ok := err.(data.OutsideModuleErr); !ok {
+			return "", nil, err
+		}
+		return aliasName + ".New" + symName, nil, nil
+	}
+	if aliasName != "" {
+		return aliasName + "." + c.NewName(), c, nil
+	}
+	return c.NewName(), c, nil
 }
 
 func (cp *constructProcessor) Process(n *html.Node) (descend bool,
 	replacement *html.Node, err error) {
+	if attributes.Exists(n.Attr, "a:slot") {
+		return false, nil, cp.processSlotContent(n)
+	}
 	if cp.e.Kind == data.DirectEmbed {
 		return false, nil, errors.New(": element requires list or optional embed as parent")
 	}
 	typeAttr := attributes.Val(n.Attr, "type")
 	var newName string
+	target := cp.target
 	if typeAttr == "" {
 		if cp.parentType.newName == "" {
-			return false, nil, errors.New(": must supply type ")
+			return false, nil, errors.New(": attribute `type` missing")
 		}
 		newName = cp.parentType.newName
 	} else {
-		c, symName, aliasName, err := cp.syms.ResolveComponent(typeAttr)
+		newName, target, err = constructorName(cp.syms, typeAttr)
 		if err != nil {
-			if _, ok := err.(data.OutsideModuleErr); !ok {
-				return false, nil, err
-			}
-			newName = aliasName + ".New" + symName
-		}
-		if aliasName != "" {
-			newName = aliasName + "."
+			return false, nil, err
 		}
-		newName += c.NewName()
 	}
 
 	var attrs attributes.General
@@ -66,30 +83,76 @@ func (cp *constructProcessor) Process(n *html.Node) (descend bool,
 	}
 	args, err := parsers.AnalyseArguments(attributes.Val(n.Attr, "args"))
 	if err != nil {
-		return false, nil, errors.New(": in args: " + err.Error())
+		return false, nil, parsers.WrapError("invalid args", err)
 	}
 	if cp.parentType.numParams >= 0 && args.Count != cp.parentType.numParams {
 		return false, nil, fmt.Errorf(
 			": target component requires %d arguments, but %d were given", cp.parentType.numParams, args.Count)
 	}
+	origin := data.Origin{Pos: data.PositionOf(n)}
 	if attrs.If != nil {
+		origin.Attr = "a:if"
 		cp.e.ConstructorCalls = append(cp.e.ConstructorCalls,
 			data.ConstructorCall{ConstructorName: newName, Args: args,
-				Kind: data.ConstructIf, Expression: attrs.If.Expression})
+				Kind: data.ConstructIf, Expression: attrs.If.Expression,
+				Origin: origin, Target: target})
 	} else if attrs.For != nil {
 		if cp.e.Kind == data.OptionalEmbed {
 			return false, nil, errors.New(": a:for not allowed inside optional embed")
 		}
+		origin.Attr = "a:for"
 		cp.e.ConstructorCalls = append(cp.e.ConstructorCalls,
 			data.ConstructorCall{ConstructorName: newName, Args: args,
 				Kind: data.ConstructFor, Index: attrs.For.Index,
-				Variable: attrs.For.Variable, Expression: attrs.For.Expression})
+				Variable: attrs.For.Variable, Expression: attrs.For.Expression,
+				Origin: origin, Target: target})
 	} else {
 		cp.e.ConstructorCalls = append(cp.e.ConstructorCalls,
 			data.ConstructorCall{ConstructorName: newName, Args: args,
-				Kind: data.ConstructDirect})
+				Kind: data.ConstructDirect, Origin: origin, Target: target})
 	}
 	w := walker.Walker{TextNode: walker.WhitespaceOnly{}}
 	_, _, err = w.WalkChildren(n, &walker.Siblings{Cur: n.FirstChild})
 	return false, nil, err
 }
+
+// processSlotContent processes an <a:construct> with an `a:slot` attribute,
+// which creates the content for a slot of the embedded component.
+func (cp *constructProcessor) processSlotContent(n *html.Node) error {
+	var attrs attributes.IncludeChild
+	if err := attributes.ExtractAskewAttribs(n, &attrs); err != nil {
+		return err
+	}
+	if len(attrs.Others) > 0 {
+		return errors.New(": content for a slot may not have askew attributes other than `a:slot`")
+	}
+	typeAttr := attributes.Val(n.Attr, "type")
+	if typeAttr == "" {
+		return errors.New(": attribute `type` missing")
+	}
+	newName, c, err := constructorName(cp.syms, typeAttr)
+	if err != nil {
+		return err
+	}
+	var args data.Arguments
+	if attributes.Exists(n.Attr, "args") {
+		if args, err = parsers.AnalyseArguments(attributes.Val(n.Attr, "args")); err != nil {
+			return parsers.WrapError("invalid args", err)
+		}
+	}
+	if c != nil && args.Count != len(c.Parameters) {
+		return fmt.Errorf(": target component requires %d arguments, but %d were given",
+			len(c.Parameters), args.Count)
+	}
+	pos := data.PositionOf(n)
+	if err := addSlotContent(cp.e, cp.target, data.SlotContent{Slot: attrs.Slot,
+		Construct: &data.ConstructorCall{ConstructorName: newName, Args: args,
+			Kind: data.ConstructDirect, Origin: data.Origin{Pos: pos, Attr: "args"},
+			Target: c},
+		Origin: data.Origin{Pos: pos, Attr: "args"}}); err != nil {
+		return err
+	}
+	w := walker.Walker{TextNode: walker.WhitespaceOnly{}}
+	_, _, err = w.WalkChildren(n, &walker.Siblings{Cur: n.FirstChild})
+	return err
+}
@@ -13,8 +13,32 @@ import (
 )
 
 type formValue struct {
-	t     *data.ParamType
-	radio bool
+	t        *data.ParamType
+	radio    bool
+	checkbox bool
+	// layout is the name of the time layout of date and time inputs.
+	layout string
+}
+
+// timeLayout returns the name of the runtime's layout constant for values of
+// <input> elements with the given type. Returns "" if the type has no time
+// value.
+func timeLayout(inputType string) string {
+	switch inputType {
+	case "date":
+		return "DateLayout"
+	case "time":
+		return "TimeLayout"
+	case "datetime-local":
+		return "DateTimeLayout"
+	}
+	return ""
+}
+
+// stringSlice returns the type []string.
+func stringSlice() *data.ParamType {
+	return &data.ParamType{Kind: data.ArrayType,
+		ValueType: &data.ParamType{Kind: data.StringType}}
 }
 
 type formValueDiscovery struct {
@@ -29,26 +53,43 @@ func (d *formValueDiscovery) Process(n *html.Node) (descend bool, replacement *h
 	}
 	switch n.DataAtom {
 	case atom.Input:
-		switch inputType := attributes.Val(n.Attr, "type"); inputType {
+		switch inputType := strings.ToLower(attributes.Val(n.Attr, "type")); inputType {
 		case "radio":
 			v.radio = true
 			v.t = &data.ParamType{Kind: data.StringType}
+		case "checkbox":
+			v.checkbox = true
+			v.t = &data.ParamType{Kind: data.BoolType}
+		case "file":
+			v.t = &data.ParamType{Kind: data.JSValueType}
 		case "number", "range":
 			if strings.ContainsRune(attributes.Val(n.Attr, "min"), '.') ||
 				strings.ContainsRune(attributes.Val(n.Attr, "max"), '.') ||
-				strings.ContainsRune(attributes.Val(n.Attr, "step"), '.') {
-				return false, nil, errors.New(": non-integer " + inputType + " inputs not supported")
+				strings.ContainsRune(attributes.Val(n.Attr, "step"), '.') ||
+				attributes.Val(n.Attr, "step") == "any" {
+				v.t = &data.ParamType{Kind: data.NamedType, Name: "float64"}
+			} else {
+				v.t = &data.ParamType{Kind: data.IntType}
 			}
-			v.t = &data.ParamType{Kind: data.IntType}
-		case "text", "":
+		case "date", "time", "datetime-local":
+			v.t = &data.ParamType{Kind: data.NamedType, Name: "time.Time"}
+			v.layout = timeLayout(inputType)
+		case "text", "", "email", "password", "search", "tel", "url", "color",
+			"month", "week":
 			v.t = &data.ParamType{Kind: data.StringType}
-		case "submit", "reset", "hidden":
+		case "submit", "reset", "hidden", "button", "image":
 			return false, nil, nil
 		default:
 			return false, nil, errors.New(": unsupported input type: `" + inputType + "`")
 		}
-	case atom.Select, atom.Textarea:
-		v.t, v.radio = &data.ParamType{Kind: data.StringType}, false
+	case atom.Select:
+		if attributes.Exists(n.Attr, "multiple") {
+			v.t = stringSlice()
+		} else {
+			v.t = &data.ParamType{Kind: data.StringType}
+		}
+	case atom.Textarea:
+		v.t = &data.ParamType{Kind: data.StringType}
 	default:
 		return true, nil, nil
 	}
@@ -57,6 +98,12 @@ func (d *formValueDiscovery) Process(n *html.Node) (descend bool, replacement *h
 		if v.radio && existing.radio {
 			return false, nil, nil
 		}
+		if v.checkbox && existing.checkbox {
+			// a group of checkboxes yields the values of the checked ones.
+			existing.t = stringSlice()
+			d.values[name] = existing
+			return false, nil, nil
+		}
 		return false, nil, errors.New(": duplicate name `" + name + "` in same form")
 	}
 	d.values[name] = v
@@ -82,6 +129,8 @@ type stdElementHandler struct {
 	b          *data.Block
 	curFormPos int
 	curForm    map[string]formValue
+	// inFor is true if the handled elements are inside an a:for block.
+	inFor bool
 }
 
 type elementHandler struct {
@@ -169,15 +218,33 @@ func (eh *elementHandler) mapCaptures(n *html.Node, v []data.UnboundEventMapping
 		}
 		ret = append(ret, data.EventMapping{
 			Event: unmapped.Event, Handler: unmapped.Handler, ParamMappings: mapped,
-			Handling: handling, FromController: fromController})
+			Handling: handling, FromController: fromController,
+			Delegate: unmapped.Delegate})
 	}
 
 	eh.cmp.Captures = append(eh.cmp.Captures, data.Capture{
-		Path: append([]int(nil), *eh.indexList...), Mappings: ret})
+		Path: append([]int(nil), *eh.indexList...), Mappings: ret,
+		Origin: data.Origin{Pos: data.PositionOf(n), Attr: "a:capture"}})
 	return nil
 }
 
-func (eh *elementHandler) processBindings(arr []data.VariableMapping) error {
+// bindable returns true iff a wrapper for values of type t exists in the
+// runtime, which is the case for the basic types, js.Value and []string.
+// Of the other predeclared types, only float64 is supported. Named types other
+// than time.Time must implement the runtime's Converter.
+func bindable(t *data.ParamType) bool {
+	switch t.Kind {
+	case data.StringType, data.IntType, data.BoolType, data.JSValueType:
+		return true
+	case data.NamedType:
+		return !t.IsPredeclared() || t.Name == "float64"
+	case data.ArrayType:
+		return t.ValueType.Kind == data.StringType
+	}
+	return false
+}
+
+func (eh *elementHandler) processBindings(n *html.Node, arr []data.VariableMapping) error {
 	formDepth := -1
 	if eh.curFormPos != -1 {
 		formDepth = len(*eh.indexList) - eh.curFormPos
@@ -197,6 +264,7 @@ func (eh *elementHandler) processBindings(arr []data.VariableMapping) error {
 			if vb.Variable.Type == nil {
 				vb.Variable.Type = val.t
 			}
+			vb.Layout = val.layout
 		} else {
 			if vb.Variable.Type == nil {
 				switch vb.Value.Kind {
@@ -212,6 +280,17 @@ func (eh *elementHandler) processBindings(arr []data.VariableMapping) error {
 					vb.Variable.Type = &data.ParamType{Kind: data.StringType}
 				}
 			}
+			if vb.Value.Kind == data.BoundProperty && vb.Value.ID() == "value" &&
+				n.DataAtom == atom.Input {
+				vb.Layout = timeLayout(attributes.Val(n.Attr, "type"))
+			}
+		}
+		if !bindable(vb.Variable.Type) {
+			return errors.New(": cannot bind `" + vb.Variable.Name +
+				"` of type `" + vb.Variable.Type.String() + "`")
+		}
+		if vb.Variable.Type.Kind != data.NamedType || vb.Variable.Type.Name != "time.Time" {
+			vb.Layout = ""
 		}
 		vb.Path = path
 		eh.cmp.Variables = append(eh.cmp.Variables, vb)
@@ -219,7 +298,36 @@ func (eh *elementHandler) processBindings(arr []data.VariableMapping) error {
 	return nil
 }
 
-func (seh *stdElementHandler) processAssignments(arr []data.Assignment, path []int) error {
+// processModels adds the given models of the node n to the component. The
+// types of the models are set after the component has been processed since
+// the referenced fields may be declared later.
+func (eh *elementHandler) processModels(n *html.Node, arr []data.Model) {
+	path := append([]int(nil), *eh.indexList...)
+	for _, m := range arr {
+		m.Event = modelEvent(n)
+		m.Path = path
+		m.Origin = data.Origin{Pos: data.PositionOf(n), Attr: "a:model"}
+		eh.cmp.Models = append(eh.cmp.Models, m)
+	}
+}
+
+// modelEvent returns the name of the DOM event after which a model on the
+// given node updates its field. Elements whose value is changed by discrete
+// user actions only emit `change`, all others emit `input` on each edit.
+func modelEvent(n *html.Node) string {
+	switch n.DataAtom {
+	case atom.Input:
+		switch attributes.Val(n.Attr, "type") {
+		case "checkbox", "radio", "file":
+			return "change"
+		}
+	case atom.Select:
+		return "change"
+	}
+	return "input"
+}
+
+func (seh *stdElementHandler) processAssignments(arr []data.Assignment, path []int, pos data.Position) error {
 	formDepth := -1
 	if seh.curFormPos != -1 {
 		formDepth = len(*seh.indexList) - seh.curFormPos
@@ -237,11 +345,24 @@ func (seh *stdElementHandler) processAssignments(arr []data.Assignment, path []i
 			}
 		}
 		a.Path = path
+		a.Origin = data.Origin{Pos: pos, Attr: "a:assign"}
 		seh.b.Assignments = append(seh.b.Assignments, a)
 	}
 	return nil
 }
 
+// checkNoSignals returns an error if one of the given assignments binds a
+// signal. Signals are bound once when the component is initialized, so they
+// cannot be used in blocks that are re-created by a:if and a:for.
+func checkNoSignals(arr []data.Assignment) error {
+	for _, a := range arr {
+		if a.Signal {
+			return errors.New(": cannot bind signal inside a:if or a:for")
+		}
+	}
+	return nil
+}
+
 func (seh *stdElementHandler) updateCurForm(n *html.Node) error {
 	if len(*seh.indexList) <= seh.curFormPos {
 		seh.curFormPos = -1
@@ -266,6 +387,7 @@ func (seh *stdElementHandler) handleControlBlocksAndAssignments(n *html.Node, at
 
 	if attrs.If != nil {
 		block = attrs.If
+		block.Origin.Attr = "a:if"
 	}
 	if attrs.For != nil {
 		if block != nil {
@@ -273,16 +395,23 @@ func (seh *stdElementHandler) handleControlBlocksAndAssignments(n *html.Node, at
 		}
 
 		block = attrs.For
+		block.Origin.Attr = "a:for"
 	}
+	pos := data.PositionOf(n)
 	if block != nil {
+		if err := checkNoSignals(attrs.Assign); err != nil {
+			return false, err
+		}
 		block.Path = append([]int(nil), *seh.indexList...)
+		block.Origin.Pos = pos
 		var indexList []int
-		cp := &ctrlBlockElementProcessor{stdElementHandler{seh.syms, &indexList, &block.Block, seh.curFormPos, seh.curForm}}
-		cp.processAssignments(attrs.Assign, []int{})
+		inFor := seh.inFor || block.Kind == data.ForBlock
+		cp := &ctrlBlockElementProcessor{stdElementHandler{seh.syms, &indexList, &block.Block, seh.curFormPos, seh.curForm, inFor}}
+		cp.processAssignments(attrs.Assign, []int{}, pos)
 
 		w := walker.Walker{
 			TextNode: walker.Allow{}, Text: &aTextProcessor{&block.Block, &indexList},
-			Embed:       &embedProcessor{seh.syms, &indexList, block.Path},
+			Embed:       &embedProcessor{seh.syms, &indexList, block, inFor},
 			StdElements: cp,
 			IndexList:   &indexList}
 		n.FirstChild, n.LastChild, err = w.WalkChildren(n, &walker.Siblings{Cur: n.FirstChild})
@@ -301,7 +430,7 @@ func (seh *stdElementHandler) handleControlBlocksAndAssignments(n *html.Node, at
 		seh.b.Controlled = append(seh.b.Controlled, block)
 		return false, nil
 	}
-	err = seh.processAssignments(attrs.Assign, append([]int(nil), *seh.indexList...))
+	err = seh.processAssignments(attrs.Assign, append([]int(nil), *seh.indexList...), pos)
 	if err != nil {
 		return false, err
 	}
@@ -309,6 +438,10 @@ func (seh *stdElementHandler) handleControlBlocksAndAssignments(n *html.Node, at
 }
 
 func (eh *elementHandler) Process(n *html.Node) (descend bool, replacement *html.Node, err error) {
+	if n.DataAtom == atom.Style && len(*eh.indexList) == 1 {
+		replacement, err = eh.processStyle(n)
+		return
+	}
 	if err = eh.updateCurForm(n); err != nil {
 		return
 	}
@@ -323,14 +456,15 @@ func (eh *elementHandler) Process(n *html.Node) (descend bool, replacement *html
 			return false, nil, errors.New(": " + err.Error())
 		}
 
-		if err = eh.processBindings(attrs.Bindings); err != nil {
+		if err = eh.processBindings(n, attrs.Bindings); err != nil {
 			return false, nil, err
 		}
+		eh.processModels(n, attrs.Model)
 	} else {
 		if len(attrs.Capture) > 0 {
 			return false, nil, errors.New(": cannot capture inside a:if or a:for")
 		}
-		if len(attrs.Bindings) > 0 {
+		if len(attrs.Bindings) > 0 || len(attrs.Model) > 0 {
 			return false, nil, errors.New(": cannot bind inside a:if or a:for")
 		}
 	}
@@ -355,9 +489,12 @@ func (cbeh *ctrlBlockElementProcessor) Process(n *html.Node) (descend bool, repl
 	if len(attrs.Capture) > 0 {
 		return false, nil, errors.New(": cannot capture inside a:if or a:for")
 	}
-	if len(attrs.Bindings) > 0 {
+	if len(attrs.Bindings) > 0 || len(attrs.Model) > 0 {
 		return false, nil, errors.New(": cannot bind inside a:if or a:for")
 	}
+	if err = checkNoSignals(attrs.Assign); err != nil {
+		return
+	}
 	descend, err = cbeh.handleControlBlocksAndAssignments(n, attrs)
 	return
 }
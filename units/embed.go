@@ -11,9 +11,12 @@ import (
 )
 
 type embedProcessor struct {
-	syms            *data.Symbols
-	indexList       *[]int
-	parentIndexList []int
+	syms      *data.Symbols
+	indexList *[]int
+	// block is the a:if block containing the embed, nil if there is none.
+	block *data.ControlBlock
+	// inFor is true if the embed is inside an a:for block.
+	inFor bool
 }
 
 // resolves the type of an embedded component.
@@ -67,6 +70,7 @@ func resolveEmbed(n *html.Node, syms *data.Symbols,
 			return data.Embed{}, nil, "", errors.New(": attribute `type` invalid: " + err.Error())
 		}
 	} else {
+		e.Target = target
 		// only when askew generates the new and init funcs for the component can
 		// we check whether the correct number of arguments have been provided.
 		canCheckArgNumber = target.GenNewInit
@@ -78,6 +82,10 @@ func resolveEmbed(n *html.Node, syms *data.Symbols,
 		}
 	} else {
 		e.Args, e.Value = attrs.Args, attrs.Value
+		e.Origin = data.Origin{Pos: data.PositionOf(n), Attr: "args"}
+		if e.Value != "" {
+			e.Origin.Attr = "value"
+		}
 		if canCheckArgNumber {
 			if len(target.Parameters) != e.Args.Count {
 				return data.Embed{}, nil, "", fmt.Errorf(
@@ -99,26 +107,44 @@ func resolveEmbed(n *html.Node, syms *data.Symbols,
 // Process implements Walker.NodeHandler.
 func (ep *embedProcessor) Process(n *html.Node) (descend bool,
 	replacement *html.Node, err error) {
-	path := make([]int, 0, len(ep.parentIndexList)+len(*ep.indexList))
-	path = append(path, ep.parentIndexList...)
-	path = append(path, *ep.indexList...)
-	e, target, newName, err := resolveEmbed(n, ep.syms, path)
+	if ep.inFor {
+		// the embed's field can only hold a single component, but the block
+		// would need one for each iteration.
+		return false, nil, errors.New(
+			": cannot embed inside a:for, use a list embed with <a:construct a:for> instead")
+	}
+	e, target, newName, err := resolveEmbed(n, ep.syms,
+		append([]int(nil), *ep.indexList...))
 	if err != nil {
 		return false, nil, err
 	}
 
-	cp := constructProcessor{ep.syms, &e, constructParent{newName: newName}}
+	cp := constructProcessor{ep.syms, &e, constructParent{newName: newName}, target}
 	if target != nil {
 		cp.parentType.numParams = len(target.Parameters)
 	} else {
 		cp.parentType.numParams = -1
 	}
 	w := walker.Walker{TextNode: &walker.WhitespaceOnly{},
-		Construct: &cp}
+		Construct: &cp, Route: &routeProcessor{&cp},
+		StdElements: &slotContentProcessor{&e, target}}
 	_, _, err = w.WalkChildren(n, &walker.Siblings{Cur: n.FirstChild})
+	if err != nil {
+		return false, nil, err
+	}
 	if e.Kind == data.OptionalEmbed && len(e.ConstructorCalls) > 1 {
 		return false, nil, errors.New(": too many <a:construct> for optional embed")
 	}
+	if len(e.ConstructorCalls) > 0 && len(e.Routes) > 0 {
+		return false, nil, errors.New(": cannot mix <a:construct> and <a:route>")
+	}
+	if ep.block != nil {
+		if len(e.Routes) > 0 {
+			return false, nil, errors.New(": cannot have <a:route> inside a:if")
+		}
+		e.Block = true
+		ep.block.Embeds = append(ep.block.Embeds, e)
+	}
 	ep.syms.CurUnit.Embeds = append(ep.syms.CurUnit.Embeds, e)
 	replacement = &html.Node{Type: html.CommentNode,
 		Data: "embed(" + e.Field + ")"}
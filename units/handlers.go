@@ -25,7 +25,7 @@ func (hp *handlersProcessor) Process(n *html.Node) (descend bool,
 	}
 	parsed, err := parsers.ParseHandlers(def.Data)
 	if err != nil {
-		return false, nil, errors.New(": unable to parse `" + def.Data + "`: " + err.Error())
+		return false, nil, parsers.WrapError("unable to parse handlers", err)
 	}
 	if hp.cmp.Handlers != nil {
 		return false, nil, errors.New(": only one <a:handlers> allowed per <a:component>")
@@ -39,8 +39,9 @@ func (hp *handlersProcessor) Process(n *html.Node) (descend bool,
 		if ok {
 			return false, nil, errors.New(": duplicate handler name: " + raw.Name)
 		}
-		hp.cmp.Handlers[raw.Name] =
-			data.Handler{Params: raw.Params, Returns: raw.Returns}
+		hp.cmp.Handlers[raw.Name] = data.Handler{Params: raw.Params,
+			Returns: raw.Returns,
+			Pos:     handlerPosition(data.PositionOf(n), def.Data, raw.Name)}
 	}
 
 	replacement = &html.Node{Type: html.CommentNode, Data: "handlers"}
@@ -0,0 +1,108 @@
+package units
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"strings"
+
+	"github.com/flyx/askew/attributes"
+	"github.com/flyx/askew/data"
+	"github.com/flyx/askew/parsers"
+	"github.com/flyx/askew/walker"
+	"github.com/flyx/net/html"
+)
+
+// routeProcessor processes <a:route> elements inside an optional <a:embed>.
+// It shares the parent's type with the processor for <a:construct>.
+type routeProcessor struct {
+	cp *constructProcessor
+}
+
+// routeParams returns the names of the parameters in the given route path.
+func routeParams(path string) ([]string, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, errors.New(": route path must start with `/`")
+	}
+	var ret []string
+	segments := strings.Split(path[1:], "/")
+	for i, s := range segments {
+		if !strings.HasPrefix(s, "{") || !strings.HasSuffix(s, "}") {
+			if strings.ContainsAny(s, "{}") {
+				return nil, errors.New(": invalid segment `" + s + "` in route path")
+			}
+			continue
+		}
+		name := s[1 : len(s)-1]
+		if strings.HasSuffix(name, "...") {
+			if i != len(segments)-1 {
+				return nil, errors.New(": `...` only allowed in last segment of route path")
+			}
+			name = strings.TrimSuffix(name, "...")
+		}
+		if !token.IsIdentifier(name) {
+			return nil, errors.New(": invalid parameter name `" + name + "` in route path")
+		}
+		for _, existing := range ret {
+			if existing == name {
+				return nil, errors.New(": duplicate parameter `" + name + "` in route path")
+			}
+		}
+		ret = append(ret, name)
+	}
+	return ret, nil
+}
+
+func (rp *routeProcessor) Process(n *html.Node) (descend bool,
+	replacement *html.Node, err error) {
+	if rp.cp.e.Kind != data.OptionalEmbed {
+		return false, nil, errors.New(": element requires optional embed as parent")
+	}
+	for _, a := range n.Attr {
+		if strings.HasPrefix(a.Key, "a:") && a.Key != data.PositionAttr {
+			return false, nil, errors.New(": attribute `" + a.Key + "` not allowed here")
+		}
+	}
+	path := attributes.Val(n.Attr, "path")
+	if path == "" {
+		return false, nil, errors.New(": attribute `path` missing")
+	}
+	params, err := routeParams(path)
+	if err != nil {
+		return false, nil, err
+	}
+	for _, r := range rp.cp.e.Routes {
+		if r.Path == path {
+			return false, nil, errors.New(": duplicate route path `" + path + "`")
+		}
+	}
+	newName, numParams := rp.cp.parentType.newName, rp.cp.parentType.numParams
+	target := rp.cp.target
+	if typeAttr := attributes.Val(n.Attr, "type"); typeAttr != "" {
+		if newName, target, err = constructorName(rp.cp.syms, typeAttr); err != nil {
+			return false, nil, err
+		}
+		numParams = -1
+		if target != nil {
+			numParams = len(target.Parameters)
+		}
+	} else if newName == "" {
+		return false, nil, errors.New(": attribute `type` missing")
+	}
+	var args data.Arguments
+	if attributes.Exists(n.Attr, "args") {
+		if args, err = parsers.AnalyseArguments(attributes.Val(n.Attr, "args")); err != nil {
+			return false, nil, parsers.WrapError("invalid args", err)
+		}
+	}
+	if numParams >= 0 && args.Count != numParams {
+		return false, nil, fmt.Errorf(
+			": target component requires %d arguments, but %d were given", numParams, args.Count)
+	}
+	rp.cp.e.Routes = append(rp.cp.e.Routes, data.Route{Path: path, Params: params,
+		ConstructorName: newName, Args: args,
+		Origin: data.Origin{Pos: data.PositionOf(n), Attr: "args"}, Target: target})
+	w := walker.Walker{TextNode: walker.WhitespaceOnly{}}
+	_, _, err = w.WalkChildren(n, &walker.Siblings{Cur: n.FirstChild})
+	return false, nil, err
+}
@@ -0,0 +1,192 @@
+package units
+
+import (
+	"errors"
+	"go/token"
+	"strings"
+
+	"github.com/flyx/askew/attributes"
+	"github.com/flyx/askew/data"
+	"github.com/flyx/net/html"
+)
+
+// slotProcessor processes <a:slot> elements inside a component. A slot is
+// an optional embed without type whose content is given by the unit that
+// embeds the component.
+type slotProcessor struct {
+	syms      *data.Symbols
+	indexList *[]int
+}
+
+func (sp *slotProcessor) Process(n *html.Node) (descend bool,
+	replacement *html.Node, err error) {
+	name := attributes.Val(n.Attr, "name")
+	if name == "" {
+		return false, nil, errors.New(": attribute `name` missing")
+	}
+	if !token.IsIdentifier(name) {
+		return false, nil, errors.New(": slot name `" + name + "` is not a valid identifier")
+	}
+	for _, e := range sp.syms.CurUnit.Embeds {
+		if e.Field == name {
+			return false, nil, errors.New(": duplicate name `" + name + "`")
+		}
+	}
+	e := data.Embed{Kind: data.OptionalEmbed,
+		Path: append([]int(nil), *sp.indexList...), Field: name, Slot: true,
+		Origin: data.Origin{Pos: data.PositionOf(n)}}
+	if e.Fallback, err = staticContent(n); err != nil {
+		return false, nil, err
+	}
+	sp.syms.CurUnit.Embeds = append(sp.syms.CurUnit.Embeds, e)
+	replacement = &html.Node{Type: html.CommentNode, Data: "slot(" + name + ")"}
+	return
+}
+
+// generatedMembers are the names of the members that the generated code
+// declares for every component, or that are part of its API.
+var generatedMembers = []string{"Controller", "Init", "Destroy", "FirstNode",
+	"InsertInto", "Extract", "DoUpdateParent", "DoMount", "DoUnmount",
+	"DoSetController", "DelegatedEvents", "Refresh"}
+
+// checkSlotNames verifies that the names of the component's slots do not
+// collide with other members of the component. This is done after the
+// component has been processed since <a:data> and the other embeds may follow
+// the slot.
+func checkSlotNames(cmp *data.Component) error {
+	for i, e := range cmp.Embeds {
+		if !e.Slot {
+			continue
+		}
+		collides := func(kind string) error {
+			return data.ErrorAt(e.Origin.Pos, errors.New("slot name `"+e.Field+
+				"` collides with "+kind))
+		}
+		for _, name := range generatedMembers {
+			if name == e.Field {
+				return collides("generated member `" + name + "`")
+			}
+		}
+		for _, f := range cmp.Fields {
+			if f.Name == e.Field {
+				return collides("field `" + f.Name + "`")
+			}
+		}
+		for _, v := range cmp.Variables {
+			if v.Variable.Name == e.Field {
+				return collides("bound variable `" + v.Variable.Name + "`")
+			}
+		}
+		for j, other := range cmp.Embeds {
+			if j != i && other.Field == e.Field {
+				return collides("embed `" + other.Field + "`")
+			}
+		}
+		if _, ok := cmp.Handlers[e.Field]; ok {
+			return collides("handler `" + e.Field + "`")
+		}
+	}
+	return nil
+}
+
+// staticContent moves the children of n into a new DocumentNode, which is
+// returned. Returns nil if n contains only whitespace. Static content is not
+// processed by askew, so it must not contain askew elements or attributes.
+func staticContent(n *html.Node) (*html.Node, error) {
+	empty := true
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if err := checkStatic(c); err != nil {
+			return nil, err
+		}
+		if c.Type != html.TextNode || strings.TrimSpace(c.Data) != "" {
+			empty = false
+		}
+	}
+	if empty {
+		return nil, nil
+	}
+	ret := &html.Node{Type: html.DocumentNode}
+	for n.FirstChild != nil {
+		c := n.FirstChild
+		n.RemoveChild(c)
+		ret.AppendChild(c)
+	}
+	return ret, nil
+}
+
+// checkStatic returns an error if n or one of its descendants is an askew
+// element or has an askew attribute.
+func checkStatic(n *html.Node) error {
+	if n.Type == html.ElementNode {
+		if n.DataAtom == 0 && strings.HasPrefix(n.Data, "a:") {
+			return errors.New(": <" + n.Data + "> not allowed in static content")
+		}
+		for _, a := range n.Attr {
+			if strings.HasPrefix(a.Key, "a:") && a.Key != data.PositionAttr {
+				return errors.New(": attribute `" + a.Key +
+					"` not allowed in static content")
+			}
+		}
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if err := checkStatic(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addSlotContent adds the given content to the embed, which must be a direct
+// embed. If target is not nil, it must have a slot with the content's name.
+func addSlotContent(e *data.Embed, target *data.Component,
+	content data.SlotContent) error {
+	if e.Kind != data.DirectEmbed {
+		return errors.New(": content for slot `" + content.Slot +
+			"` requires embed without `list` or `optional`")
+	}
+	if target != nil && !target.HasSlot(content.Slot) {
+		return errors.New(": component `" + target.Name + "` has no slot `" +
+			content.Slot + "`")
+	}
+	for _, existing := range e.SlotContents {
+		if existing.Slot == content.Slot {
+			return errors.New(": duplicate content for slot `" + content.Slot + "`")
+		}
+	}
+	e.SlotContents = append(e.SlotContents, content)
+	return nil
+}
+
+// slotContentProcessor processes child elements of <a:embed>, which give
+// static content for a slot of the embedded component.
+type slotContentProcessor struct {
+	e      *data.Embed
+	target *data.Component
+}
+
+func (scp *slotContentProcessor) Process(n *html.Node) (descend bool,
+	replacement *html.Node, err error) {
+	var attrs attributes.IncludeChild
+	if err = attributes.ExtractAskewAttribs(n, &attrs); err != nil {
+		return
+	}
+	if attrs.Slot == "" {
+		return false, nil, errors.New(": child of a:embed has no attribute `a:slot`")
+	}
+	if len(attrs.Others) > 0 {
+		return false, nil, errors.New(
+			": content for a slot may not have askew attributes other than `a:slot`")
+	}
+	if err = checkStatic(n); err != nil {
+		return
+	}
+	content := data.SlotContent{Slot: attrs.Slot,
+		HTML:   &html.Node{Type: html.DocumentNode},
+		Origin: data.Origin{Pos: data.PositionOf(n)}}
+	if err = addSlotContent(scp.e, scp.target, content); err != nil {
+		return
+	}
+	n.Parent, n.PrevSibling, n.NextSibling = nil, nil, nil
+	content.HTML.AppendChild(n)
+	return false, &html.Node{Type: html.CommentNode}, nil
+}
@@ -0,0 +1,360 @@
+package units
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	"github.com/flyx/askew/data"
+	"github.com/flyx/net/html"
+)
+
+// componentID returns the ID of the component with the given name declared in
+// the package with the given import path.
+func componentID(importPath, name string) string {
+	h := fnv.New32a()
+	h.Write([]byte(importPath + "." + name))
+	return fmt.Sprintf("askew-%08x", h.Sum32())
+}
+
+// processStyle reads a <style> element that is a direct child of a component
+// and appends its scoped content to the component's style.
+func (eh *elementHandler) processStyle(n *html.Node) (replacement *html.Node, err error) {
+	for _, a := range n.Attr {
+		if a.Key != data.PositionAttr {
+			return nil, errors.New(": <style> of a component may not have attributes")
+		}
+	}
+	var content strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		content.WriteString(c.Data)
+	}
+	scoped, err := scopeStyle(content.String(), eh.cmp.ScopeAttr())
+	if err != nil {
+		return nil, err
+	}
+	eh.cmp.Style += scoped
+	return &html.Node{Type: html.CommentNode, Data: "style"}, nil
+}
+
+// markComponentScope adds the component's scope attribute to all elements of
+// its template, including static content given to slots.
+func markComponentScope(cmp *data.Component) {
+	attr := cmp.ScopeAttr()
+	markScope(cmp.Template, attr)
+	for _, e := range cmp.Embeds {
+		if e.Fallback != nil {
+			markScope(e.Fallback, attr)
+		}
+		for _, c := range e.SlotContents {
+			if c.HTML != nil {
+				markScope(c.HTML, attr)
+			}
+		}
+	}
+}
+
+// markScope adds the given attribute to n and all elements below it.
+func markScope(n *html.Node, attr string) {
+	if n.Type == html.ElementNode {
+		n.Attr = append(n.Attr, html.Attribute{Key: attr})
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		markScope(c, attr)
+	}
+}
+
+// groupingRules are the at-rules whose block contains style rules that are
+// to be scoped. The blocks of all other at-rules are copied verbatim.
+var groupingRules = map[string]struct{}{
+	"media": {}, "supports": {}, "container": {}, "layer": {}, "document": {},
+}
+
+// cssSpace contains the characters that are whitespace in CSS.
+const cssSpace = " \t\n\r\f"
+
+// legacyPseudoElements can be written with a single colon.
+var legacyPseudoElements = []string{"before", "after", "first-line", "first-letter"}
+
+// cssScoper rewrites a style sheet so that its style rules only match
+// elements having a certain attribute.
+type cssScoper struct {
+	input string
+	pos   int
+	// sel is the attribute selector added to each selector.
+	sel string
+	out strings.Builder
+}
+
+// scopeStyle returns the given style sheet with the selectors of all style
+// rules restricted to elements having the given attribute. The selector's
+// last compound is restricted, so that descendants of other components do not
+// match. Declarations and at-rules like @keyframes are not changed.
+func scopeStyle(css, attr string) (string, error) {
+	s := cssScoper{input: css, sel: "[" + attr + "]"}
+	if err := s.rules(false); err != nil {
+		return "", err
+	}
+	return s.out.String(), nil
+}
+
+// skip advances over the comment or string starting at the current position.
+// Returns false if there is none.
+func (s *cssScoper) skip() bool {
+	switch {
+	case strings.HasPrefix(s.input[s.pos:], "/*"):
+		end := strings.Index(s.input[s.pos+2:], "*/")
+		if end == -1 {
+			s.pos = len(s.input)
+		} else {
+			s.pos += end + 4
+		}
+	case s.input[s.pos] == '"' || s.input[s.pos] == '\'':
+		quote := s.input[s.pos]
+		for s.pos++; s.pos < len(s.input); s.pos++ {
+			if c := s.input[s.pos]; c == '\\' {
+				s.pos++
+			} else if c == quote || c == '\n' {
+				s.pos++
+				break
+			}
+		}
+		if s.pos > len(s.input) {
+			s.pos = len(s.input)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// prelude advances to the next `{`, `;` or `}` that is not part of a
+// comment, string or parenthesized block and returns the text before it.
+func (s *cssScoper) prelude() string {
+	start, depth := s.pos, 0
+	for s.pos < len(s.input) {
+		if s.skip() {
+			continue
+		}
+		switch s.input[s.pos] {
+		case '\\':
+			s.pos++
+		case '(', '[':
+			depth++
+		case ')', ']':
+			depth--
+		case '{', ';', '}':
+			if depth <= 0 {
+				return s.input[start:s.pos]
+			}
+		}
+		s.pos++
+	}
+	if s.pos > len(s.input) {
+		s.pos = len(s.input)
+	}
+	return s.input[start:s.pos]
+}
+
+// block copies the block starting at the current position, which must be a
+// `{`, including its closing `}`.
+func (s *cssScoper) block() error {
+	start, depth := s.pos, 0
+	for s.pos < len(s.input) {
+		if s.skip() {
+			continue
+		}
+		switch s.input[s.pos] {
+		case '\\':
+			s.pos++
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				s.pos++
+				s.out.WriteString(s.input[start:s.pos])
+				return nil
+			}
+		}
+		s.pos++
+	}
+	return errors.New(": unterminated block in <style>")
+}
+
+// rules processes a list of rules until the end of the input or, if nested
+// is true, until the `}` that closes the surrounding block.
+func (s *cssScoper) rules(nested bool) error {
+	for {
+		prelude := s.prelude()
+		if s.pos == len(s.input) {
+			if nested {
+				return errors.New(": unterminated block in <style>")
+			}
+			s.out.WriteString(prelude)
+			return nil
+		}
+		switch s.input[s.pos] {
+		case '}':
+			if !nested {
+				return errors.New(": unexpected `}` in <style>")
+			}
+			s.out.WriteString(prelude)
+			s.out.WriteByte('}')
+			s.pos++
+			return nil
+		case ';':
+			s.out.WriteString(prelude)
+			s.out.WriteByte(';')
+			s.pos++
+			continue
+		}
+		trimmed := strings.TrimSpace(prelude)
+		s.out.WriteString(prelude[:len(prelude)-len(strings.TrimLeft(prelude, cssSpace))])
+		if strings.HasPrefix(trimmed, "@") {
+			end := 1
+			for end < len(trimmed) && isIdentChar(trimmed[end]) {
+				end++
+			}
+			s.out.WriteString(trimmed)
+			s.out.WriteString(prelude[len(strings.TrimRight(prelude, cssSpace)):])
+			if _, ok := groupingRules[strings.ToLower(trimmed[1:end])]; ok {
+				s.out.WriteByte('{')
+				s.pos++
+				if err := s.rules(true); err != nil {
+					return err
+				}
+				continue
+			}
+		} else {
+			s.out.WriteString(s.selectors(stripComments(trimmed)))
+			s.out.WriteString(prelude[len(strings.TrimRight(prelude, cssSpace)):])
+		}
+		if err := s.block(); err != nil {
+			return err
+		}
+	}
+}
+
+// topLevel calls f with the index of each byte of sel that is neither
+// escaped nor part of a string, a parenthesized or a bracketed block.
+// Stops if f returns false.
+func topLevel(sel string, f func(i int) bool) {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(sel); i++ {
+		c := sel[i]
+		switch {
+		case c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '(' || c == '[':
+			depth++
+		case c == ')' || c == ']':
+			depth--
+		case depth == 0:
+			if !f(i) {
+				return
+			}
+		}
+	}
+}
+
+// stripComments removes all comments outside of strings from the given
+// selector list.
+func stripComments(sel string) string {
+	var b strings.Builder
+	var quote byte
+	for i := 0; i < len(sel); i++ {
+		c := sel[i]
+		switch {
+		case c == '\\' && i+1 < len(sel):
+			b.WriteByte(c)
+			i++
+			c = sel[i]
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case strings.HasPrefix(sel[i:], "/*"):
+			end := strings.Index(sel[i+2:], "*/")
+			if end == -1 {
+				return b.String()
+			}
+			i += end + 3
+			b.WriteByte(' ')
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// selectors restricts each selector of the given comma-separated list.
+func (s *cssScoper) selectors(list string) string {
+	var items []string
+	start := 0
+	topLevel(list, func(i int) bool {
+		if list[i] == ',' {
+			items = append(items, s.selector(strings.TrimSpace(list[start:i])))
+			start = i + 1
+		}
+		return true
+	})
+	items = append(items, s.selector(strings.TrimSpace(list[start:])))
+	return strings.Join(items, ", ")
+}
+
+// selector adds the attribute selector to the last compound of sel, in front
+// of its pseudo-element if it has one.
+func (s *cssScoper) selector(sel string) string {
+	if sel == "" {
+		return sel
+	}
+	compound := 0
+	topLevel(sel, func(i int) bool {
+		switch sel[i] {
+		case '>', '+', '~':
+			compound = i + 1
+		default:
+			if strings.IndexByte(cssSpace, sel[i]) != -1 {
+				compound = i + 1
+			}
+		}
+		return true
+	})
+	insert := len(sel)
+	topLevel(sel[compound:], func(i int) bool {
+		rest := sel[compound+i:]
+		if strings.HasPrefix(rest, "::") {
+			insert = compound + i
+			return false
+		}
+		if rest[0] == ':' {
+			lower := strings.ToLower(rest[1:])
+			for _, name := range legacyPseudoElements {
+				if strings.HasPrefix(lower, name) && (len(lower) == len(name) ||
+					!isIdentChar(lower[len(name)])) {
+					insert = compound + i
+					return false
+				}
+			}
+		}
+		return true
+	})
+	return sel[:insert] + s.sel + sel[insert:]
+}
+
+// isIdentChar returns true iff c can be part of a CSS identifier.
+func isIdentChar(c byte) bool {
+	return c == '-' || c == '_' || c >= 0x80 || (c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,58 @@
+package units
+
+import "testing"
+
+func TestScopeStyle(t *testing.T) {
+	for _, tc := range []struct {
+		name, input, expected string
+	}{
+		{"type", "p { color: red; }", "p[s] { color: red; }"},
+		{"universal", "* {}", "*[s] {}"},
+		{"compound", "a.b#c[d=e] {}", "a.b#c[d=e][s] {}"},
+		{"list", "h1,h2 , h3 {}", "h1[s], h2[s], h3[s] {}"},
+		{"descendant", "ul  li {}", "ul  li[s] {}"},
+		{"child", "ul>li {}", "ul>li[s] {}"},
+		{"child with spaces", "ul > li {}", "ul > li[s] {}"},
+		{"siblings", "h1 + p ~ a {}", "h1 + p ~ a[s] {}"},
+		{"pseudo-class", "a:hover {}", "a:hover[s] {}"},
+		{"pseudo-element", "p::before {}", "p[s]::before {}"},
+		{"legacy pseudo-element", "p:first-line {}", "p[s]:first-line {}"},
+		{"not a legacy pseudo-element", "p:first-child {}", "p:first-child[s] {}"},
+		{"is", ":is(h1, h2) span {}", ":is(h1, h2) span[s] {}"},
+		{"is with combinator", "p :is(.a > .b) {}", "p :is(.a > .b)[s] {}"},
+		{"not", "li:not(.a, .b) {}", "li:not(.a, .b)[s] {}"},
+		{"attribute with combinator chars", `a[title="x > y, z"] {}`, `a[title="x > y, z"][s] {}`},
+		{"comment in selector", "h1, /* h2, */ h3 {}", "h1[s], h3[s] {}"},
+		{"comment before rule", "/* p { } */ a {}", "a[s] {}"},
+		{"string in declaration", `p { content: "} a {"; }`, `p[s] { content: "} a {"; }`},
+		{"escaped selector", `.a\:b {}`, `.a\:b[s] {}`},
+		{"media", "@media (min-width: 1px) { p {} a b {} }",
+			"@media (min-width: 1px) { p[s] {} a b[s] {} }"},
+		{"nested grouping", "@supports (display: grid) { @media print { p {} } }",
+			"@supports (display: grid) { @media print { p[s] {} } }"},
+		{"keyframes", "@keyframes spin { from { top: 0; } to { top: 1px; } }",
+			"@keyframes spin { from { top: 0; } to { top: 1px; } }"},
+		{"font-face", "@font-face { font-family: x; }", "@font-face { font-family: x; }"},
+		{"import", "@import url(a.css);\np {}", "@import url(a.css);\np[s] {}"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := scopeStyle(tc.input, "s")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != tc.expected {
+				t.Fatalf("expected\n  %s\ngot\n  %s", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestScopeStyleErrors(t *testing.T) {
+	for _, input := range []string{"p {", "p {} }", "@media print { p {}"} {
+		t.Run(input, func(t *testing.T) {
+			if _, err := scopeStyle(input, "s"); err == nil {
+				t.Fatalf("expected an error")
+			}
+		})
+	}
+}
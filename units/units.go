@@ -1,7 +1,6 @@
 package units
 
 import (
-	"errors"
 	"os"
 	"path/filepath"
 
@@ -16,13 +15,14 @@ func ProcessFile(file *data.AskewFile, syms *data.Symbols) error {
 	syms.SetAskewFile(file)
 	os.Stdout.WriteString("[info] processing units: " + file.Path + "\n")
 	w := walker.Walker{TextNode: walker.WhitespaceOnly{},
-		Component: &componentProcessor{unitProcessor{syms}},
+		Component:   &componentProcessor{unitProcessor{syms}},
+		Diagnostics: syms.Diagnostics,
 	}
 	_, _, err := w.WalkChildren(nil, &walker.NodeSlice{Items: file.Content})
 	if err != nil {
-		return errors.New(file.Path + ": " + err.Error())
+		return data.ErrorAt(data.Position{File: file.Path}, err)
 	}
-	return err
+	return nil
 }
 
 func processSiteDescriptor(site *data.ASiteFile) error {
@@ -62,10 +62,14 @@ func ProcessSite(file *data.ASiteFile, syms *data.Symbols) error {
 	syms.SetASiteFile(file)
 	os.Stdout.WriteString("[info] processing site: " + file.Path + "\n")
 	if err := processSiteDescriptor(file); err != nil {
-		return err
+		return data.ErrorAt(data.PositionOf(file.RootNode()), err)
 	}
 
 	p := unitProcessor{syms}
 
-	return p.processUnitContent(file.RootNode(), &file.Unit, nil, file.RootNode(), false)
+	err := p.processUnitContent(file.RootNode(), &file.Unit, nil, file.RootNode(), false)
+	if err != nil {
+		return data.ErrorAt(data.Position{File: file.Path}, err)
+	}
+	return nil
 }
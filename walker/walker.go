@@ -2,9 +2,9 @@ package walker
 
 import (
 	"errors"
-	"fmt"
 	"strings"
 
+	"github.com/flyx/askew/data"
 	"github.com/flyx/net/html"
 )
 
@@ -40,32 +40,39 @@ type Walker struct {
 	Controller  NodeHandler
 	Data        NodeHandler
 	Construct   NodeHandler
+	Route       NodeHandler
 	IndexList   *[]int
+	// Diagnostics, if set, receives errors that occur while processing child
+	// nodes. The walker then removes the erroneous node and continues with its
+	// next sibling instead of aborting.
+	Diagnostics *data.Diagnostics
 }
 
-func (w *Walker) walk(n *html.Node, nodesCount map[string]int) (replacement *html.Node, err error) {
+func (w *Walker) walk(n *html.Node) (replacement *html.Node, err error) {
 	switch n.Type {
 	case html.ErrorNode:
-		return nil, errors.New(": encountered error node: " + n.Data)
+		return nil, data.ErrorAt(data.PositionOf(n),
+			errors.New("encountered error node: "+n.Data))
 	case html.TextNode:
 		if w.TextNode == nil {
-			return nil, errors.New(": text content not allowed here")
+			return nil, data.ErrorAt(data.PositionOf(n),
+				errors.New("text content not allowed here"))
 		}
 		_, replacement, err = w.TextNode.Process(n)
+		if err != nil {
+			return nil, data.ErrorAt(data.PositionOf(n), err)
+		}
 		return
 	case html.ElementNode:
 		break
 	case html.CommentNode, html.DoctypeNode:
 		return nil, nil
 	default:
-		return nil, errors.New(": unexpected node kind")
+		return nil, data.ErrorAt(data.PositionOf(n), errors.New("unexpected node kind"))
 	}
-	count, _ := nodesCount[n.Data]
-	count++
-	nodesCount[n.Data] = count
 	replacement, err = w.processElement(n)
 	if err != nil {
-		return nil, fmt.Errorf("/%s[%d]%s", n.Data, count, err.Error())
+		return nil, data.ErrorAt(data.PositionOf(n), err)
 	}
 	return
 }
@@ -103,11 +110,13 @@ func (w *Walker) processElement(n *html.Node) (replacement *html.Node, err error
 			h = w.Data
 		case "a:construct":
 			h = w.Construct
+		case "a:route":
+			h = w.Route
 		default:
-			return nil, errors.New(": unknown element")
+			return nil, errors.New(": unknown element <" + n.Data + ">")
 		}
 		if h == nil {
-			return nil, errors.New(": element not allowed here")
+			return nil, errors.New(": element <" + n.Data + "> not allowed here")
 		}
 	} else {
 		h = w.StdElements
@@ -160,14 +169,17 @@ func (ns *NodeSlice) next() (ret *html.Node) {
 // children of the given parent (which may be nil)
 func (w *Walker) WalkChildren(parent *html.Node,
 	l NodeList) (repFirst, repLast *html.Node, err error) {
-	nodesCount := make(map[string]int)
 	if w.IndexList != nil {
 		*w.IndexList = append(*w.IndexList, 0)
 	}
 	for c := l.next(); c != nil; c = l.next() {
-		f, err := w.walk(c, nodesCount)
+		f, err := w.walk(c)
 		if err != nil {
-			return nil, nil, err
+			if w.Diagnostics == nil {
+				return nil, nil, err
+			}
+			w.Diagnostics.Add(err)
+			f = &html.Node{Type: html.CommentNode, Data: "error"}
 		}
 		if repFirst == nil {
 			if f == nil {
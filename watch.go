@@ -0,0 +1,332 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/flyx/askew/data"
+	"github.com/flyx/askew/output"
+	"github.com/flyx/askew/packages"
+	"github.com/fsnotify/fsnotify"
+)
+
+const (
+	// settleDelay is the time the watcher waits after a file system event for
+	// further events before processing changes. Editors often write a file in
+	// several steps, which should only trigger one regeneration.
+	settleDelay = 100 * time.Millisecond
+	// typecheckDelay is the time without changes after which generated code is
+	// type-checked. Type-checking loads all dependencies and is far slower than
+	// generating code, so it is not done for every change.
+	typecheckDelay = 2 * time.Second
+	// pollInterval is the time the watcher waits between checking the file
+	// system for changes if file system notifications are not available.
+	pollInterval = 500 * time.Millisecond
+)
+
+// watcher keeps the processed symbols in memory and regenerates the code of
+// packages whose source files change.
+type watcher struct {
+	excludes   []string
+	dataPath   string
+	outputPath string
+	backend    output.Backend
+	goimports  bool
+	prerender  bool
+	native     bool
+	// ignoreUnused suppresses warnings about handlers that are never captured.
+	ignoreUnused bool
+
+	tmplData    interface{}
+	dataModTime time.Time
+	modTimes    map[string]time.Time
+	// p is nil if the last complete rebuild failed.
+	p     *processor
+	dirty map[string]struct{}
+	// unchecked holds the packages that have been generated since the last
+	// type-check.
+	unchecked map[string]struct{}
+	notify    *fsnotify.Watcher
+}
+
+// run does an initial generation of all packages and then watches the file
+// system for changes. It never returns.
+func (w *watcher) run() {
+	w.dirty = make(map[string]struct{})
+	w.unchecked = make(map[string]struct{})
+	var events <-chan fsnotify.Event
+	var errs <-chan error
+	var poll <-chan time.Time
+	if err := w.startNotify(); err != nil {
+		reportError(err)
+		os.Stdout.WriteString("[info] file system notifications unavailable, polling for changes\n")
+		poll = time.NewTicker(pollInterval).C
+	} else {
+		events, errs = w.notify.Events, w.notify.Errors
+	}
+	w.rebuild()
+
+	var settle, check <-chan time.Time
+	if len(w.unchecked) > 0 {
+		check = time.After(typecheckDelay)
+	}
+	for {
+		select {
+		case e := <-events:
+			if e.Op&fsnotify.Create != 0 {
+				w.watchDirs(e.Name)
+			}
+			if w.relevant(e) {
+				settle, check = time.After(settleDelay), nil
+			}
+		case err := <-errs:
+			reportError(err)
+		case <-poll:
+			w.handleChanges()
+		case <-settle:
+			settle = nil
+			w.handleChanges()
+		case <-check:
+			check = nil
+			w.typecheck()
+			continue
+		}
+		if len(w.unchecked) > 0 && settle == nil && check == nil {
+			check = time.After(typecheckDelay)
+		}
+	}
+}
+
+// startNotify creates the file system watcher and adds all directories that
+// may contain sources or the data file.
+func (w *watcher) startNotify() error {
+	var err error
+	if w.notify, err = fsnotify.NewWatcher(); err != nil {
+		return err
+	}
+	if w.dataPath != "" {
+		if err := w.notify.Add(filepath.Dir(w.dataPath)); err != nil {
+			w.notify.Close()
+			return err
+		}
+	}
+	dirs, err := packages.Dirs(".", w.excludes)
+	if err == nil {
+		for _, dir := range dirs {
+			if err = w.notify.Add(dir); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		w.notify.Close()
+	}
+	return err
+}
+
+// watchDirs adds the directory at the given path and all directories below it
+// to the file system watcher. Does nothing if path is not a directory.
+func (w *watcher) watchDirs(path string) {
+	if info, err := os.Stat(path); err != nil || !info.IsDir() {
+		return
+	}
+	dirs, err := packages.Dirs(path, w.excludes)
+	if err != nil {
+		reportError(err)
+	}
+	for _, dir := range dirs {
+		if err := w.notify.Add(dir); err != nil {
+			reportError(err)
+		}
+	}
+}
+
+// relevant returns true iff the given event may change the result of poll.
+func (w *watcher) relevant(e fsnotify.Event) bool {
+	if packages.IsSource(e.Name) || (w.dataPath != "" &&
+		filepath.Clean(e.Name) == filepath.Clean(w.dataPath)) {
+		return true
+	}
+	// renamed or removed directories may have contained sources.
+	return e.Op&(fsnotify.Remove|fsnotify.Rename) != 0 && filepath.Ext(e.Name) == ""
+}
+
+// handleChanges regenerates the packages that changed since the last call.
+func (w *watcher) handleChanges() {
+	changed, dataChanged, err := w.poll()
+	if err != nil {
+		reportError(err)
+		return
+	}
+	if dataChanged || (w.p == nil && len(changed) > 0) {
+		w.rebuild()
+	} else if len(changed) > 0 {
+		w.update(changed)
+	}
+}
+
+// poll checks the file system for changes since the last call and returns
+// the relative paths of all packages that contain changed, added or removed
+// files. dataChanged is true if the data file has been modified.
+func (w *watcher) poll() (changed []string, dataChanged bool, err error) {
+	if w.dataPath != "" {
+		info, err := os.Stat(w.dataPath)
+		if err != nil {
+			return nil, false, err
+		}
+		if !info.ModTime().Equal(w.dataModTime) {
+			w.dataModTime = info.ModTime()
+			dataChanged = true
+		}
+	}
+	modTimes, err := packages.Scan(w.excludes)
+	if err != nil {
+		return nil, false, err
+	}
+	pkgs := make(map[string]struct{})
+	for path, t := range modTimes {
+		if prev, ok := w.modTimes[path]; !ok || !prev.Equal(t) {
+			pkgs[filepath.Dir(path)] = struct{}{}
+		}
+	}
+	for path := range w.modTimes {
+		if _, ok := modTimes[path]; !ok {
+			pkgs[filepath.Dir(path)] = struct{}{}
+		}
+	}
+	w.modTimes = modTimes
+	for pkg := range pkgs {
+		changed = append(changed, pkg)
+	}
+	sort.Strings(changed)
+	return
+}
+
+// rebuild discovers and processes all packages and generates their code.
+func (w *watcher) rebuild() {
+	w.p = nil
+	if _, _, err := w.poll(); err != nil {
+		reportError(err)
+		return
+	}
+	var err error
+	w.tmplData, err = loadData(w.dataPath)
+	if err != nil {
+		reportError(err)
+		return
+	}
+	diag := &data.Diagnostics{}
+	base, err := packages.Discover(w.excludes, w.tmplData, diag)
+	if err != nil {
+		reportError(err)
+		return
+	}
+	w.p = &processor{goimports: w.goimports, prerender: w.prerender,
+		native: w.native, ignoreUnused: w.ignoreUnused}
+	w.p.init(base, diag)
+	w.generate(w.p.packages())
+}
+
+// update loads the given changed packages again and regenerates them along
+// with all packages depending on them. Packages that failed to generate
+// previously are retried.
+func (w *watcher) update(changed []string) {
+	base := &w.p.syms.BaseDir
+	diag := &data.Diagnostics{}
+	w.p.setDiagnostics(diag)
+	for _, relPath := range changed {
+		delete(w.dirty, relPath)
+	}
+	for relPath := range w.dirty {
+		changed = append(changed, relPath)
+	}
+	for _, relPath := range changed {
+		if err := packages.Rediscover(base, relPath, w.tmplData, diag); err != nil {
+			reportError(err)
+			w.markDirty(changed)
+			return
+		}
+	}
+	affected := packages.Dependents(base.ImportPath, base.Packages, changed)
+	// dependent packages have been modified by previous processing and must be
+	// loaded again from the file system.
+	for _, relPath := range affected[len(changed):] {
+		if err := packages.Rediscover(base, relPath, w.tmplData, diag); err != nil {
+			reportError(err)
+			w.markDirty(affected)
+			return
+		}
+	}
+	w.generate(affected)
+}
+
+// generate processes and dumps the packages at the given relative paths. The
+// generated code is type-checked later by typecheck.
+func (w *watcher) generate(relPaths []string) {
+	base := &w.p.syms.BaseDir
+	order, err := packages.Sort(base.ImportPath, base.Packages)
+	if err != nil {
+		w.p.diag.Add(err)
+		reportDiagnostics(w.p.diag)
+		w.markDirty(relPaths)
+		return
+	}
+	wanted := make(map[string]struct{})
+	for _, relPath := range relPaths {
+		wanted[relPath] = struct{}{}
+	}
+	filtered := make([]string, 0, len(relPaths))
+	for _, relPath := range order {
+		if _, ok := wanted[relPath]; ok {
+			filtered = append(filtered, relPath)
+		}
+	}
+	if !w.p.process(filtered) {
+		reportDiagnostics(w.p.diag)
+		w.markDirty(filtered)
+		return
+	}
+	os.Stdout.WriteString("[info] generating code\n")
+	for _, relPath := range filtered {
+		if err := w.p.dumpPackage(relPath, w.outputPath, w.backend); err != nil {
+			w.p.diag.Add(err)
+			reportDiagnostics(w.p.diag)
+			w.markDirty(filtered)
+			return
+		}
+		w.unchecked[relPath] = struct{}{}
+	}
+	reportDiagnostics(w.p.diag)
+	w.dirty = make(map[string]struct{})
+	os.Stdout.WriteString("[info] watching for changes\n")
+}
+
+// typecheck type-checks the code of all packages generated since the last
+// call that still exist.
+func (w *watcher) typecheck() {
+	relPaths := make([]string, 0, len(w.unchecked))
+	for relPath := range w.unchecked {
+		if w.p != nil {
+			if _, ok := w.p.syms.Packages[relPath]; ok {
+				relPaths = append(relPaths, relPath)
+			}
+		}
+	}
+	w.unchecked = make(map[string]struct{})
+	if len(relPaths) == 0 {
+		return
+	}
+	sort.Strings(relPaths)
+	diag := &data.Diagnostics{}
+	w.p.setDiagnostics(diag)
+	w.p.typecheck(relPaths)
+	reportDiagnostics(diag)
+}
+
+func (w *watcher) markDirty(relPaths []string) {
+	for _, relPath := range relPaths {
+		w.dirty[relPath] = struct{}{}
+	}
+}